@@ -11,11 +11,243 @@ import (
 	"github.com/oapi-codegen/runtime"
 )
 
+// Defines values for AdminListK8sReleasesParamsSort.
+const (
+	AdminListK8sReleasesParamsSortCreationTimestamp      AdminListK8sReleasesParamsSort = "creationTimestamp"
+	AdminListK8sReleasesParamsSortMinusCreationTimestamp AdminListK8sReleasesParamsSort = "-creationTimestamp"
+	AdminListK8sReleasesParamsSortMinusName              AdminListK8sReleasesParamsSort = "-name"
+	AdminListK8sReleasesParamsSortMinusNamespace         AdminListK8sReleasesParamsSort = "-namespace"
+	AdminListK8sReleasesParamsSortMinusPackage           AdminListK8sReleasesParamsSort = "-package"
+	AdminListK8sReleasesParamsSortMinusPhase             AdminListK8sReleasesParamsSort = "-phase"
+	AdminListK8sReleasesParamsSortMinusTag               AdminListK8sReleasesParamsSort = "-tag"
+	AdminListK8sReleasesParamsSortName                   AdminListK8sReleasesParamsSort = "name"
+	AdminListK8sReleasesParamsSortNamespace              AdminListK8sReleasesParamsSort = "namespace"
+	AdminListK8sReleasesParamsSortPackage                AdminListK8sReleasesParamsSort = "package"
+	AdminListK8sReleasesParamsSortPhase                  AdminListK8sReleasesParamsSort = "phase"
+	AdminListK8sReleasesParamsSortTag                    AdminListK8sReleasesParamsSort = "tag"
+)
+
+// Defines values for AdminListGitSourcesParamsSort.
+const (
+	AdminListGitSourcesParamsSortClusterId         AdminListGitSourcesParamsSort = "clusterId"
+	AdminListGitSourcesParamsSortLastSyncTime      AdminListGitSourcesParamsSort = "lastSyncTime"
+	AdminListGitSourcesParamsSortMinusClusterId    AdminListGitSourcesParamsSort = "-clusterId"
+	AdminListGitSourcesParamsSortMinusLastSyncTime AdminListGitSourcesParamsSort = "-lastSyncTime"
+	AdminListGitSourcesParamsSortMinusName         AdminListGitSourcesParamsSort = "-name"
+	AdminListGitSourcesParamsSortMinusNamespace    AdminListGitSourcesParamsSort = "-namespace"
+	AdminListGitSourcesParamsSortName              AdminListGitSourcesParamsSort = "name"
+	AdminListGitSourcesParamsSortNamespace         AdminListGitSourcesParamsSort = "namespace"
+)
+
+// Defines values for AdminListProjectsParamsSort.
+const (
+	AdminListProjectsParamsSortClusterId              AdminListProjectsParamsSort = "clusterId"
+	AdminListProjectsParamsSortCreationTimestamp      AdminListProjectsParamsSort = "creationTimestamp"
+	AdminListProjectsParamsSortMinusClusterId         AdminListProjectsParamsSort = "-clusterId"
+	AdminListProjectsParamsSortMinusCreationTimestamp AdminListProjectsParamsSort = "-creationTimestamp"
+	AdminListProjectsParamsSortMinusName              AdminListProjectsParamsSort = "-name"
+	AdminListProjectsParamsSortMinusPods              AdminListProjectsParamsSort = "-pods"
+	AdminListProjectsParamsSortMinusReleases          AdminListProjectsParamsSort = "-releases"
+	AdminListProjectsParamsSortName                   AdminListProjectsParamsSort = "name"
+	AdminListProjectsParamsSortPods                   AdminListProjectsParamsSort = "pods"
+	AdminListProjectsParamsSortReleases               AdminListProjectsParamsSort = "releases"
+)
+
+// Defines values for AdminListReleasesParamsSort.
+const (
+	AdminListReleasesParamsSortClusterId              AdminListReleasesParamsSort = "clusterId"
+	AdminListReleasesParamsSortCreationTimestamp      AdminListReleasesParamsSort = "creationTimestamp"
+	AdminListReleasesParamsSortMinusClusterId         AdminListReleasesParamsSort = "-clusterId"
+	AdminListReleasesParamsSortMinusCreationTimestamp AdminListReleasesParamsSort = "-creationTimestamp"
+	AdminListReleasesParamsSortMinusName              AdminListReleasesParamsSort = "-name"
+	AdminListReleasesParamsSortMinusNamespace         AdminListReleasesParamsSort = "-namespace"
+	AdminListReleasesParamsSortMinusPackage           AdminListReleasesParamsSort = "-package"
+	AdminListReleasesParamsSortMinusPhase             AdminListReleasesParamsSort = "-phase"
+	AdminListReleasesParamsSortMinusTag               AdminListReleasesParamsSort = "-tag"
+	AdminListReleasesParamsSortName                   AdminListReleasesParamsSort = "name"
+	AdminListReleasesParamsSortNamespace              AdminListReleasesParamsSort = "namespace"
+	AdminListReleasesParamsSortPackage                AdminListReleasesParamsSort = "package"
+	AdminListReleasesParamsSortPhase                  AdminListReleasesParamsSort = "phase"
+	AdminListReleasesParamsSortTag                    AdminListReleasesParamsSort = "tag"
+)
+
+// Defines values for AdminUpgradeReleasesJSONBodyTarget.
+const (
+	Cluster AdminUpgradeReleasesJSONBodyTarget = "cluster"
+	Git     AdminUpgradeReleasesJSONBodyTarget = "git"
+)
+
+// AdminListK8sReleasesParams defines parameters for AdminListK8sReleases.
+type AdminListK8sReleasesParams struct {
+	// Limit Maximum number of items to return. All the items are returned when not set.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Continue Opaque cursor returned in the X-Continue header of the previous page
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+
+	// LabelSelector Kubernetes label selector (ex. app=podinfo,tier!=frontend)
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+
+	// Search Case insensitive text search on the name and description of the items
+	Search *string `form:"search,omitempty" json:"search,omitempty"`
+
+	// Status Filter by release phase (READY, ERROR, WAIT_OCI, WAIT_REPO, WAIT_HREL, WAIT_DEPS, SUSPENDED)
+	Status *string `form:"status,omitempty" json:"status,omitempty"`
+
+	// Package Filter by package repository (case insensitive, partial match)
+	Package *string `form:"package,omitempty" json:"package,omitempty"`
+
+	// Tag Filter by package tag (version): an exact tag, or a semantic version constraint (e.g. 1.x, ">=1.2, <2").
+	// The constraints are checked against the version without the package revision suffix (6.7.1 for 6.7.1-p01).
+	Tag *string `form:"tag,omitempty" json:"tag,omitempty"`
+
+	// Sort Sort key, prefixed by '-' for a descending order
+	Sort *AdminListK8sReleasesParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// AdminListK8sReleasesParamsSort defines parameters for AdminListK8sReleases.
+type AdminListK8sReleasesParamsSort string
+
+// AdminListGitSourcesParams defines parameters for AdminListGitSources.
+type AdminListGitSourcesParams struct {
+	// ClusterId Restrict the result to a Kubernetes cluster ID
+	ClusterId *string `form:"clusterId,omitempty" json:"clusterId,omitempty"`
+
+	// Limit Maximum number of items to return. All the items are returned when not set.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Continue Opaque cursor returned in the X-Continue header of the previous page
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+
+	// Search Case insensitive text search on the name and description of the items
+	Search *string `form:"search,omitempty" json:"search,omitempty"`
+
+	// Status Filter by synchronization state (Ready, NotReady)
+	Status *string `form:"status,omitempty" json:"status,omitempty"`
+
+	// Sort Sort key, prefixed by '-' for a descending order
+	Sort *AdminListGitSourcesParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// AdminListGitSourcesParamsSort defines parameters for AdminListGitSources.
+type AdminListGitSourcesParamsSort string
+
+// AdminListProjectsParams defines parameters for AdminListProjects.
+type AdminListProjectsParams struct {
+	// ClusterId Restrict the result to a Kubernetes cluster ID
+	ClusterId *string `form:"clusterId,omitempty" json:"clusterId,omitempty"`
+
+	// Limit Maximum number of items to return. All the items are returned when not set.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Continue Opaque cursor returned in the X-Continue header of the previous page
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+
+	// LabelSelector Kubernetes label selector (ex. app=podinfo,tier!=frontend)
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+
+	// Search Case insensitive text search on the name and description of the items
+	Search *string `form:"search,omitempty" json:"search,omitempty"`
+
+	// Status Filter by namespace phase (Active, Terminating)
+	Status *string `form:"status,omitempty" json:"status,omitempty"`
+
+	// Sort Sort key, prefixed by '-' for a descending order
+	Sort *AdminListProjectsParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// AdminListProjectsParamsSort defines parameters for AdminListProjects.
+type AdminListProjectsParamsSort string
+
+// AdminListReleasesParams defines parameters for AdminListReleases.
+type AdminListReleasesParams struct {
+	// ClusterId Restrict the result to a Kubernetes cluster ID
+	ClusterId *string `form:"clusterId,omitempty" json:"clusterId,omitempty"`
+
+	// Limit Maximum number of items to return. All the items are returned when not set.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Continue Opaque cursor returned in the X-Continue header of the previous page
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+
+	// LabelSelector Kubernetes label selector (ex. app=podinfo,tier!=frontend)
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+
+	// Search Case insensitive text search on the name and description of the items
+	Search *string `form:"search,omitempty" json:"search,omitempty"`
+
+	// Status Filter by release phase (READY, ERROR, WAIT_OCI, WAIT_REPO, WAIT_HREL, WAIT_DEPS, SUSPENDED)
+	Status *string `form:"status,omitempty" json:"status,omitempty"`
+
+	// Package Filter by package repository (case insensitive, partial match)
+	Package *string `form:"package,omitempty" json:"package,omitempty"`
+
+	// Tag Filter by package tag (version): an exact tag, or a semantic version constraint (e.g. 1.x, ">=1.2, <2").
+	// The constraints are checked against the version without the package revision suffix (6.7.1 for 6.7.1-p01).
+	Tag *string `form:"tag,omitempty" json:"tag,omitempty"`
+
+	// Sort Sort key, prefixed by '-' for a descending order
+	Sort *AdminListReleasesParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// AdminListReleasesParamsSort defines parameters for AdminListReleases.
+type AdminListReleasesParamsSort string
+
+// AdminUpgradeReleasesJSONBody defines parameters for AdminUpgradeReleases.
+type AdminUpgradeReleasesJSONBody struct {
+	// ClusterId Restrict the upgrade to a Kubernetes cluster ID, all the clusters by default
+	ClusterId *string `json:"clusterId,omitempty"`
+
+	// LabelSelector Restrict the upgrade to the releases matching the label selector
+	LabelSelector *string `json:"labelSelector,omitempty"`
+
+	// Namespace Restrict the upgrade to the releases of a namespace
+	Namespace *string `json:"namespace,omitempty"`
+
+	// Package OCI repository of the package (spec.package.repository) of the releases to upgrade
+	Package string `json:"package"`
+
+	// Target Where the releases are upgraded:
+	//   - cluster: the releases deployed on the clusters are updated
+	//   - git: the releases of the git repos (fluxcd kustomizations) are updated, with a single commit per git repo
+	Target *AdminUpgradeReleasesJSONBodyTarget `json:"target,omitempty"`
+
+	// Version Package version (tag) to upgrade the releases to
+	Version string `json:"version"`
+}
+
+// AdminUpgradeReleasesParams defines parameters for AdminUpgradeReleases.
+type AdminUpgradeReleasesParams struct {
+	// DryRun If true, lists the changes without applying them
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+}
+
+// AdminUpgradeReleasesJSONBodyTarget defines parameters for AdminUpgradeReleases.
+type AdminUpgradeReleasesJSONBodyTarget string
+
+// AdminUpgradeReleasesJSONRequestBody defines body for AdminUpgradeReleases for application/json ContentType.
+type AdminUpgradeReleasesJSONRequestBody AdminUpgradeReleasesJSONBody
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// Get the list of the deployed releases on all namespaces
 	// (GET /admin/clusters/{clusterId}/releases)
-	AdminListK8sReleases(c *gin.Context, clusterId string)
+	AdminListK8sReleases(c *gin.Context, clusterId string, params AdminListK8sReleasesParams)
+	// Get the Flux git sources of all the clusters
+	// (GET /admin/gitsources)
+	AdminListGitSources(c *gin.Context, params AdminListGitSourcesParams)
+	// Get the projects of all the clusters
+	// (GET /admin/projects)
+	AdminListProjects(c *gin.Context, params AdminListProjectsParams)
+	// Get the releases of all the clusters
+	// (GET /admin/releases)
+	AdminListReleases(c *gin.Context, params AdminListReleasesParams)
+	// Get the system information
+	// (GET /admin/system)
+	AdminGetSystemInfo(c *gin.Context)
+	// Upgrade the releases of a package to a new version
+	// (POST /admin/upgrades)
+	AdminUpgradeReleases(c *gin.Context, params AdminUpgradeReleasesParams)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -41,6 +273,342 @@ func (siw *ServerInterfaceWrapper) AdminListK8sReleases(c *gin.Context) {
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AdminListK8sReleasesParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "continue" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "continue", c.Request.URL.Query(), &params.Continue)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter continue: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "labelSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "labelSelector", c.Request.URL.Query(), &params.LabelSelector)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter labelSelector: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", c.Request.URL.Query(), &params.Search)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter search: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "package" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "package", c.Request.URL.Query(), &params.Package)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter package: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "tag" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag", c.Request.URL.Query(), &params.Tag)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tag: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.AdminListK8sReleases(c, clusterId, params)
+}
+
+// AdminListGitSources operation middleware
+func (siw *ServerInterfaceWrapper) AdminListGitSources(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AdminListGitSourcesParams
+
+	// ------------- Optional query parameter "clusterId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "clusterId", c.Request.URL.Query(), &params.ClusterId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter clusterId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "continue" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "continue", c.Request.URL.Query(), &params.Continue)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter continue: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", c.Request.URL.Query(), &params.Search)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter search: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.AdminListGitSources(c, params)
+}
+
+// AdminListProjects operation middleware
+func (siw *ServerInterfaceWrapper) AdminListProjects(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AdminListProjectsParams
+
+	// ------------- Optional query parameter "clusterId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "clusterId", c.Request.URL.Query(), &params.ClusterId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter clusterId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "continue" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "continue", c.Request.URL.Query(), &params.Continue)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter continue: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "labelSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "labelSelector", c.Request.URL.Query(), &params.LabelSelector)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter labelSelector: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", c.Request.URL.Query(), &params.Search)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter search: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.AdminListProjects(c, params)
+}
+
+// AdminListReleases operation middleware
+func (siw *ServerInterfaceWrapper) AdminListReleases(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AdminListReleasesParams
+
+	// ------------- Optional query parameter "clusterId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "clusterId", c.Request.URL.Query(), &params.ClusterId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter clusterId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "continue" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "continue", c.Request.URL.Query(), &params.Continue)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter continue: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "labelSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "labelSelector", c.Request.URL.Query(), &params.LabelSelector)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter labelSelector: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", c.Request.URL.Query(), &params.Search)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter search: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "package" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "package", c.Request.URL.Query(), &params.Package)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter package: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "tag" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag", c.Request.URL.Query(), &params.Tag)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tag: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.AdminListReleases(c, params)
+}
+
+// AdminGetSystemInfo operation middleware
+func (siw *ServerInterfaceWrapper) AdminGetSystemInfo(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.AdminGetSystemInfo(c)
+}
+
+// AdminUpgradeReleases operation middleware
+func (siw *ServerInterfaceWrapper) AdminUpgradeReleases(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AdminUpgradeReleasesParams
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", c.Request.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dryRun: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -48,7 +616,7 @@ func (siw *ServerInterfaceWrapper) AdminListK8sReleases(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.AdminListK8sReleases(c, clusterId)
+	siw.Handler.AdminUpgradeReleases(c, params)
 }
 
 // GinServerOptions provides options for the Gin server.
@@ -79,4 +647,9 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	}
 
 	router.GET(options.BaseURL+"/admin/clusters/:clusterId/releases", wrapper.AdminListK8sReleases)
+	router.GET(options.BaseURL+"/admin/gitsources", wrapper.AdminListGitSources)
+	router.GET(options.BaseURL+"/admin/projects", wrapper.AdminListProjects)
+	router.GET(options.BaseURL+"/admin/releases", wrapper.AdminListReleases)
+	router.GET(options.BaseURL+"/admin/system", wrapper.AdminGetSystemInfo)
+	router.POST(options.BaseURL+"/admin/upgrades", wrapper.AdminUpgradeReleases)
 }
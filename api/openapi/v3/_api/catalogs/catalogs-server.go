@@ -11,6 +11,60 @@ import (
 	"github.com/oapi-codegen/runtime"
 )
 
+// Defines values for ListPackagesParamsSort.
+const (
+	MinusName ListPackagesParamsSort = "-name"
+	Name      ListPackagesParamsSort = "name"
+)
+
+// Defines values for GetPackageJSONSchemaParamsSection.
+const (
+	Context    GetPackageJSONSchemaParamsSection = "context"
+	Parameters GetPackageJSONSchemaParamsSection = "parameters"
+)
+
+// ListPackagesParams defines parameters for ListPackages.
+type ListPackagesParams struct {
+	// Limit Maximum number of items to return. All the items are returned when not set.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Continue Opaque cursor returned in the X-Continue header of the previous page
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+
+	// Search Case insensitive text search on the name and description of the items
+	Search *string `form:"search,omitempty" json:"search,omitempty"`
+
+	// Version Only return the packages having this version
+	Version *string `form:"version,omitempty" json:"version,omitempty"`
+
+	// Sort Sort key, prefixed by '-' for a descending order
+	Sort *ListPackagesParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// ListPackagesParamsSort defines parameters for ListPackages.
+type ListPackagesParamsSort string
+
+// GetPackageJSONSchemaParams defines parameters for GetPackageJSONSchema.
+type GetPackageJSONSchemaParams struct {
+	// Section Return only the JSON Schema document of this section
+	Section *GetPackageJSONSchemaParamsSection `form:"section,omitempty" json:"section,omitempty"`
+}
+
+// GetPackageJSONSchemaParamsSection defines parameters for GetPackageJSONSchema.
+type GetPackageJSONSchemaParamsSection string
+
+// GetReleaseSkeletonParams defines parameters for GetReleaseSkeleton.
+type GetReleaseSkeletonParams struct {
+	// ReleaseName Name of the release, defaults to the package name
+	ReleaseName *string `form:"releaseName,omitempty" json:"releaseName,omitempty"`
+
+	// Namespace Namespace of the release
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+
+	// TargetNamespace Namespace the package is deployed to
+	TargetNamespace *string `form:"targetNamespace,omitempty" json:"targetNamespace,omitempty"`
+}
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// List all catalogs
@@ -21,7 +75,7 @@ type ServerInterface interface {
 	GetCatalog(c *gin.Context, catalogId string)
 	// Get a list of packages by catalog id
 	// (GET /catalogs/{catalogId}/packages)
-	ListPackages(c *gin.Context, catalogId string)
+	ListPackages(c *gin.Context, catalogId string, params ListPackagesParams)
 	// Get package by catalog id and package name
 	// (GET /catalogs/{catalogId}/packages/{name})
 	GetPackage(c *gin.Context, catalogId string, name string)
@@ -31,6 +85,12 @@ type ServerInterface interface {
 	// Get package definition
 	// (GET /catalogs/{catalogId}/packages/{name}/versions/{version})
 	GetPackageDefinition(c *gin.Context, catalogId string, name string, version string)
+	// Get package schema as standard JSON Schema
+	// (GET /catalogs/{catalogId}/packages/{name}/versions/{version}/jsonschema)
+	GetPackageJSONSchema(c *gin.Context, catalogId string, name string, version string, params GetPackageJSONSchemaParams)
+	// Generate a release skeleton from a package version
+	// (GET /catalogs/{catalogId}/packages/{name}/versions/{version}/release-skeleton)
+	GetReleaseSkeleton(c *gin.Context, catalogId string, name string, version string, params GetReleaseSkeletonParams)
 	// Get package schema (parameters, context, etc)
 	// (GET /catalogs/{catalogId}/packages/{name}/versions/{version}/schema)
 	GetPackageSchema(c *gin.Context, catalogId string, name string, version string)
@@ -96,6 +156,49 @@ func (siw *ServerInterfaceWrapper) ListPackages(c *gin.Context) {
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListPackagesParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "continue" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "continue", c.Request.URL.Query(), &params.Continue)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter continue: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", c.Request.URL.Query(), &params.Search)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter search: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "version" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "version", c.Request.URL.Query(), &params.Version)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter version: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -103,7 +206,7 @@ func (siw *ServerInterfaceWrapper) ListPackages(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListPackages(c, catalogId)
+	siw.Handler.ListPackages(c, catalogId, params)
 }
 
 // GetPackage operation middleware
@@ -214,6 +317,128 @@ func (siw *ServerInterfaceWrapper) GetPackageDefinition(c *gin.Context) {
 	siw.Handler.GetPackageDefinition(c, catalogId, name, version)
 }
 
+// GetPackageJSONSchema operation middleware
+func (siw *ServerInterfaceWrapper) GetPackageJSONSchema(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "catalogId" -------------
+	var catalogId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "catalogId", c.Param("catalogId"), &catalogId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter catalogId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", c.Param("name"), &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "version" -------------
+	var version string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", c.Param("version"), &version, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter version: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPackageJSONSchemaParams
+
+	// ------------- Optional query parameter "section" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "section", c.Request.URL.Query(), &params.Section)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter section: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetPackageJSONSchema(c, catalogId, name, version, params)
+}
+
+// GetReleaseSkeleton operation middleware
+func (siw *ServerInterfaceWrapper) GetReleaseSkeleton(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "catalogId" -------------
+	var catalogId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "catalogId", c.Param("catalogId"), &catalogId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter catalogId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", c.Param("name"), &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "version" -------------
+	var version string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", c.Param("version"), &version, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter version: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetReleaseSkeletonParams
+
+	// ------------- Optional query parameter "releaseName" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "releaseName", c.Request.URL.Query(), &params.ReleaseName)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter releaseName: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", c.Request.URL.Query(), &params.Namespace)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter namespace: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "targetNamespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "targetNamespace", c.Request.URL.Query(), &params.TargetNamespace)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter targetNamespace: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetReleaseSkeleton(c, catalogId, name, version, params)
+}
+
 // GetPackageSchema operation middleware
 func (siw *ServerInterfaceWrapper) GetPackageSchema(c *gin.Context) {
 
@@ -289,5 +514,7 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	router.GET(options.BaseURL+"/catalogs/:catalogId/packages/:name", wrapper.GetPackage)
 	router.GET(options.BaseURL+"/catalogs/:catalogId/packages/:name/versions", wrapper.GetPackageVersions)
 	router.GET(options.BaseURL+"/catalogs/:catalogId/packages/:name/versions/:version", wrapper.GetPackageDefinition)
+	router.GET(options.BaseURL+"/catalogs/:catalogId/packages/:name/versions/:version/jsonschema", wrapper.GetPackageJSONSchema)
+	router.GET(options.BaseURL+"/catalogs/:catalogId/packages/:name/versions/:version/release-skeleton", wrapper.GetReleaseSkeleton)
 	router.GET(options.BaseURL+"/catalogs/:catalogId/packages/:name/versions/:version/schema", wrapper.GetPackageSchema)
 }
@@ -12,6 +12,14 @@ import (
 	"github.com/oapi-codegen/runtime"
 )
 
+// Defines values for ListNamespacesParamsSort.
+const (
+	CreationTimestamp      ListNamespacesParamsSort = "creationTimestamp"
+	MinusCreationTimestamp ListNamespacesParamsSort = "-creationTimestamp"
+	MinusName              ListNamespacesParamsSort = "-name"
+	Name                   ListNamespacesParamsSort = "name"
+)
+
 // Defines values for CreateNamespaceJSONBodyKind.
 const (
 	CreateNamespaceJSONBodyKindNamespace CreateNamespaceJSONBodyKind = "Namespace"
@@ -34,6 +42,30 @@ const (
 	UpdateNamespaceJSONBodyStatusPhaseTerminating UpdateNamespaceJSONBodyStatusPhase = "Terminating"
 )
 
+// ListNamespacesParams defines parameters for ListNamespaces.
+type ListNamespacesParams struct {
+	// Limit Maximum number of items to return. All the items are returned when not set.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Continue Opaque cursor returned in the X-Continue header of the previous page
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+
+	// LabelSelector Kubernetes label selector (ex. app=podinfo,tier!=frontend)
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+
+	// Search Case insensitive text search on the name and description of the items
+	Search *string `form:"search,omitempty" json:"search,omitempty"`
+
+	// Status Filter by namespace phase (Active, Terminating)
+	Status *string `form:"status,omitempty" json:"status,omitempty"`
+
+	// Sort Sort key, prefixed by '-' for a descending order
+	Sort *ListNamespacesParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// ListNamespacesParamsSort defines parameters for ListNamespaces.
+type ListNamespacesParamsSort string
+
 // CreateNamespaceJSONBody defines parameters for CreateNamespace.
 type CreateNamespaceJSONBody struct {
 	ApiVersion string                      `json:"apiVersion"`
@@ -43,6 +75,9 @@ type CreateNamespaceJSONBody struct {
 		CreationTimestamp *time.Time         `json:"creationTimestamp,omitempty"`
 		Labels            *map[string]string `json:"labels,omitempty"`
 		Name              string             `json:"name"`
+
+		// ResourceVersion Version of the namespace, returned in the ETag header of the project
+		ResourceVersion *string `json:"resourceVersion,omitempty"`
 	} `json:"metadata"`
 	Status *struct {
 		Phase *CreateNamespaceJSONBodyStatusPhase `json:"phase,omitempty"`
@@ -64,6 +99,9 @@ type UpdateNamespaceJSONBody struct {
 		CreationTimestamp *time.Time         `json:"creationTimestamp,omitempty"`
 		Labels            *map[string]string `json:"labels,omitempty"`
 		Name              string             `json:"name"`
+
+		// ResourceVersion Version of the namespace, returned in the ETag header of the project
+		ResourceVersion *string `json:"resourceVersion,omitempty"`
 	} `json:"metadata"`
 	Status *struct {
 		Phase *UpdateNamespaceJSONBodyStatusPhase `json:"phase,omitempty"`
@@ -92,7 +130,7 @@ type ServerInterface interface {
 	GetCluster(c *gin.Context, clusterId string)
 	// List all kubernetes namespaces
 	// (GET /clusters/{clusterId}/namespaces)
-	ListNamespaces(c *gin.Context, clusterId string)
+	ListNamespaces(c *gin.Context, clusterId string, params ListNamespacesParams)
 	// Create k8s namespace
 	// (POST /clusters/{clusterId}/namespaces)
 	CreateNamespace(c *gin.Context, clusterId string)
@@ -167,6 +205,57 @@ func (siw *ServerInterfaceWrapper) ListNamespaces(c *gin.Context) {
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListNamespacesParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "continue" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "continue", c.Request.URL.Query(), &params.Continue)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter continue: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "labelSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "labelSelector", c.Request.URL.Query(), &params.LabelSelector)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter labelSelector: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", c.Request.URL.Query(), &params.Search)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter search: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -174,7 +263,7 @@ func (siw *ServerInterfaceWrapper) ListNamespaces(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListNamespaces(c, clusterId)
+	siw.Handler.ListNamespaces(c, clusterId, params)
 }
 
 // CreateNamespace operation middleware
@@ -0,0 +1,319 @@
+// Package _inventory provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.4.1 DO NOT EDIT.
+package _inventory
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/oapi-codegen/runtime"
+)
+
+// GetProjectsInventoryParams defines parameters for GetProjectsInventory.
+type GetProjectsInventoryParams struct {
+	// Env Restrict the result to the clusters of an environment
+	Env *string `form:"env,omitempty" json:"env,omitempty"`
+
+	// Package Filter by package repository (case insensitive, partial match)
+	Package *string `form:"package,omitempty" json:"package,omitempty"`
+
+	// Tag Filter by package tag (version): an exact tag, or a semantic version constraint (e.g. 1.x, ">=1.2, <2").
+	// The constraints are checked against the version without the package revision suffix (6.7.1 for 6.7.1-p01).
+	Tag *string `form:"tag,omitempty" json:"tag,omitempty"`
+
+	// LabelSelector Kubernetes label selector (ex. app=podinfo,tier!=frontend)
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+
+	// Search Case insensitive text search on the name and description of the items
+	Search *string `form:"search,omitempty" json:"search,omitempty"`
+
+	// Timeout Timeout in seconds of the requests to each cluster, the clusters that do not answer in time are reported as failed
+	Timeout *int `form:"timeout,omitempty" json:"timeout,omitempty"`
+}
+
+// GetReleasesInventoryParams defines parameters for GetReleasesInventory.
+type GetReleasesInventoryParams struct {
+	// Env Restrict the result to the clusters of an environment
+	Env *string `form:"env,omitempty" json:"env,omitempty"`
+
+	// Package Filter by package repository (case insensitive, partial match)
+	Package *string `form:"package,omitempty" json:"package,omitempty"`
+
+	// Tag Filter by package tag (version): an exact tag, or a semantic version constraint (e.g. 1.x, ">=1.2, <2").
+	// The constraints are checked against the version without the package revision suffix (6.7.1 for 6.7.1-p01).
+	Tag *string `form:"tag,omitempty" json:"tag,omitempty"`
+
+	// LabelSelector Kubernetes label selector (ex. app=podinfo,tier!=frontend)
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+
+	// Search Case insensitive text search on the name and description of the items
+	Search *string `form:"search,omitempty" json:"search,omitempty"`
+
+	// Status Filter by release phase (READY, ERROR, WAIT_OCI, WAIT_REPO, WAIT_HREL, WAIT_DEPS, SUSPENDED)
+	Status *string `form:"status,omitempty" json:"status,omitempty"`
+
+	// Timeout Timeout in seconds of the requests to each cluster, the clusters that do not answer in time are reported as failed
+	Timeout *int `form:"timeout,omitempty" json:"timeout,omitempty"`
+}
+
+// GetVersionsInventoryParams defines parameters for GetVersionsInventory.
+type GetVersionsInventoryParams struct {
+	// Env Restrict the result to the clusters of an environment
+	Env *string `form:"env,omitempty" json:"env,omitempty"`
+
+	// Package Filter by package repository (case insensitive, partial match)
+	Package *string `form:"package,omitempty" json:"package,omitempty"`
+
+	// Tag Filter by package tag (version): an exact tag, or a semantic version constraint (e.g. 1.x, ">=1.2, <2").
+	// The constraints are checked against the version without the package revision suffix (6.7.1 for 6.7.1-p01).
+	Tag *string `form:"tag,omitempty" json:"tag,omitempty"`
+
+	// Timeout Timeout in seconds of the requests to each cluster, the clusters that do not answer in time are reported as failed
+	Timeout *int `form:"timeout,omitempty" json:"timeout,omitempty"`
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Get the projects of all the clusters, grouped by cluster
+	// (GET /inventory/projects)
+	GetProjectsInventory(c *gin.Context, params GetProjectsInventoryParams)
+	// Get the releases of all the clusters, grouped by cluster
+	// (GET /inventory/releases)
+	GetReleasesInventory(c *gin.Context, params GetReleasesInventoryParams)
+	// Get the package versions running on all the clusters, grouped by cluster
+	// (GET /inventory/versions)
+	GetVersionsInventory(c *gin.Context, params GetVersionsInventoryParams)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandler       func(*gin.Context, error, int)
+}
+
+type MiddlewareFunc func(c *gin.Context)
+
+// GetProjectsInventory operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectsInventory(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProjectsInventoryParams
+
+	// ------------- Optional query parameter "env" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "env", c.Request.URL.Query(), &params.Env)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter env: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "package" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "package", c.Request.URL.Query(), &params.Package)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter package: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "tag" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag", c.Request.URL.Query(), &params.Tag)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tag: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "labelSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "labelSelector", c.Request.URL.Query(), &params.LabelSelector)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter labelSelector: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", c.Request.URL.Query(), &params.Search)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter search: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "timeout" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "timeout", c.Request.URL.Query(), &params.Timeout)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter timeout: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetProjectsInventory(c, params)
+}
+
+// GetReleasesInventory operation middleware
+func (siw *ServerInterfaceWrapper) GetReleasesInventory(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetReleasesInventoryParams
+
+	// ------------- Optional query parameter "env" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "env", c.Request.URL.Query(), &params.Env)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter env: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "package" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "package", c.Request.URL.Query(), &params.Package)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter package: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "tag" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag", c.Request.URL.Query(), &params.Tag)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tag: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "labelSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "labelSelector", c.Request.URL.Query(), &params.LabelSelector)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter labelSelector: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", c.Request.URL.Query(), &params.Search)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter search: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "timeout" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "timeout", c.Request.URL.Query(), &params.Timeout)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter timeout: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetReleasesInventory(c, params)
+}
+
+// GetVersionsInventory operation middleware
+func (siw *ServerInterfaceWrapper) GetVersionsInventory(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetVersionsInventoryParams
+
+	// ------------- Optional query parameter "env" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "env", c.Request.URL.Query(), &params.Env)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter env: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "package" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "package", c.Request.URL.Query(), &params.Package)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter package: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "tag" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag", c.Request.URL.Query(), &params.Tag)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tag: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "timeout" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "timeout", c.Request.URL.Query(), &params.Timeout)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter timeout: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetVersionsInventory(c, params)
+}
+
+// GinServerOptions provides options for the Gin server.
+type GinServerOptions struct {
+	BaseURL      string
+	Middlewares  []MiddlewareFunc
+	ErrorHandler func(*gin.Context, error, int)
+}
+
+// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
+func RegisterHandlers(router gin.IRouter, si ServerInterface) {
+	RegisterHandlersWithOptions(router, si, GinServerOptions{})
+}
+
+// RegisterHandlersWithOptions creates http.Handler with additional options
+func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
+	errorHandler := options.ErrorHandler
+	if errorHandler == nil {
+		errorHandler = func(c *gin.Context, err error, statusCode int) {
+			c.JSON(statusCode, gin.H{"msg": err.Error()})
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandler:       errorHandler,
+	}
+
+	router.GET(options.BaseURL+"/inventory/projects", wrapper.GetProjectsInventory)
+	router.GET(options.BaseURL+"/inventory/releases", wrapper.GetReleasesInventory)
+	router.GET(options.BaseURL+"/inventory/versions", wrapper.GetVersionsInventory)
+}
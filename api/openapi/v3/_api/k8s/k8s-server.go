@@ -4,6 +4,7 @@
 package _k8s
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"time"
@@ -12,18 +13,34 @@ import (
 	"github.com/oapi-codegen/runtime"
 )
 
-// Defines values for CreateK8sReleaseJSONBodySpecPackageProvider.
+// Defines values for ListK8sReleasesParamsSort.
 const (
-	CreateK8sReleaseJSONBodySpecPackageProviderAws     CreateK8sReleaseJSONBodySpecPackageProvider = "aws"
-	CreateK8sReleaseJSONBodySpecPackageProviderAzure   CreateK8sReleaseJSONBodySpecPackageProvider = "azure"
-	CreateK8sReleaseJSONBodySpecPackageProviderGcp     CreateK8sReleaseJSONBodySpecPackageProvider = "gcp"
-	CreateK8sReleaseJSONBodySpecPackageProviderGeneric CreateK8sReleaseJSONBodySpecPackageProvider = "generic"
+	CreationTimestamp      ListK8sReleasesParamsSort = "creationTimestamp"
+	MinusCreationTimestamp ListK8sReleasesParamsSort = "-creationTimestamp"
+	MinusName              ListK8sReleasesParamsSort = "-name"
+	MinusNamespace         ListK8sReleasesParamsSort = "-namespace"
+	MinusPackage           ListK8sReleasesParamsSort = "-package"
+	MinusPhase             ListK8sReleasesParamsSort = "-phase"
+	MinusTag               ListK8sReleasesParamsSort = "-tag"
+	Name                   ListK8sReleasesParamsSort = "name"
+	Namespace              ListK8sReleasesParamsSort = "namespace"
+	Package                ListK8sReleasesParamsSort = "package"
+	Phase                  ListK8sReleasesParamsSort = "phase"
+	Tag                    ListK8sReleasesParamsSort = "tag"
 )
 
-// Defines values for CreateK8sReleaseJSONBodySpecPackageVerifyProvider.
+// Defines values for CreateK8sReleaseJSONBody1SpecPackageProvider.
 const (
-	CreateK8sReleaseJSONBodySpecPackageVerifyProviderCosign   CreateK8sReleaseJSONBodySpecPackageVerifyProvider = "cosign"
-	CreateK8sReleaseJSONBodySpecPackageVerifyProviderNotation CreateK8sReleaseJSONBodySpecPackageVerifyProvider = "notation"
+	CreateK8sReleaseJSONBody1SpecPackageProviderAws     CreateK8sReleaseJSONBody1SpecPackageProvider = "aws"
+	CreateK8sReleaseJSONBody1SpecPackageProviderAzure   CreateK8sReleaseJSONBody1SpecPackageProvider = "azure"
+	CreateK8sReleaseJSONBody1SpecPackageProviderGcp     CreateK8sReleaseJSONBody1SpecPackageProvider = "gcp"
+	CreateK8sReleaseJSONBody1SpecPackageProviderGeneric CreateK8sReleaseJSONBody1SpecPackageProvider = "generic"
+)
+
+// Defines values for CreateK8sReleaseJSONBody1SpecPackageVerifyProvider.
+const (
+	CreateK8sReleaseJSONBody1SpecPackageVerifyProviderCosign   CreateK8sReleaseJSONBody1SpecPackageVerifyProvider = "cosign"
+	CreateK8sReleaseJSONBody1SpecPackageVerifyProviderNotation CreateK8sReleaseJSONBody1SpecPackageVerifyProvider = "notation"
 )
 
 // Defines values for UpdateK8sReleaseJSONBodySpecPackageProvider.
@@ -40,8 +57,83 @@ const (
 	UpdateK8sReleaseJSONBodySpecPackageVerifyProviderNotation UpdateK8sReleaseJSONBodySpecPackageVerifyProvider = "notation"
 )
 
+// Defines values for PatchK8sReleaseApplicationJSONPatchPlusJSONBodyOp.
+const (
+	Add     PatchK8sReleaseApplicationJSONPatchPlusJSONBodyOp = "add"
+	Copy    PatchK8sReleaseApplicationJSONPatchPlusJSONBodyOp = "copy"
+	Move    PatchK8sReleaseApplicationJSONPatchPlusJSONBodyOp = "move"
+	Remove  PatchK8sReleaseApplicationJSONPatchPlusJSONBodyOp = "remove"
+	Replace PatchK8sReleaseApplicationJSONPatchPlusJSONBodyOp = "replace"
+	Test    PatchK8sReleaseApplicationJSONPatchPlusJSONBodyOp = "test"
+)
+
+// Defines values for RunK8sReleaseActionParamsAction.
+const (
+	Reconcile RunK8sReleaseActionParamsAction = "reconcile"
+	Resume    RunK8sReleaseActionParamsAction = "resume"
+	Suspend   RunK8sReleaseActionParamsAction = "suspend"
+)
+
+// Defines values for GetEventsReleaseParamsType.
+const (
+	Normal  GetEventsReleaseParamsType = "Normal"
+	Warning GetEventsReleaseParamsType = "Warning"
+)
+
+// Defines values for GetEventsReleaseParamsSort.
+const (
+	LastTimestamp      GetEventsReleaseParamsSort = "lastTimestamp"
+	MinusLastTimestamp GetEventsReleaseParamsSort = "-lastTimestamp"
+	MinusReason        GetEventsReleaseParamsSort = "-reason"
+	MinusType          GetEventsReleaseParamsSort = "-type"
+	Reason             GetEventsReleaseParamsSort = "reason"
+	Type               GetEventsReleaseParamsSort = "type"
+)
+
+// ListK8sReleasesParams defines parameters for ListK8sReleases.
+type ListK8sReleasesParams struct {
+	// Limit Maximum number of items to return. All the items are returned when not set.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Continue Opaque cursor returned in the X-Continue header of the previous page
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+
+	// LabelSelector Kubernetes label selector (ex. app=podinfo,tier!=frontend)
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+
+	// Search Case insensitive text search on the name and description of the items
+	Search *string `form:"search,omitempty" json:"search,omitempty"`
+
+	// Status Filter by release phase (READY, ERROR, WAIT_OCI, WAIT_REPO, WAIT_HREL, WAIT_DEPS, SUSPENDED)
+	Status *string `form:"status,omitempty" json:"status,omitempty"`
+
+	// Package Filter by package repository (case insensitive, partial match)
+	Package *string `form:"package,omitempty" json:"package,omitempty"`
+
+	// Tag Filter by package tag (version): an exact tag, or a semantic version constraint (e.g. 1.x, ">=1.2, <2").
+	// The constraints are checked against the version without the package revision suffix (6.7.1 for 6.7.1-p01).
+	Tag *string `form:"tag,omitempty" json:"tag,omitempty"`
+
+	// Sort Sort key, prefixed by '-' for a descending order
+	Sort *ListK8sReleasesParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// ListK8sReleasesParamsSort defines parameters for ListK8sReleases.
+type ListK8sReleasesParamsSort string
+
 // CreateK8sReleaseJSONBody defines parameters for CreateK8sRelease.
 type CreateK8sReleaseJSONBody struct {
+	union json.RawMessage
+}
+
+// CreateK8sReleaseParams defines parameters for CreateK8sRelease.
+type CreateK8sReleaseParams struct {
+	// DryRun If true, performs a server-side dry run without persisting the resource
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+}
+
+// CreateK8sReleaseJSONBody1 defines parameters for CreateK8sRelease.
+type CreateK8sReleaseJSONBody1 = []struct {
 	// ApiVersion APIVersion defines the versioned schema of this representation of an object.
 	// Servers should convert recognized schemas to the latest internal value, and
 	// may reject unrecognized values.
@@ -185,7 +277,7 @@ type CreateK8sReleaseJSONBody struct {
 			// The provider used for authentication, can be 'aws', 'azure', 'gcp' or 'generic'.
 			// When not specified, defaults to 'generic'.
 			// -kubebuilder:default:=generic
-			Provider *CreateK8sReleaseJSONBodySpecPackageProvider `json:"provider,omitempty"`
+			Provider *CreateK8sReleaseJSONBody1SpecPackageProvider `json:"provider,omitempty"`
 
 			// ProxySecretRef ProxySecretRef specifies the Secret containing the proxy configuration
 			// to use while communicating with the container registry.
@@ -240,7 +332,7 @@ type CreateK8sReleaseJSONBody struct {
 				} `json:"matchOIDCIdentity,omitempty"`
 
 				// Provider Provider specifies the technology used to sign the OCI Artifact.
-				Provider CreateK8sReleaseJSONBodySpecPackageVerifyProvider `json:"provider"`
+				Provider CreateK8sReleaseJSONBody1SpecPackageVerifyProvider `json:"provider"`
 
 				// SecretRef SecretRef specifies the Kubernetes Secret containing the
 				// trusted public keys.
@@ -330,17 +422,11 @@ type CreateK8sReleaseJSONBody struct {
 	} `json:"status,omitempty"`
 }
 
-// CreateK8sReleaseParams defines parameters for CreateK8sRelease.
-type CreateK8sReleaseParams struct {
-	// DryRun If true, performs a server-side dry run without persisting the resource
-	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
-}
-
-// CreateK8sReleaseJSONBodySpecPackageProvider defines parameters for CreateK8sRelease.
-type CreateK8sReleaseJSONBodySpecPackageProvider string
+// CreateK8sReleaseJSONBody1SpecPackageProvider defines parameters for CreateK8sRelease.
+type CreateK8sReleaseJSONBody1SpecPackageProvider string
 
-// CreateK8sReleaseJSONBodySpecPackageVerifyProvider defines parameters for CreateK8sRelease.
-type CreateK8sReleaseJSONBodySpecPackageVerifyProvider string
+// CreateK8sReleaseJSONBody1SpecPackageVerifyProvider defines parameters for CreateK8sRelease.
+type CreateK8sReleaseJSONBody1SpecPackageVerifyProvider string
 
 // UpdateK8sReleaseJSONBody defines parameters for UpdateK8sRelease.
 type UpdateK8sReleaseJSONBody struct {
@@ -636,6 +722,11 @@ type UpdateK8sReleaseJSONBody struct {
 type UpdateK8sReleaseParams struct {
 	// DryRun If true, performs a server-side dry run without persisting the resource
 	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+
+	// IfMatch ETag returned by the GET of the resource. The modification is rejected with 412 (Precondition Failed)
+	// if the resource changed in the meantime. '*' only requires the resource to exist. A list of ETags is
+	// rejected with 400 (Bad Request).
+	IfMatch *string `json:"If-Match,omitempty"`
 }
 
 // UpdateK8sReleaseJSONBodySpecPackageProvider defines parameters for UpdateK8sRelease.
@@ -644,17 +735,113 @@ type UpdateK8sReleaseJSONBodySpecPackageProvider string
 // UpdateK8sReleaseJSONBodySpecPackageVerifyProvider defines parameters for UpdateK8sRelease.
 type UpdateK8sReleaseJSONBodySpecPackageVerifyProvider string
 
+// DeleteK8sReleaseParams defines parameters for DeleteK8sRelease.
+type DeleteK8sReleaseParams struct {
+	// IfMatch ETag returned by the GET of the resource. The modification is rejected with 412 (Precondition Failed)
+	// if the resource changed in the meantime. '*' only requires the resource to exist. A list of ETags is
+	// rejected with 400 (Bad Request).
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// PatchK8sReleaseApplicationJSONPatchPlusJSONBody defines parameters for PatchK8sRelease.
+type PatchK8sReleaseApplicationJSONPatchPlusJSONBody = []struct {
+	// From JSON Pointer to the source location of the move and copy operations
+	From *string `json:"from,omitempty"`
+
+	// Op The operation to perform
+	Op PatchK8sReleaseApplicationJSONPatchPlusJSONBodyOp `json:"op"`
+
+	// Path JSON Pointer (RFC 6901) to the target location
+	Path string `json:"path"`
+
+	// Value The value of the add, replace and test operations
+	Value *interface{} `json:"value,omitempty"`
+}
+
+// PatchK8sReleaseApplicationMergePatchPlusJSONBody defines parameters for PatchK8sRelease.
+type PatchK8sReleaseApplicationMergePatchPlusJSONBody = map[string]interface{}
+
+// PatchK8sReleaseParams defines parameters for PatchK8sRelease.
+type PatchK8sReleaseParams struct {
+	// DryRun If true, performs a server-side dry run without persisting the resource
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+
+	// IfMatch ETag returned by the GET of the resource. The modification is rejected with 412 (Precondition Failed)
+	// if the resource changed in the meantime. '*' only requires the resource to exist. A list of ETags is
+	// rejected with 400 (Bad Request).
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// PatchK8sReleaseApplicationJSONPatchPlusJSONBodyOp defines parameters for PatchK8sRelease.
+type PatchK8sReleaseApplicationJSONPatchPlusJSONBodyOp string
+
+// RunK8sReleaseActionParams defines parameters for RunK8sReleaseAction.
+type RunK8sReleaseActionParams struct {
+	// Wait If true, wait for the resource to be reconciled (Ready or failed) and return the outcome
+	Wait *bool `form:"wait,omitempty" json:"wait,omitempty"`
+
+	// WaitTimeout Maximum time in seconds to wait for the reconciliation, when wait is true
+	WaitTimeout *int `form:"waitTimeout,omitempty" json:"waitTimeout,omitempty"`
+}
+
+// RunK8sReleaseActionParamsAction defines parameters for RunK8sReleaseAction.
+type RunK8sReleaseActionParamsAction string
+
+// GetEventsReleaseParams defines parameters for GetEventsRelease.
+type GetEventsReleaseParams struct {
+	// Limit Maximum number of items to return. All the items are returned when not set.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Continue Opaque cursor returned in the X-Continue header of the previous page
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+
+	// Search Case insensitive text search on the name and description of the items
+	Search *string `form:"search,omitempty" json:"search,omitempty"`
+
+	// Type Filter by event type
+	Type *GetEventsReleaseParamsType `form:"type,omitempty" json:"type,omitempty"`
+
+	// Sort Sort key, prefixed by '-' for a descending order
+	Sort *GetEventsReleaseParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// GetEventsReleaseParamsType defines parameters for GetEventsRelease.
+type GetEventsReleaseParamsType string
+
+// GetEventsReleaseParamsSort defines parameters for GetEventsRelease.
+type GetEventsReleaseParamsSort string
+
+// RollbackK8sReleaseParams defines parameters for RollbackK8sRelease.
+type RollbackK8sReleaseParams struct {
+	// Revision Revision of the release to roll back to (see the history of the release)
+	Revision int `form:"revision" json:"revision"`
+
+	// DryRun If true, performs a server-side dry run without persisting the resource
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+
+	// IfMatch ETag returned by the GET of the resource. The modification is rejected with 412 (Precondition Failed)
+	// if the resource changed in the meantime. '*' only requires the resource to exist. A list of ETags is
+	// rejected with 400 (Bad Request).
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
 // CreateK8sReleaseJSONRequestBody defines body for CreateK8sRelease for application/json ContentType.
 type CreateK8sReleaseJSONRequestBody CreateK8sReleaseJSONBody
 
 // UpdateK8sReleaseJSONRequestBody defines body for UpdateK8sRelease for application/json ContentType.
 type UpdateK8sReleaseJSONRequestBody UpdateK8sReleaseJSONBody
 
+// PatchK8sReleaseApplicationJSONPatchPlusJSONRequestBody defines body for PatchK8sRelease for application/json-patch+json ContentType.
+type PatchK8sReleaseApplicationJSONPatchPlusJSONRequestBody = PatchK8sReleaseApplicationJSONPatchPlusJSONBody
+
+// PatchK8sReleaseApplicationMergePatchPlusJSONRequestBody defines body for PatchK8sRelease for application/merge-patch+json ContentType.
+type PatchK8sReleaseApplicationMergePatchPlusJSONRequestBody = PatchK8sReleaseApplicationMergePatchPlusJSONBody
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// Get the list of the deployed releases
 	// (GET /clusters/{clusterId}/namespaces/{namespace}/releases)
-	ListK8sReleases(c *gin.Context, clusterId string, namespace string)
+	ListK8sReleases(c *gin.Context, clusterId string, namespace string, params ListK8sReleasesParams)
 	// Create KuboCD release in Kubernetes
 	// (POST /clusters/{clusterId}/namespaces/{namespace}/releases)
 	CreateK8sRelease(c *gin.Context, clusterId string, namespace string, params CreateK8sReleaseParams)
@@ -663,19 +850,40 @@ type ServerInterface interface {
 	UpdateK8sRelease(c *gin.Context, clusterId string, namespace string, params UpdateK8sReleaseParams)
 	// Delete the KuboCD release in kubernetes
 	// (DELETE /clusters/{clusterId}/namespaces/{namespace}/releases/{releaseName})
-	DeleteK8sRelease(c *gin.Context, clusterId string, namespace string, releaseName string)
+	DeleteK8sRelease(c *gin.Context, clusterId string, namespace string, releaseName string, params DeleteK8sReleaseParams)
 	// Get the deployed release by name
 	// (GET /clusters/{clusterId}/namespaces/{namespace}/releases/{releaseName})
 	GetK8sRelease(c *gin.Context, clusterId string, namespace string, releaseName string)
+	// Patch the deployed KuboCD release
+	// (PATCH /clusters/{clusterId}/namespaces/{namespace}/releases/{releaseName})
+	PatchK8sRelease(c *gin.Context, clusterId string, namespace string, releaseName string, params PatchK8sReleaseParams)
+	// Suspend, resume or reconcile the deployed KuboCD release
+	// (POST /clusters/{clusterId}/namespaces/{namespace}/releases/{releaseName}/actions/{action})
+	RunK8sReleaseAction(c *gin.Context, clusterId string, namespace string, releaseName string, action RunK8sReleaseActionParamsAction, params RunK8sReleaseActionParams)
 	// Get Kubernetes events for a release
 	// (GET /clusters/{clusterId}/namespaces/{namespace}/releases/{releaseName}/events)
-	GetEventsRelease(c *gin.Context, clusterId string, namespace string, releaseName string)
+	GetEventsRelease(c *gin.Context, clusterId string, namespace string, releaseName string, params GetEventsReleaseParams)
+	// Get the aggregated release health
+	// (GET /clusters/{clusterId}/namespaces/{namespace}/releases/{releaseName}/health)
+	GetK8sReleaseHealth(c *gin.Context, clusterId string, namespace string, releaseName string)
+	// Get the revision history of the deployed KuboCD release
+	// (GET /clusters/{clusterId}/namespaces/{namespace}/releases/{releaseName}/history)
+	GetK8sReleaseHistory(c *gin.Context, clusterId string, namespace string, releaseName string)
 	// Get the list of pods and their containers attached to a release
 	// (GET /clusters/{clusterId}/namespaces/{namespace}/releases/{releaseName}/pods)
 	GetPods(c *gin.Context, clusterId string, namespace string, releaseName string)
+	// Get the release resource tree
+	// (GET /clusters/{clusterId}/namespaces/{namespace}/releases/{releaseName}/resource-tree)
+	GetK8sReleaseResourceTree(c *gin.Context, clusterId string, namespace string, releaseName string)
+	// Roll back the deployed KuboCD release to a previous revision
+	// (POST /clusters/{clusterId}/namespaces/{namespace}/releases/{releaseName}/rollback)
+	RollbackK8sRelease(c *gin.Context, clusterId string, namespace string, releaseName string, params RollbackK8sReleaseParams)
 	// Get the release status
 	// (GET /clusters/{clusterId}/namespaces/{namespace}/releases/{releaseName}/status)
 	GetK8sReleaseStatus(c *gin.Context, clusterId string, namespace string, releaseName string)
+	// Get the outdated releases of a cluster
+	// (GET /clusters/{clusterId}/outdated-releases)
+	ListOutdatedReleases(c *gin.Context, clusterId string)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -710,6 +918,73 @@ func (siw *ServerInterfaceWrapper) ListK8sReleases(c *gin.Context) {
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListK8sReleasesParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "continue" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "continue", c.Request.URL.Query(), &params.Continue)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter continue: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "labelSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "labelSelector", c.Request.URL.Query(), &params.LabelSelector)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter labelSelector: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", c.Request.URL.Query(), &params.Search)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter search: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "package" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "package", c.Request.URL.Query(), &params.Package)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter package: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "tag" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag", c.Request.URL.Query(), &params.Tag)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tag: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -717,7 +992,7 @@ func (siw *ServerInterfaceWrapper) ListK8sReleases(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListK8sReleases(c, clusterId, namespace)
+	siw.Handler.ListK8sReleases(c, clusterId, namespace, params)
 }
 
 // CreateK8sRelease operation middleware
@@ -798,6 +1073,27 @@ func (siw *ServerInterfaceWrapper) UpdateK8sRelease(c *gin.Context) {
 		return
 	}
 
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-Match, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-Match: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -840,6 +1136,30 @@ func (siw *ServerInterfaceWrapper) DeleteK8sRelease(c *gin.Context) {
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteK8sReleaseParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-Match, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-Match: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -847,7 +1167,7 @@ func (siw *ServerInterfaceWrapper) DeleteK8sRelease(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteK8sRelease(c, clusterId, namespace, releaseName)
+	siw.Handler.DeleteK8sRelease(c, clusterId, namespace, releaseName, params)
 }
 
 // GetK8sRelease operation middleware
@@ -892,6 +1212,150 @@ func (siw *ServerInterfaceWrapper) GetK8sRelease(c *gin.Context) {
 	siw.Handler.GetK8sRelease(c, clusterId, namespace, releaseName)
 }
 
+// PatchK8sRelease operation middleware
+func (siw *ServerInterfaceWrapper) PatchK8sRelease(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "clusterId" -------------
+	var clusterId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clusterId", c.Param("clusterId"), &clusterId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter clusterId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", c.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter namespace: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "releaseName" -------------
+	var releaseName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "releaseName", c.Param("releaseName"), &releaseName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter releaseName: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchK8sReleaseParams
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", c.Request.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dryRun: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-Match, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-Match: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PatchK8sRelease(c, clusterId, namespace, releaseName, params)
+}
+
+// RunK8sReleaseAction operation middleware
+func (siw *ServerInterfaceWrapper) RunK8sReleaseAction(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "clusterId" -------------
+	var clusterId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clusterId", c.Param("clusterId"), &clusterId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter clusterId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", c.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter namespace: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "releaseName" -------------
+	var releaseName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "releaseName", c.Param("releaseName"), &releaseName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter releaseName: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "action" -------------
+	var action RunK8sReleaseActionParamsAction
+
+	err = runtime.BindStyledParameterWithOptions("simple", "action", c.Param("action"), &action, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter action: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RunK8sReleaseActionParams
+
+	// ------------- Optional query parameter "wait" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "wait", c.Request.URL.Query(), &params.Wait)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter wait: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "waitTimeout" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "waitTimeout", c.Request.URL.Query(), &params.WaitTimeout)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter waitTimeout: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RunK8sReleaseAction(c, clusterId, namespace, releaseName, action, params)
+}
+
 // GetEventsRelease operation middleware
 func (siw *ServerInterfaceWrapper) GetEventsRelease(c *gin.Context) {
 
@@ -924,6 +1388,91 @@ func (siw *ServerInterfaceWrapper) GetEventsRelease(c *gin.Context) {
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEventsReleaseParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "continue" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "continue", c.Request.URL.Query(), &params.Continue)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter continue: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", c.Request.URL.Query(), &params.Search)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter search: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", c.Request.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter type: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetEventsRelease(c, clusterId, namespace, releaseName, params)
+}
+
+// GetK8sReleaseHealth operation middleware
+func (siw *ServerInterfaceWrapper) GetK8sReleaseHealth(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "clusterId" -------------
+	var clusterId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clusterId", c.Param("clusterId"), &clusterId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter clusterId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", c.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter namespace: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "releaseName" -------------
+	var releaseName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "releaseName", c.Param("releaseName"), &releaseName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter releaseName: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -931,7 +1480,49 @@ func (siw *ServerInterfaceWrapper) GetEventsRelease(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetEventsRelease(c, clusterId, namespace, releaseName)
+	siw.Handler.GetK8sReleaseHealth(c, clusterId, namespace, releaseName)
+}
+
+// GetK8sReleaseHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetK8sReleaseHistory(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "clusterId" -------------
+	var clusterId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clusterId", c.Param("clusterId"), &clusterId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter clusterId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", c.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter namespace: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "releaseName" -------------
+	var releaseName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "releaseName", c.Param("releaseName"), &releaseName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter releaseName: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetK8sReleaseHistory(c, clusterId, namespace, releaseName)
 }
 
 // GetPods operation middleware
@@ -976,6 +1567,137 @@ func (siw *ServerInterfaceWrapper) GetPods(c *gin.Context) {
 	siw.Handler.GetPods(c, clusterId, namespace, releaseName)
 }
 
+// GetK8sReleaseResourceTree operation middleware
+func (siw *ServerInterfaceWrapper) GetK8sReleaseResourceTree(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "clusterId" -------------
+	var clusterId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clusterId", c.Param("clusterId"), &clusterId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter clusterId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", c.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter namespace: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "releaseName" -------------
+	var releaseName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "releaseName", c.Param("releaseName"), &releaseName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter releaseName: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetK8sReleaseResourceTree(c, clusterId, namespace, releaseName)
+}
+
+// RollbackK8sRelease operation middleware
+func (siw *ServerInterfaceWrapper) RollbackK8sRelease(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "clusterId" -------------
+	var clusterId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clusterId", c.Param("clusterId"), &clusterId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter clusterId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", c.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter namespace: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "releaseName" -------------
+	var releaseName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "releaseName", c.Param("releaseName"), &releaseName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter releaseName: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RollbackK8sReleaseParams
+
+	// ------------- Required query parameter "revision" -------------
+
+	if paramValue := c.Query("revision"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument revision is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "revision", c.Request.URL.Query(), &params.Revision)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter revision: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", c.Request.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dryRun: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-Match, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-Match: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RollbackK8sRelease(c, clusterId, namespace, releaseName, params)
+}
+
 // GetK8sReleaseStatus operation middleware
 func (siw *ServerInterfaceWrapper) GetK8sReleaseStatus(c *gin.Context) {
 
@@ -1018,6 +1740,30 @@ func (siw *ServerInterfaceWrapper) GetK8sReleaseStatus(c *gin.Context) {
 	siw.Handler.GetK8sReleaseStatus(c, clusterId, namespace, releaseName)
 }
 
+// ListOutdatedReleases operation middleware
+func (siw *ServerInterfaceWrapper) ListOutdatedReleases(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "clusterId" -------------
+	var clusterId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clusterId", c.Param("clusterId"), &clusterId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter clusterId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListOutdatedReleases(c, clusterId)
+}
+
 // GinServerOptions provides options for the Gin server.
 type GinServerOptions struct {
 	BaseURL      string
@@ -1050,7 +1796,14 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	router.PUT(options.BaseURL+"/clusters/:clusterId/namespaces/:namespace/releases", wrapper.UpdateK8sRelease)
 	router.DELETE(options.BaseURL+"/clusters/:clusterId/namespaces/:namespace/releases/:releaseName", wrapper.DeleteK8sRelease)
 	router.GET(options.BaseURL+"/clusters/:clusterId/namespaces/:namespace/releases/:releaseName", wrapper.GetK8sRelease)
+	router.PATCH(options.BaseURL+"/clusters/:clusterId/namespaces/:namespace/releases/:releaseName", wrapper.PatchK8sRelease)
+	router.POST(options.BaseURL+"/clusters/:clusterId/namespaces/:namespace/releases/:releaseName/actions/:action", wrapper.RunK8sReleaseAction)
 	router.GET(options.BaseURL+"/clusters/:clusterId/namespaces/:namespace/releases/:releaseName/events", wrapper.GetEventsRelease)
+	router.GET(options.BaseURL+"/clusters/:clusterId/namespaces/:namespace/releases/:releaseName/health", wrapper.GetK8sReleaseHealth)
+	router.GET(options.BaseURL+"/clusters/:clusterId/namespaces/:namespace/releases/:releaseName/history", wrapper.GetK8sReleaseHistory)
 	router.GET(options.BaseURL+"/clusters/:clusterId/namespaces/:namespace/releases/:releaseName/pods", wrapper.GetPods)
+	router.GET(options.BaseURL+"/clusters/:clusterId/namespaces/:namespace/releases/:releaseName/resource-tree", wrapper.GetK8sReleaseResourceTree)
+	router.POST(options.BaseURL+"/clusters/:clusterId/namespaces/:namespace/releases/:releaseName/rollback", wrapper.RollbackK8sRelease)
 	router.GET(options.BaseURL+"/clusters/:clusterId/namespaces/:namespace/releases/:releaseName/status", wrapper.GetK8sReleaseStatus)
+	router.GET(options.BaseURL+"/clusters/:clusterId/outdated-releases", wrapper.ListOutdatedReleases)
 }
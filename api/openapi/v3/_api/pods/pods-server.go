@@ -20,11 +20,23 @@ type GetLogsParams struct {
 	TailLines *int `form:"tailLines,omitempty" json:"tailLines,omitempty"`
 }
 
+// ExecPodParams defines parameters for ExecPod.
+type ExecPodParams struct {
+	// Command The command to run and its arguments, one query parameter per argument.
+	Command *[]string `form:"command,omitempty" json:"command,omitempty"`
+
+	// Tty If true, allocates a TTY. The stderr is then merged into the stdout.
+	Tty *bool `form:"tty,omitempty" json:"tty,omitempty"`
+}
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// Get the logs
 	// (GET /clusters/{clusterId}/namespaces/{namespace}/pods/{pod}/containers/{container}/logs)
 	GetLogs(c *gin.Context, clusterId string, namespace string, pod string, container string, params GetLogsParams)
+	// Open an exec session into a container
+	// (GET /clusters/{clusterId}/namespaces/{namespace}/releases/{releaseName}/pods/{pod}/containers/{container}/exec)
+	ExecPod(c *gin.Context, clusterId string, namespace string, releaseName string, pod string, container string, params ExecPodParams)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -106,6 +118,85 @@ func (siw *ServerInterfaceWrapper) GetLogs(c *gin.Context) {
 	siw.Handler.GetLogs(c, clusterId, namespace, pod, container, params)
 }
 
+// ExecPod operation middleware
+func (siw *ServerInterfaceWrapper) ExecPod(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "clusterId" -------------
+	var clusterId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clusterId", c.Param("clusterId"), &clusterId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter clusterId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", c.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter namespace: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "releaseName" -------------
+	var releaseName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "releaseName", c.Param("releaseName"), &releaseName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter releaseName: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "pod" -------------
+	var pod string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pod", c.Param("pod"), &pod, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pod: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "container" -------------
+	var container string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "container", c.Param("container"), &container, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter container: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExecPodParams
+
+	// ------------- Optional query parameter "command" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "command", c.Request.URL.Query(), &params.Command)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter command: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "tty" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tty", c.Request.URL.Query(), &params.Tty)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tty: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ExecPod(c, clusterId, namespace, releaseName, pod, container, params)
+}
+
 // GinServerOptions provides options for the Gin server.
 type GinServerOptions struct {
 	BaseURL      string
@@ -134,4 +225,5 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	}
 
 	router.GET(options.BaseURL+"/clusters/:clusterId/namespaces/:namespace/pods/:pod/containers/:container/logs", wrapper.GetLogs)
+	router.GET(options.BaseURL+"/clusters/:clusterId/namespaces/:namespace/releases/:releaseName/pods/:pod/containers/:container/exec", wrapper.ExecPod)
 }
@@ -12,6 +12,14 @@ import (
 	"github.com/oapi-codegen/runtime"
 )
 
+// Defines values for ListProjectsParamsSort.
+const (
+	CreationTimestamp      ListProjectsParamsSort = "creationTimestamp"
+	MinusCreationTimestamp ListProjectsParamsSort = "-creationTimestamp"
+	MinusName              ListProjectsParamsSort = "-name"
+	Name                   ListProjectsParamsSort = "name"
+)
+
 // Defines values for CreateProjectJSONBodyStatus.
 const (
 	CreateProjectJSONBodyStatusActive      CreateProjectJSONBodyStatus = "Active"
@@ -24,6 +32,40 @@ const (
 	UpdateProjectJSONBodyStatusTerminating UpdateProjectJSONBodyStatus = "Terminating"
 )
 
+// Defines values for PatchProjectApplicationJSONPatchPlusJSONBodyOp.
+const (
+	Add     PatchProjectApplicationJSONPatchPlusJSONBodyOp = "add"
+	Copy    PatchProjectApplicationJSONPatchPlusJSONBodyOp = "copy"
+	Move    PatchProjectApplicationJSONPatchPlusJSONBodyOp = "move"
+	Remove  PatchProjectApplicationJSONPatchPlusJSONBodyOp = "remove"
+	Replace PatchProjectApplicationJSONPatchPlusJSONBodyOp = "replace"
+	Test    PatchProjectApplicationJSONPatchPlusJSONBodyOp = "test"
+)
+
+// ListProjectsParams defines parameters for ListProjects.
+type ListProjectsParams struct {
+	// Limit Maximum number of items to return. All the items are returned when not set.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Continue Opaque cursor returned in the X-Continue header of the previous page
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+
+	// LabelSelector Kubernetes label selector (ex. app=podinfo,tier!=frontend)
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+
+	// Search Case insensitive text search on the name and description of the items
+	Search *string `form:"search,omitempty" json:"search,omitempty"`
+
+	// Status Filter by namespace phase (Active, Terminating)
+	Status *string `form:"status,omitempty" json:"status,omitempty"`
+
+	// Sort Sort key, prefixed by '-' for a descending order
+	Sort *ListProjectsParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// ListProjectsParamsSort defines parameters for ListProjects.
+type ListProjectsParamsSort string
+
 // CreateProjectJSONBody defines parameters for CreateProject.
 type CreateProjectJSONBody struct {
 	CreationTimestamp *time.Time                   `json:"creationTimestamp,omitempty"`
@@ -47,32 +89,95 @@ type UpdateProjectJSONBody struct {
 	Status            *UpdateProjectJSONBodyStatus `json:"status,omitempty"`
 }
 
+// UpdateProjectParams defines parameters for UpdateProject.
+type UpdateProjectParams struct {
+	// IfMatch ETag returned by the GET of the resource. The modification is rejected with 412 (Precondition Failed)
+	// if the resource changed in the meantime. '*' only requires the resource to exist. A list of ETags is
+	// rejected with 400 (Bad Request).
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
 // UpdateProjectJSONBodyStatus defines parameters for UpdateProject.
 type UpdateProjectJSONBodyStatus string
 
+// DeleteProjectParams defines parameters for DeleteProject.
+type DeleteProjectParams struct {
+	// IfMatch ETag returned by the GET of the resource. The modification is rejected with 412 (Precondition Failed)
+	// if the resource changed in the meantime. '*' only requires the resource to exist. A list of ETags is
+	// rejected with 400 (Bad Request).
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// PatchProjectApplicationJSONPatchPlusJSONBody defines parameters for PatchProject.
+type PatchProjectApplicationJSONPatchPlusJSONBody = []struct {
+	// From JSON Pointer to the source location of the move and copy operations
+	From *string `json:"from,omitempty"`
+
+	// Op The operation to perform
+	Op PatchProjectApplicationJSONPatchPlusJSONBodyOp `json:"op"`
+
+	// Path JSON Pointer (RFC 6901) to the target location
+	Path string `json:"path"`
+
+	// Value The value of the add, replace and test operations
+	Value *interface{} `json:"value,omitempty"`
+}
+
+// PatchProjectApplicationMergePatchPlusJSONBody defines parameters for PatchProject.
+type PatchProjectApplicationMergePatchPlusJSONBody = map[string]interface{}
+
+// PatchProjectParams defines parameters for PatchProject.
+type PatchProjectParams struct {
+	// DryRun If true, returns the patched project without persisting it
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+
+	// IfMatch ETag returned by the GET of the resource. The modification is rejected with 412 (Precondition Failed)
+	// if the resource changed in the meantime. '*' only requires the resource to exist. A list of ETags is
+	// rejected with 400 (Bad Request).
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// PatchProjectApplicationJSONPatchPlusJSONBodyOp defines parameters for PatchProject.
+type PatchProjectApplicationJSONPatchPlusJSONBodyOp string
+
 // CreateProjectJSONRequestBody defines body for CreateProject for application/json ContentType.
 type CreateProjectJSONRequestBody CreateProjectJSONBody
 
 // UpdateProjectJSONRequestBody defines body for UpdateProject for application/json ContentType.
 type UpdateProjectJSONRequestBody UpdateProjectJSONBody
 
+// PatchProjectApplicationJSONPatchPlusJSONRequestBody defines body for PatchProject for application/json-patch+json ContentType.
+type PatchProjectApplicationJSONPatchPlusJSONRequestBody = PatchProjectApplicationJSONPatchPlusJSONBody
+
+// PatchProjectApplicationMergePatchPlusJSONRequestBody defines body for PatchProject for application/merge-patch+json ContentType.
+type PatchProjectApplicationMergePatchPlusJSONRequestBody = PatchProjectApplicationMergePatchPlusJSONBody
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// List all projects
 	// (GET /clusters/{clusterId}/projects)
-	ListProjects(c *gin.Context, clusterId string)
+	ListProjects(c *gin.Context, clusterId string, params ListProjectsParams)
 	// Create OKDP project
 	// (POST /clusters/{clusterId}/projects)
 	CreateProject(c *gin.Context, clusterId string)
 	// Update an existing OKDP project
 	// (PUT /clusters/{clusterId}/projects)
-	UpdateProject(c *gin.Context, clusterId string)
+	UpdateProject(c *gin.Context, clusterId string, params UpdateProjectParams)
 	// Delete an existing OKDP project
 	// (DELETE /clusters/{clusterId}/projects/{projectName})
-	DeleteProject(c *gin.Context, clusterId string, projectName string)
+	DeleteProject(c *gin.Context, clusterId string, projectName string, params DeleteProjectParams)
 	// Get a project by name
 	// (GET /clusters/{clusterId}/projects/{projectName})
 	GetProject(c *gin.Context, clusterId string, projectName string)
+	// Patch an existing OKDP project
+	// (PATCH /clusters/{clusterId}/projects/{projectName})
+	PatchProject(c *gin.Context, clusterId string, projectName string, params PatchProjectParams)
+	// Get the aggregated health of a project
+	// (GET /clusters/{clusterId}/projects/{projectName}/health)
+	GetProjectHealth(c *gin.Context, clusterId string, projectName string)
+	// Get the outdated releases of a project
+	// (GET /clusters/{clusterId}/projects/{projectName}/outdated-releases)
+	ListProjectOutdatedReleases(c *gin.Context, clusterId string, projectName string)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -98,6 +203,57 @@ func (siw *ServerInterfaceWrapper) ListProjects(c *gin.Context) {
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListProjectsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "continue" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "continue", c.Request.URL.Query(), &params.Continue)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter continue: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "labelSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "labelSelector", c.Request.URL.Query(), &params.LabelSelector)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter labelSelector: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", c.Request.URL.Query(), &params.Search)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter search: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -105,7 +261,7 @@ func (siw *ServerInterfaceWrapper) ListProjects(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListProjects(c, clusterId)
+	siw.Handler.ListProjects(c, clusterId, params)
 }
 
 // CreateProject operation middleware
@@ -146,6 +302,30 @@ func (siw *ServerInterfaceWrapper) UpdateProject(c *gin.Context) {
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateProjectParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-Match, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-Match: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -153,7 +333,7 @@ func (siw *ServerInterfaceWrapper) UpdateProject(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.UpdateProject(c, clusterId)
+	siw.Handler.UpdateProject(c, clusterId, params)
 }
 
 // DeleteProject operation middleware
@@ -179,6 +359,30 @@ func (siw *ServerInterfaceWrapper) DeleteProject(c *gin.Context) {
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteProjectParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-Match, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-Match: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -186,7 +390,7 @@ func (siw *ServerInterfaceWrapper) DeleteProject(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteProject(c, clusterId, projectName)
+	siw.Handler.DeleteProject(c, clusterId, projectName, params)
 }
 
 // GetProject operation middleware
@@ -222,6 +426,137 @@ func (siw *ServerInterfaceWrapper) GetProject(c *gin.Context) {
 	siw.Handler.GetProject(c, clusterId, projectName)
 }
 
+// PatchProject operation middleware
+func (siw *ServerInterfaceWrapper) PatchProject(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "clusterId" -------------
+	var clusterId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clusterId", c.Param("clusterId"), &clusterId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter clusterId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", c.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter projectName: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchProjectParams
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", c.Request.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dryRun: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-Match, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-Match: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PatchProject(c, clusterId, projectName, params)
+}
+
+// GetProjectHealth operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectHealth(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "clusterId" -------------
+	var clusterId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clusterId", c.Param("clusterId"), &clusterId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter clusterId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", c.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter projectName: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetProjectHealth(c, clusterId, projectName)
+}
+
+// ListProjectOutdatedReleases operation middleware
+func (siw *ServerInterfaceWrapper) ListProjectOutdatedReleases(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "clusterId" -------------
+	var clusterId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clusterId", c.Param("clusterId"), &clusterId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter clusterId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", c.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter projectName: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListProjectOutdatedReleases(c, clusterId, projectName)
+}
+
 // GinServerOptions provides options for the Gin server.
 type GinServerOptions struct {
 	BaseURL      string
@@ -254,4 +589,7 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	router.PUT(options.BaseURL+"/clusters/:clusterId/projects", wrapper.UpdateProject)
 	router.DELETE(options.BaseURL+"/clusters/:clusterId/projects/:projectName", wrapper.DeleteProject)
 	router.GET(options.BaseURL+"/clusters/:clusterId/projects/:projectName", wrapper.GetProject)
+	router.PATCH(options.BaseURL+"/clusters/:clusterId/projects/:projectName", wrapper.PatchProject)
+	router.GET(options.BaseURL+"/clusters/:clusterId/projects/:projectName/health", wrapper.GetProjectHealth)
+	router.GET(options.BaseURL+"/clusters/:clusterId/projects/:projectName/outdated-releases", wrapper.ListProjectOutdatedReleases)
 }
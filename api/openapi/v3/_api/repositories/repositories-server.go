@@ -4,6 +4,7 @@
 package _repositories
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"time"
@@ -12,18 +13,37 @@ import (
 	"github.com/oapi-codegen/runtime"
 )
 
-// Defines values for CreateGitReleaseJSONBodySpecPackageProvider.
+// Defines values for RunKustomizationActionParamsAction.
 const (
-	CreateGitReleaseJSONBodySpecPackageProviderAws     CreateGitReleaseJSONBodySpecPackageProvider = "aws"
-	CreateGitReleaseJSONBodySpecPackageProviderAzure   CreateGitReleaseJSONBodySpecPackageProvider = "azure"
-	CreateGitReleaseJSONBodySpecPackageProviderGcp     CreateGitReleaseJSONBodySpecPackageProvider = "gcp"
-	CreateGitReleaseJSONBodySpecPackageProviderGeneric CreateGitReleaseJSONBodySpecPackageProvider = "generic"
+	RunKustomizationActionParamsActionReconcile RunKustomizationActionParamsAction = "reconcile"
+	RunKustomizationActionParamsActionResume    RunKustomizationActionParamsAction = "resume"
+	RunKustomizationActionParamsActionSuspend   RunKustomizationActionParamsAction = "suspend"
 )
 
-// Defines values for CreateGitReleaseJSONBodySpecPackageVerifyProvider.
+// Defines values for ListGitReleasesParamsSort.
 const (
-	CreateGitReleaseJSONBodySpecPackageVerifyProviderCosign   CreateGitReleaseJSONBodySpecPackageVerifyProvider = "cosign"
-	CreateGitReleaseJSONBodySpecPackageVerifyProviderNotation CreateGitReleaseJSONBodySpecPackageVerifyProvider = "notation"
+	MinusName      ListGitReleasesParamsSort = "-name"
+	MinusNamespace ListGitReleasesParamsSort = "-namespace"
+	MinusPackage   ListGitReleasesParamsSort = "-package"
+	MinusTag       ListGitReleasesParamsSort = "-tag"
+	Name           ListGitReleasesParamsSort = "name"
+	Namespace      ListGitReleasesParamsSort = "namespace"
+	Package        ListGitReleasesParamsSort = "package"
+	Tag            ListGitReleasesParamsSort = "tag"
+)
+
+// Defines values for CreateGitReleaseJSONBody1SpecPackageProvider.
+const (
+	CreateGitReleaseJSONBody1SpecPackageProviderAws     CreateGitReleaseJSONBody1SpecPackageProvider = "aws"
+	CreateGitReleaseJSONBody1SpecPackageProviderAzure   CreateGitReleaseJSONBody1SpecPackageProvider = "azure"
+	CreateGitReleaseJSONBody1SpecPackageProviderGcp     CreateGitReleaseJSONBody1SpecPackageProvider = "gcp"
+	CreateGitReleaseJSONBody1SpecPackageProviderGeneric CreateGitReleaseJSONBody1SpecPackageProvider = "generic"
+)
+
+// Defines values for CreateGitReleaseJSONBody1SpecPackageVerifyProvider.
+const (
+	CreateGitReleaseJSONBody1SpecPackageVerifyProviderCosign   CreateGitReleaseJSONBody1SpecPackageVerifyProvider = "cosign"
+	CreateGitReleaseJSONBody1SpecPackageVerifyProviderNotation CreateGitReleaseJSONBody1SpecPackageVerifyProvider = "notation"
 )
 
 // Defines values for UpdateGitReleaseJSONBodySpecPackageProvider.
@@ -40,8 +60,67 @@ const (
 	UpdateGitReleaseJSONBodySpecPackageVerifyProviderNotation UpdateGitReleaseJSONBodySpecPackageVerifyProvider = "notation"
 )
 
+// Defines values for PatchGitReleaseApplicationJSONPatchPlusJSONBodyOp.
+const (
+	Add     PatchGitReleaseApplicationJSONPatchPlusJSONBodyOp = "add"
+	Copy    PatchGitReleaseApplicationJSONPatchPlusJSONBodyOp = "copy"
+	Move    PatchGitReleaseApplicationJSONPatchPlusJSONBodyOp = "move"
+	Remove  PatchGitReleaseApplicationJSONPatchPlusJSONBodyOp = "remove"
+	Replace PatchGitReleaseApplicationJSONPatchPlusJSONBodyOp = "replace"
+	Test    PatchGitReleaseApplicationJSONPatchPlusJSONBodyOp = "test"
+)
+
+// Defines values for RunGitSourceActionParamsAction.
+const (
+	RunGitSourceActionParamsActionReconcile RunGitSourceActionParamsAction = "reconcile"
+	RunGitSourceActionParamsActionResume    RunGitSourceActionParamsAction = "resume"
+	RunGitSourceActionParamsActionSuspend   RunGitSourceActionParamsAction = "suspend"
+)
+
+// RunKustomizationActionParams defines parameters for RunKustomizationAction.
+type RunKustomizationActionParams struct {
+	// Wait If true, wait for the resource to be reconciled (Ready or failed) and return the outcome
+	Wait *bool `form:"wait,omitempty" json:"wait,omitempty"`
+
+	// WaitTimeout Maximum time in seconds to wait for the reconciliation, when wait is true
+	WaitTimeout *int `form:"waitTimeout,omitempty" json:"waitTimeout,omitempty"`
+}
+
+// RunKustomizationActionParamsAction defines parameters for RunKustomizationAction.
+type RunKustomizationActionParamsAction string
+
+// ListGitReleasesParams defines parameters for ListGitReleases.
+type ListGitReleasesParams struct {
+	// Limit Maximum number of items to return. All the items are returned when not set.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Continue Opaque cursor returned in the X-Continue header of the previous page
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+
+	// Search Case insensitive text search on the name and description of the items
+	Search *string `form:"search,omitempty" json:"search,omitempty"`
+
+	// Package Filter by package repository (case insensitive, partial match)
+	Package *string `form:"package,omitempty" json:"package,omitempty"`
+
+	// Tag Filter by package tag (version): an exact tag, or a semantic version constraint (e.g. 1.x, ">=1.2, <2").
+	// The constraints are checked against the version without the package revision suffix (6.7.1 for 6.7.1-p01).
+	Tag *string `form:"tag,omitempty" json:"tag,omitempty"`
+
+	// Sort Sort key, prefixed by '-' for a descending order
+	Sort *ListGitReleasesParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// ListGitReleasesParamsSort defines parameters for ListGitReleases.
+type ListGitReleasesParamsSort string
+
 // CreateGitReleaseJSONBody defines parameters for CreateGitRelease.
 type CreateGitReleaseJSONBody struct {
+	union json.RawMessage
+}
+
+// CreateGitReleaseJSONBody1 defines parameters for CreateGitRelease.
+type CreateGitReleaseJSONBody1 = []struct {
 	// ApiVersion APIVersion defines the versioned schema of this representation of an object.
 	// Servers should convert recognized schemas to the latest internal value, and
 	// may reject unrecognized values.
@@ -185,7 +264,7 @@ type CreateGitReleaseJSONBody struct {
 			// The provider used for authentication, can be 'aws', 'azure', 'gcp' or 'generic'.
 			// When not specified, defaults to 'generic'.
 			// -kubebuilder:default:=generic
-			Provider *CreateGitReleaseJSONBodySpecPackageProvider `json:"provider,omitempty"`
+			Provider *CreateGitReleaseJSONBody1SpecPackageProvider `json:"provider,omitempty"`
 
 			// ProxySecretRef ProxySecretRef specifies the Secret containing the proxy configuration
 			// to use while communicating with the container registry.
@@ -240,7 +319,7 @@ type CreateGitReleaseJSONBody struct {
 				} `json:"matchOIDCIdentity,omitempty"`
 
 				// Provider Provider specifies the technology used to sign the OCI Artifact.
-				Provider CreateGitReleaseJSONBodySpecPackageVerifyProvider `json:"provider"`
+				Provider CreateGitReleaseJSONBody1SpecPackageVerifyProvider `json:"provider"`
 
 				// SecretRef SecretRef specifies the Kubernetes Secret containing the
 				// trusted public keys.
@@ -330,11 +409,11 @@ type CreateGitReleaseJSONBody struct {
 	} `json:"status,omitempty"`
 }
 
-// CreateGitReleaseJSONBodySpecPackageProvider defines parameters for CreateGitRelease.
-type CreateGitReleaseJSONBodySpecPackageProvider string
+// CreateGitReleaseJSONBody1SpecPackageProvider defines parameters for CreateGitRelease.
+type CreateGitReleaseJSONBody1SpecPackageProvider string
 
-// CreateGitReleaseJSONBodySpecPackageVerifyProvider defines parameters for CreateGitRelease.
-type CreateGitReleaseJSONBodySpecPackageVerifyProvider string
+// CreateGitReleaseJSONBody1SpecPackageVerifyProvider defines parameters for CreateGitRelease.
+type CreateGitReleaseJSONBody1SpecPackageVerifyProvider string
 
 // UpdateGitReleaseJSONBody defines parameters for UpdateGitRelease.
 type UpdateGitReleaseJSONBody struct {
@@ -626,18 +705,98 @@ type UpdateGitReleaseJSONBody struct {
 	} `json:"status,omitempty"`
 }
 
+// UpdateGitReleaseParams defines parameters for UpdateGitRelease.
+type UpdateGitReleaseParams struct {
+	// IfMatch ETag returned by the GET of the resource. The modification is rejected with 412 (Precondition Failed)
+	// if the resource changed in the meantime. '*' only requires the resource to exist. A list of ETags is
+	// rejected with 400 (Bad Request).
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
 // UpdateGitReleaseJSONBodySpecPackageProvider defines parameters for UpdateGitRelease.
 type UpdateGitReleaseJSONBodySpecPackageProvider string
 
 // UpdateGitReleaseJSONBodySpecPackageVerifyProvider defines parameters for UpdateGitRelease.
 type UpdateGitReleaseJSONBodySpecPackageVerifyProvider string
 
+// DeleteGitReleaseParams defines parameters for DeleteGitRelease.
+type DeleteGitReleaseParams struct {
+	// IfMatch ETag returned by the GET of the resource. The modification is rejected with 412 (Precondition Failed)
+	// if the resource changed in the meantime. '*' only requires the resource to exist. A list of ETags is
+	// rejected with 400 (Bad Request).
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// PatchGitReleaseApplicationJSONPatchPlusJSONBody defines parameters for PatchGitRelease.
+type PatchGitReleaseApplicationJSONPatchPlusJSONBody = []struct {
+	// From JSON Pointer to the source location of the move and copy operations
+	From *string `json:"from,omitempty"`
+
+	// Op The operation to perform
+	Op PatchGitReleaseApplicationJSONPatchPlusJSONBodyOp `json:"op"`
+
+	// Path JSON Pointer (RFC 6901) to the target location
+	Path string `json:"path"`
+
+	// Value The value of the add, replace and test operations
+	Value *interface{} `json:"value,omitempty"`
+}
+
+// PatchGitReleaseApplicationMergePatchPlusJSONBody defines parameters for PatchGitRelease.
+type PatchGitReleaseApplicationMergePatchPlusJSONBody = map[string]interface{}
+
+// PatchGitReleaseParams defines parameters for PatchGitRelease.
+type PatchGitReleaseParams struct {
+	// DryRun If true, returns the patched release without committing it
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+
+	// IfMatch ETag returned by the GET of the resource. The modification is rejected with 412 (Precondition Failed)
+	// if the resource changed in the meantime. '*' only requires the resource to exist. A list of ETags is
+	// rejected with 400 (Bad Request).
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// PatchGitReleaseApplicationJSONPatchPlusJSONBodyOp defines parameters for PatchGitRelease.
+type PatchGitReleaseApplicationJSONPatchPlusJSONBodyOp string
+
+// RollbackGitReleaseParams defines parameters for RollbackGitRelease.
+type RollbackGitReleaseParams struct {
+	// Revision Revision of the release to roll back to (see the history of the release)
+	Revision int `form:"revision" json:"revision"`
+
+	// DryRun If true, returns the release of the revision without committing it
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+
+	// IfMatch ETag returned by the GET of the resource. The modification is rejected with 412 (Precondition Failed)
+	// if the resource changed in the meantime. '*' only requires the resource to exist. A list of ETags is
+	// rejected with 400 (Bad Request).
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// RunGitSourceActionParams defines parameters for RunGitSourceAction.
+type RunGitSourceActionParams struct {
+	// Wait If true, wait for the resource to be reconciled (Ready or failed) and return the outcome
+	Wait *bool `form:"wait,omitempty" json:"wait,omitempty"`
+
+	// WaitTimeout Maximum time in seconds to wait for the reconciliation, when wait is true
+	WaitTimeout *int `form:"waitTimeout,omitempty" json:"waitTimeout,omitempty"`
+}
+
+// RunGitSourceActionParamsAction defines parameters for RunGitSourceAction.
+type RunGitSourceActionParamsAction string
+
 // CreateGitReleaseJSONRequestBody defines body for CreateGitRelease for application/json ContentType.
 type CreateGitReleaseJSONRequestBody CreateGitReleaseJSONBody
 
 // UpdateGitReleaseJSONRequestBody defines body for UpdateGitRelease for application/json ContentType.
 type UpdateGitReleaseJSONRequestBody UpdateGitReleaseJSONBody
 
+// PatchGitReleaseApplicationJSONPatchPlusJSONRequestBody defines body for PatchGitRelease for application/json-patch+json ContentType.
+type PatchGitReleaseApplicationJSONPatchPlusJSONRequestBody = PatchGitReleaseApplicationJSONPatchPlusJSONBody
+
+// PatchGitReleaseApplicationMergePatchPlusJSONRequestBody defines body for PatchGitRelease for application/merge-patch+json ContentType.
+type PatchGitReleaseApplicationMergePatchPlusJSONRequestBody = PatchGitReleaseApplicationMergePatchPlusJSONBody
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// List all configured git repositories
@@ -646,21 +805,39 @@ type ServerInterface interface {
 	// Return a git repo details
 	// (GET /clusters/{clusterId}/namespaces/{namespace}/gitkustomizations/{kustomizationName})
 	GetGitRepo(c *gin.Context, clusterId string, namespace string, kustomizationName string)
+	// Suspend, resume or reconcile the fluxcd kustomization
+	// (POST /clusters/{clusterId}/namespaces/{namespace}/gitkustomizations/{kustomizationName}/actions/{action})
+	RunKustomizationAction(c *gin.Context, clusterId string, namespace string, kustomizationName string, action RunKustomizationActionParamsAction, params RunKustomizationActionParams)
+	// Compare the KuboCD releases in the git repo with the cluster
+	// (GET /clusters/{clusterId}/namespaces/{namespace}/gitkustomizations/{kustomizationName}/drift)
+	GetGitReleasesDrift(c *gin.Context, clusterId string, namespace string, kustomizationName string)
 	// Return list of releases in the git repo
 	// (GET /clusters/{clusterId}/namespaces/{namespace}/gitkustomizations/{kustomizationName}/releases)
-	ListGitReleases(c *gin.Context, clusterId string, namespace string, kustomizationName string)
+	ListGitReleases(c *gin.Context, clusterId string, namespace string, kustomizationName string, params ListGitReleasesParams)
 	// Create a new KuboCD release in the git repo
 	// (POST /clusters/{clusterId}/namespaces/{namespace}/gitkustomizations/{kustomizationName}/releases)
 	CreateGitRelease(c *gin.Context, clusterId string, namespace string, kustomizationName string)
 	// Update an existing KuboCD release in the git repo
 	// (PUT /clusters/{clusterId}/namespaces/{namespace}/gitkustomizations/{kustomizationName}/releases)
-	UpdateGitRelease(c *gin.Context, clusterId string, namespace string, kustomizationName string)
+	UpdateGitRelease(c *gin.Context, clusterId string, namespace string, kustomizationName string, params UpdateGitReleaseParams)
 	// Delete KuboCD release by name in the git repo
 	// (DELETE /clusters/{clusterId}/namespaces/{namespace}/gitkustomizations/{kustomizationName}/releases/{releaseName})
-	DeleteGitRelease(c *gin.Context, clusterId string, namespace string, kustomizationName string, releaseName string)
+	DeleteGitRelease(c *gin.Context, clusterId string, namespace string, kustomizationName string, releaseName string, params DeleteGitReleaseParams)
 	// Return KuboCD release by name in the git repo
 	// (GET /clusters/{clusterId}/namespaces/{namespace}/gitkustomizations/{kustomizationName}/releases/{releaseName})
 	GetGitRelease(c *gin.Context, clusterId string, namespace string, kustomizationName string, releaseName string)
+	// Patch a KuboCD release in the git repo
+	// (PATCH /clusters/{clusterId}/namespaces/{namespace}/gitkustomizations/{kustomizationName}/releases/{releaseName})
+	PatchGitRelease(c *gin.Context, clusterId string, namespace string, kustomizationName string, releaseName string, params PatchGitReleaseParams)
+	// Get the revision history of the KuboCD release in the git repo
+	// (GET /clusters/{clusterId}/namespaces/{namespace}/gitkustomizations/{kustomizationName}/releases/{releaseName}/history)
+	GetGitReleaseHistory(c *gin.Context, clusterId string, namespace string, kustomizationName string, releaseName string)
+	// Roll back the KuboCD release in the git repo to a previous revision
+	// (POST /clusters/{clusterId}/namespaces/{namespace}/gitkustomizations/{kustomizationName}/releases/{releaseName}/rollback)
+	RollbackGitRelease(c *gin.Context, clusterId string, namespace string, kustomizationName string, releaseName string, params RollbackGitReleaseParams)
+	// Suspend, resume or reconcile the fluxcd git repository of the kustomization
+	// (POST /clusters/{clusterId}/namespaces/{namespace}/gitkustomizations/{kustomizationName}/source/actions/{action})
+	RunGitSourceAction(c *gin.Context, clusterId string, namespace string, kustomizationName string, action RunGitSourceActionParamsAction, params RunGitSourceActionParams)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -747,6 +924,118 @@ func (siw *ServerInterfaceWrapper) GetGitRepo(c *gin.Context) {
 	siw.Handler.GetGitRepo(c, clusterId, namespace, kustomizationName)
 }
 
+// RunKustomizationAction operation middleware
+func (siw *ServerInterfaceWrapper) RunKustomizationAction(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "clusterId" -------------
+	var clusterId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clusterId", c.Param("clusterId"), &clusterId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter clusterId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", c.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter namespace: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "kustomizationName" -------------
+	var kustomizationName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "kustomizationName", c.Param("kustomizationName"), &kustomizationName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter kustomizationName: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "action" -------------
+	var action RunKustomizationActionParamsAction
+
+	err = runtime.BindStyledParameterWithOptions("simple", "action", c.Param("action"), &action, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter action: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RunKustomizationActionParams
+
+	// ------------- Optional query parameter "wait" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "wait", c.Request.URL.Query(), &params.Wait)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter wait: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "waitTimeout" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "waitTimeout", c.Request.URL.Query(), &params.WaitTimeout)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter waitTimeout: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RunKustomizationAction(c, clusterId, namespace, kustomizationName, action, params)
+}
+
+// GetGitReleasesDrift operation middleware
+func (siw *ServerInterfaceWrapper) GetGitReleasesDrift(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "clusterId" -------------
+	var clusterId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clusterId", c.Param("clusterId"), &clusterId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter clusterId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", c.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter namespace: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "kustomizationName" -------------
+	var kustomizationName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "kustomizationName", c.Param("kustomizationName"), &kustomizationName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter kustomizationName: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetGitReleasesDrift(c, clusterId, namespace, kustomizationName)
+}
+
 // ListGitReleases operation middleware
 func (siw *ServerInterfaceWrapper) ListGitReleases(c *gin.Context) {
 
@@ -779,6 +1068,57 @@ func (siw *ServerInterfaceWrapper) ListGitReleases(c *gin.Context) {
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListGitReleasesParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "continue" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "continue", c.Request.URL.Query(), &params.Continue)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter continue: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", c.Request.URL.Query(), &params.Search)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter search: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "package" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "package", c.Request.URL.Query(), &params.Package)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter package: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "tag" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag", c.Request.URL.Query(), &params.Tag)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tag: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -786,7 +1126,7 @@ func (siw *ServerInterfaceWrapper) ListGitReleases(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListGitReleases(c, clusterId, namespace, kustomizationName)
+	siw.Handler.ListGitReleases(c, clusterId, namespace, kustomizationName, params)
 }
 
 // CreateGitRelease operation middleware
@@ -863,6 +1203,30 @@ func (siw *ServerInterfaceWrapper) UpdateGitRelease(c *gin.Context) {
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateGitReleaseParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-Match, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-Match: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -870,7 +1234,7 @@ func (siw *ServerInterfaceWrapper) UpdateGitRelease(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.UpdateGitRelease(c, clusterId, namespace, kustomizationName)
+	siw.Handler.UpdateGitRelease(c, clusterId, namespace, kustomizationName, params)
 }
 
 // DeleteGitRelease operation middleware
@@ -914,6 +1278,30 @@ func (siw *ServerInterfaceWrapper) DeleteGitRelease(c *gin.Context) {
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteGitReleaseParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-Match, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-Match: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -921,7 +1309,7 @@ func (siw *ServerInterfaceWrapper) DeleteGitRelease(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteGitRelease(c, clusterId, namespace, kustomizationName, releaseName)
+	siw.Handler.DeleteGitRelease(c, clusterId, namespace, kustomizationName, releaseName, params)
 }
 
 // GetGitRelease operation middleware
@@ -975,6 +1363,308 @@ func (siw *ServerInterfaceWrapper) GetGitRelease(c *gin.Context) {
 	siw.Handler.GetGitRelease(c, clusterId, namespace, kustomizationName, releaseName)
 }
 
+// PatchGitRelease operation middleware
+func (siw *ServerInterfaceWrapper) PatchGitRelease(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "clusterId" -------------
+	var clusterId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clusterId", c.Param("clusterId"), &clusterId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter clusterId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", c.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter namespace: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "kustomizationName" -------------
+	var kustomizationName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "kustomizationName", c.Param("kustomizationName"), &kustomizationName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter kustomizationName: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "releaseName" -------------
+	var releaseName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "releaseName", c.Param("releaseName"), &releaseName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter releaseName: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchGitReleaseParams
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", c.Request.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dryRun: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-Match, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-Match: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PatchGitRelease(c, clusterId, namespace, kustomizationName, releaseName, params)
+}
+
+// GetGitReleaseHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetGitReleaseHistory(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "clusterId" -------------
+	var clusterId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clusterId", c.Param("clusterId"), &clusterId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter clusterId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", c.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter namespace: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "kustomizationName" -------------
+	var kustomizationName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "kustomizationName", c.Param("kustomizationName"), &kustomizationName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter kustomizationName: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "releaseName" -------------
+	var releaseName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "releaseName", c.Param("releaseName"), &releaseName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter releaseName: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetGitReleaseHistory(c, clusterId, namespace, kustomizationName, releaseName)
+}
+
+// RollbackGitRelease operation middleware
+func (siw *ServerInterfaceWrapper) RollbackGitRelease(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "clusterId" -------------
+	var clusterId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clusterId", c.Param("clusterId"), &clusterId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter clusterId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", c.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter namespace: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "kustomizationName" -------------
+	var kustomizationName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "kustomizationName", c.Param("kustomizationName"), &kustomizationName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter kustomizationName: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "releaseName" -------------
+	var releaseName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "releaseName", c.Param("releaseName"), &releaseName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter releaseName: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RollbackGitReleaseParams
+
+	// ------------- Required query parameter "revision" -------------
+
+	if paramValue := c.Query("revision"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument revision is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "revision", c.Request.URL.Query(), &params.Revision)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter revision: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", c.Request.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dryRun: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-Match, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-Match: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RollbackGitRelease(c, clusterId, namespace, kustomizationName, releaseName, params)
+}
+
+// RunGitSourceAction operation middleware
+func (siw *ServerInterfaceWrapper) RunGitSourceAction(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "clusterId" -------------
+	var clusterId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clusterId", c.Param("clusterId"), &clusterId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter clusterId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", c.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter namespace: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "kustomizationName" -------------
+	var kustomizationName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "kustomizationName", c.Param("kustomizationName"), &kustomizationName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter kustomizationName: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "action" -------------
+	var action RunGitSourceActionParamsAction
+
+	err = runtime.BindStyledParameterWithOptions("simple", "action", c.Param("action"), &action, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter action: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RunGitSourceActionParams
+
+	// ------------- Optional query parameter "wait" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "wait", c.Request.URL.Query(), &params.Wait)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter wait: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "waitTimeout" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "waitTimeout", c.Request.URL.Query(), &params.WaitTimeout)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter waitTimeout: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RunGitSourceAction(c, clusterId, namespace, kustomizationName, action, params)
+}
+
 // GinServerOptions provides options for the Gin server.
 type GinServerOptions struct {
 	BaseURL      string
@@ -1004,9 +1694,15 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 
 	router.GET(options.BaseURL+"/clusters/:clusterId/namespaces/:namespace/gitkustomizations", wrapper.ListGitRepos)
 	router.GET(options.BaseURL+"/clusters/:clusterId/namespaces/:namespace/gitkustomizations/:kustomizationName", wrapper.GetGitRepo)
+	router.POST(options.BaseURL+"/clusters/:clusterId/namespaces/:namespace/gitkustomizations/:kustomizationName/actions/:action", wrapper.RunKustomizationAction)
+	router.GET(options.BaseURL+"/clusters/:clusterId/namespaces/:namespace/gitkustomizations/:kustomizationName/drift", wrapper.GetGitReleasesDrift)
 	router.GET(options.BaseURL+"/clusters/:clusterId/namespaces/:namespace/gitkustomizations/:kustomizationName/releases", wrapper.ListGitReleases)
 	router.POST(options.BaseURL+"/clusters/:clusterId/namespaces/:namespace/gitkustomizations/:kustomizationName/releases", wrapper.CreateGitRelease)
 	router.PUT(options.BaseURL+"/clusters/:clusterId/namespaces/:namespace/gitkustomizations/:kustomizationName/releases", wrapper.UpdateGitRelease)
 	router.DELETE(options.BaseURL+"/clusters/:clusterId/namespaces/:namespace/gitkustomizations/:kustomizationName/releases/:releaseName", wrapper.DeleteGitRelease)
 	router.GET(options.BaseURL+"/clusters/:clusterId/namespaces/:namespace/gitkustomizations/:kustomizationName/releases/:releaseName", wrapper.GetGitRelease)
+	router.PATCH(options.BaseURL+"/clusters/:clusterId/namespaces/:namespace/gitkustomizations/:kustomizationName/releases/:releaseName", wrapper.PatchGitRelease)
+	router.GET(options.BaseURL+"/clusters/:clusterId/namespaces/:namespace/gitkustomizations/:kustomizationName/releases/:releaseName/history", wrapper.GetGitReleaseHistory)
+	router.POST(options.BaseURL+"/clusters/:clusterId/namespaces/:namespace/gitkustomizations/:kustomizationName/releases/:releaseName/rollback", wrapper.RollbackGitRelease)
+	router.POST(options.BaseURL+"/clusters/:clusterId/namespaces/:namespace/gitkustomizations/:kustomizationName/source/actions/:action", wrapper.RunGitSourceAction)
 }
@@ -18,134 +18,346 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9eXMbufXgV0ExvypL9eMhOzMTr1KprCN7HK0vlSQnmx16x2D3I4kQDfQAaEocRd99",
-	"C1c3uhvNQ5Zsyat/ZmQ2jod3HziuegnPcs6AKdk7vOrJZA4ZNn+mMCWMKMLZr0dYYcpn+tdc8ByEImDa",
-	"JAJSYIpgKtsfU54sQCScTcns35Iz/Zta5dA77EklCJv1rvs9wSdcvUgSXjD1HmewsdE5X0BsqOu+/4VP",
-	"/g2J0v1SkIkguV5DdFyStn/u9y4HMz5gBpbe8UvdjnUBluNkgWd2uURBFsFCR1+9KvitIALS3uEvttWn",
-	"yBLcD1gIvDKogJx/FFSPOOUiw6p32CsE6fXXLsP0On3bmpakPbe6OrKqeYI1tsDr9y4zWq2x59nk2qC+",
-	"4h5aSAWijRpcqLn+P2fwYdo7/OWqDhvzHT9d9+ufFsUELF+1vyV6/ClJsIL2xwlgYQb81G/A4r60YczJ",
-	"GYil/bQDxl+cHLt+1303+BrODWGsZqx3jHFHuNi7AT3BRyDUJjE5emFa6faUAFPxPuXnN7DaDQ9Vt9oM",
-	"JXgx3FT8U0014ZwCZvpzwEN3hDnOFFzG0UCYhKQQcLYg+TmV/wBBpqs4nDm2QrI9skyPav6u2do4i6lQ",
-	"YMu7UJ1r9JCesd9bPN9G4TgCNxTOa6JOIeeSKC5WOxotCYkAdQrTzUBXTWPs12kz9AeZ46TLokTJrWee",
-	"dvx+mwahUvt6vpImFcwVewVY3EioOkUa5HofIqQliP8AIZ39hkuc5VSPt3zaWp8WacIMVwIrMr2YauBP",
-	"/aDz+2AxrTEyUDjFCkdgYYwrrCG2/0xTAz6mJ7VmrRHLia96/IJpndJTgLMB7sXkLRFg5jgnGUiFs7y+",
-	"8GcHz34cHPw0ePrs/OkPhwcHhwcH/6fXryifYgUDRQzRBOD0A6Or3qESBUTWSvEE6I0XA2xJBGcZMDMx",
-	"LKPr8WJQLSFbDVg3Bbb1i6TCqojIbz7HEkImeJEostQTnYPICMNKT1Pjh7LFRo+yrXQ9dzrmC/hno0xU",
-	"bNiQhxPrcHW7kjW3tnc+B6S/ID5Fag7I+Wsx5g50RXuMj6dv9RAfjk6RgBmRSqy2VSL93tLiQbaHfoEo",
-	"kUqP7NugKRcNSEvfuQVy3f+NcUcwebXCjdj3SG7inqfHbMprHHtlTCkmDIQ0XirJDHl6UsEUs1zwFLNR",
-	"zlPCpvyQYgVSGU6Q0rb7G04WAz6doh+zA4mElmuh2RBNMaGQonJ45AbxivewV/0gAJsYqncksJy/5TzX",
-	"w36Yai2thUG3/icmyuKtAjJbcTEbSZJCgkUFnRvf/R6McVowZsb45JQRpC9UqXl+GDz78fzp08Mfnh/+",
-	"8Fxrnjlgqi1W7xRwumoBPvjTT88nP/z40/RPyQBPkqfPasZEq40pLqiKzd/00EMiNJnsrWOxqg0izPDY",
-	"CU9D/qoP6XDUHO2opIdtECoLkhLxO4xM6BI1H47ozTHf2Q/ogqg5qtQtSkFhQmUfkSnCeU5Jgie0PuVN",
-	"2CfqdaxbqXe+ylnLUZ/GNQl2EX19wFPzeynhSSEEMIUMcdetMcLUrTkdh3SvwTYIh/XMtJWZ6fc8ue1A",
-	"24TjgYQ04TrhKfLWHKVNyDrEKWrIW5jwQheb0n5Dzj7WkOEkdEveeB/YFCtF1VBrpHu9v9uewnzS0nox",
-	"J8ncz4aIRCnklK+gPnOlMLblDz3aF3NG6LH4wSralxTph1pqsw1yxqZpgwQ3zWOBy1fzDRups5r7ljsA",
-	"Y92IzClevW95fe9WyK0LRRVKzZuMz/d0He9u3aFyHG/FQ9zsrLao7rDXoPopUHCua1Olmg9aIrRwnJkM",
-	"balhhf0q0YuT42GvvzZ+ajhmJ8fuGzJggB3fOVOQIpsLtiqAaMOTC5DAbAykf8YM2TUOx8xmHySSc15Q",
-	"Y5OWIBQSkPAZI7+Xw0mkuJnHeiKIMAVCG8IlpgX0EWbpmGV4hQQYZilYMIRpI4dj9o4LrTOm/BDNlcrl",
-	"4Wg0I2q4eC6HhI8SnmUFI2o10tIoyKRQXMhRCkugI0lmAyySOVGQqELACOdkYMBlJrYbZukfBEheiATk",
-	"uiizjs03hBmlhZFtaWGtkKZ/0ss+fXV2jvz4FrEWh1VTGaBTY4KwKQjbdCp4ZoYBluacMGWtrMmIIVlM",
-	"MqI0oX4rQCqN6eGYHZm4FU0AFbnWAOlwzI4ZOsIZ0CMs4e6xqTEoBxptclPEXcfpmcIsxSL1GPItI3x+",
-	"w9i8IRFiQpTAYlWbabsQveGTeLuvfJvh1sY9BQq672uBEzgBQXh6BgnX2GtjyH5AmFJ+AanRCjPdb1pQ",
-	"pLw646w2O2Hqpx+qmbUEzmzK0k+9ZmUvXZObrGxKGKbk97UefNVmuENc2O/NgIHACt5HfZkTAVNyadFj",
-	"G2p5xKhg5LfChs/DGMS+cUx/nmkxYwkgVmQTEHVRrzrqRaUgtY2wbsiWtLhBcqahkWA1sEoox0QYvZtg",
-	"BTMuyO9QqiAZZfEMMzyD9GcCNMZ27+xnNDXfkRI4WehVax2C9hKuzealk9r96ASbXU4P4PDGPuU2A5mM",
-	"3ClMQWhSypj19d80AvmFdhrryOuILeumt9OKdDo0rQ9FLOUezdm3a4YW3E5X4NTbI2f8jaAknNnwLVmZ",
-	"EFNwGkWhBDp9S9gipityAZrnUqQbDShhC/Tx9G10GLe8+ggfrXwev0RYSjLTXslkhd4UExAMVI151yFE",
-	"5pB0OlZnOSQ1D6gmrZqLXMO22XEllgjbnHOUC74kKSDXqDA+jiA69JVjZvhKs9SR/Vz5BMFAhg6SZ4Dg",
-	"MqfY6vIxc10kwgJQBkKLokt3TLm2BJpHuUhBHI7ZAJ3PAc0on5hsg4meEGeA9uyaTdcjUwjb961L8fLA",
-	"o70X5Z82g4E0TsmUJKZxHwWD2apaHzl16EFznmo1uJtOe0AaVkhNpnDMXlogD9Evn7rF60bllZsW3W2k",
-	"975b6RxPkYmjEE5ThK4QYVJhSg/RFWr0PTQN0TW6NgrZoAplONduWCGNnyZB9RGWqJAWnxlPCwrDU2Ap",
-	"iL39AEFTTGVgaYOqYQqTYtaG87XgRa6JB8bY5ljgDJR2NAtpfAbNcKbvTPOQDz0wS9FJmaxt7PIosvyo",
-	"KnU2NED10eBEFInS65Z4CQhHQgrjztoOY+YY58xEbEPLKhrAQkIA5V/jCCiy/KRcXhyy6vv2wFUo+xL4",
-	"4ptVck1hlpCYHdJiKTgFiS60itBNEWdDtIdz0y31cZUWbQdroRmGrjQlXap9v1O6NjpYjYRAwxeac6Fq",
-	"mquKGq3uRGeEzSggSjR8jEYTUXlV+Wiv3n3U67SZobY6BqHOwvptwykPP6MEMy1sM7IES0ZfQ8HINvJ5",
-	"VcJmYwZEzUEgLtCEqzni0zHT2gujk1fvBsASring4rBgUwba+6yoHCZCfd43UpQLssQKxmwBK/dxAavP",
-	"+39uj3b0ojFSgu1Aemo9lkmYwRKEsQOyyHNKIO2jC0KpifekiwgSzhgkNgQ1TDJmvsIzNPo+ANxAqYHT",
-	"YzqlQKZoxQv9y5jhQs21j5tYF9oZgwDQPxtkOuB1RFwOMmZuFFRI634br8DZdWki3nAkC5sjRlZo0zCx",
-	"vL3KAX3+kOPfCvisafJ5UXkEhI8UlZ+HGkvvuYJDdFbkuWZPnzL5nOCfCYXPffRZz2b+Nsv+vICV/dcC",
-	"VhLN8RL0lMA0wzlPpu0EbOPKGh9SDW9e7iQzxkXM8JjfEV+CECR1DozT7nCZ0EJzUo6VAsHKmsjQehp2",
-	"TGRjkTHbswlYl2aSGn4s0XBGlG24P0THU8S48p5N2ke49ChCpuuPWcKZ1D8bf4onRVbqUU2FFS9E6Wsq",
-	"roO+FPFCoYs51n24tjki7rH7zSwRXLgvNhqWDbbHiHE2OH97hv5+fn4SFE1KUYjaEZOfWmJXM7W558Pe",
-	"j1mv35rdNkRYBansD0fH1cYHU2IlEiVzSBZONm1SRg7HzJgPP51JJeW54Jck09Kv2TPDK+MeFDYbojj6",
-	"N9GE1X8Bk4UWT5hOSWKEuZCG/2qhimOE3mHv/+79cjD4H5/+e288Htq/9v+6l8n/yP9k/5nv7//3f0XV",
-	"s6W7iOtn5756RphjllLrr2OUzAlN0ZQWl0cv0YeEBDjxAPYd1nx/l13X/W2WLNcBNRdj9oLSwM91Majv",
-	"JsDUl5R3icv8mkYweNYVlXIMFRpnfW8XnuAL+aSPnuDfCwH6j1mSP9G65okJ7UnyZDhm/5wDMxLhnGEt",
-	"Eo5JTLgYth1oHTUpCNVuuWt0+BfXwGx7stno6hd8IfV/NQC9fm+W5IFmqBHlcrXG6p3UvpeQumRy09JZ",
-	"H0d3cb5pIVzQobhhqYs5odpFMznB0Ah4z60tVN9AXYra9q8GQrBQdqPDMSoERTwhh6PRuDg4+GNS9TP/",
-	"hkP7s8Iz++94/NuJ+8DbsJjx+tlg3TgcDdR7vCHKZ4SNWbDLSjOUFha6BFuXrrKUlrdlZSlRYCjrtrG5",
-	"KfsbEUhbe5JAY+N3E3vNNt44hdtdqmQAqnewIq41fyXhMGa6j0VfXlDj21iSmK4Iu75zLBFWCidzbT91",
-	"Q4tdOUQ/c4EynzHXppNwdjhmPnPeQrccKSwXcuTlCQY5TwelqAS/OyAGDojRH3CaDgysGgIHwEDxAW42",
-	"jfJlIXVkEFPWOk6heIYUUCpLyRWcUmtNXFdH7ISzhFCCa359K6cW2EyFZ3cvdopkwAtVt8s/HciWYdai",
-	"4RobhS8g48pYZhSYIWtezPYkShaWOQib1fX5Twfbm9EuI7os9/rWwbS7crdWFEoUUhnenFCSGId1zDzH",
-	"2znsEGTGsDJeEUsD7W+NbWkOnXZX3DonY3YxBxPxaDRZcdEeiZekttbIsErmH45fHh0bfaVWsaxxo0nD",
-	"GBH/sxlLLzQRRIEg2IGnodLWxy7PhBDMAIh1zIAT73hdYIlcVGEjjSOu/6mRREHKMdP/ImxmAwzf+Yms",
-	"IDC7DyCz2Jy4GYn2KaYIs5XTPWNW2n0Ls63YqWSO8EwTUdXWVUsW11ETYsWgKUANz1W5a65auRHaIDz0",
-	"k/THjAxhaCeWstDRoZNk7zj6NEsQabXIabtGfGw7ZJ1wAmZw6aMMjbEGDjxDWuWr1+pBcwHJzwVNCG/H",
-	"fn5Ib9LwmC0xJSl6rU3hrKBYILjMBUjpilwRLTjxmyka1sVh40uWUvKLR+0O6zHBLEa7rae5bd5SqVrl",
-	"NsnMuhfvNWdiRKSlPE+8gqijSUEyZ5zy2aq0skbCXMiDXjiZGgaObTmDL9VG3dmtvKk6MDUPIOLPjllE",
-	"WX4Tv6fRqqTExpZlFFpzba2ljdE8X5P51IzoE7s1H78qT5h9vpUjMzCJULGEQcEWjF+wwdQVBpUowLKU",
-	"gkRBuiYzrhF4AZM55wsbrOUCljpQ9UXnrfLaJgXaXT22GdJpQaeE0iB4LLOQXy1dKhckd307s+PHU7QC",
-	"2XdFJ5tHccnvPbkfpMOnZGaLPTrWVHgBTGsa5/uNWX8LxGmROdG67G+rd6aesK6yvBPxG3sodFyukZsb",
-	"xakX8HegmS+haTCMJQOczF1lI1Yfdh7oWo4KxnVpFG23y54IK60a+NFLRGEJdMz2bIpFovcfzg1k8wZk",
-	"Q+/4au/YJyR82gWUZidbKUM5Fmq7SozCYgZqTe2oXnArs9uIsCHae4dXCFNpnBBsZiWYWofQJC9QVlBF",
-	"qgMVMmDd91whr0RMQYkon92QoIocYcaNj3eBV31XUzCVQxbuzh+zvdKh8Cl4Ex2hKbmEtAK9j7hAEpYg",
-	"MNViJfeHAYI8msuYdfszIL4qsP4YyH+ZA1K9P4yqA80jt+Vs1N5mZ2s2u53ocHXkjRv8/E6++AY/f7yg",
-	"UUXbcFJ5RiL7QTvPihX2jMe6sxuxw1/uZFcROz/xJSfaOo6z1JM0bY1qo8j1vLGFNYxv6K3OnmjUbkvT",
-	"2HbdOkd17jIwn2v7DPjE6NXIRoMxe2HqfBeYKVdedIEIXOaUJEQhPNHxrM/Eh7mIvqkQcvbE5oCf8Exb",
-	"rVytnmijQZQDwgA/HLO9V5cJ5DY0fuKszxOjLsqcgEvimoqmUY37XTsh4rvzTfXX+9dSKywd29kP5lCA",
-	"27Foc7Z2Ghf17OqJbFFENRA0DkshjSHqE+1+p4LZPbWT1Q8MiiYbrN2/VYft742urpo6gQ5e8Un1oF+L",
-	"KsLs+I8BXunNTfKlR/i06W6FnaFvwS41jznZbG9HI1LH8S89cVcdiqbb6Q1L/U1ODHZBmK0Xt8qQ5XnE",
-	"SOKeMO8bxiAOP5c7fsIdxoq7o3WF8NI0RNrom+iMJJhSG5710aSwO6295zsB5HbqQzpmWCIDTMJpkbF4",
-	"5Ycw9XLdDoCTRosxO+L5qoqcrEQFfbS7YHBtdJNpteelMN0vBZMz6ATopDvsOKl9t2hLHEDlz31fvv7X",
-	"iBm9NkTnvKyca8pbHHXUwrrn9tNWuqbmZZZ9vddvieKW3GgzZsel9rTbOX35cLIKg6p4uKTF10tY9LRW",
-	"9bnJW27x6H+P/hU6ysiMeVeM1hHf3bniLvypvRtutv0oXYrUcruOJI0r7Y5PhZQ1U/2ygNWnIXpBXI7R",
-	"bxY0W4cCYz4cszewQok5VPFkrjL6pI+eOBttdgZSzGaFmTztI1DJcBjZcHu9pX8TuMWBh2MPI5yCzDmz",
-	"yqz+y7kZunW+hCFgRVbfF52Sqc2h+P0ewo1halUySBfxRZr/Kv2dFsGJ5BlRv2q3z14S8WviL4mJC2mY",
-	"ou46m+m4y8LhWlVHLUoY1hjR9nhmk4FtgBKeQnSPt4oizhQsVnmZl2hg6lZx1DD3HkXl2lyHjf5xg0Ua",
-	"DPRRgjgRfEpoJAyADBMaD3oEL/L63UobBdnUTXfbWF3qnB2yO8VkU4DlU7KtW0OKSc+DWd15YnBQrtjD",
-	"tBHtIWKvr6/NBQ32PNVLnsTqDG9entT347h477Dn65eap4aEj+zmFxurmnyqTaI7aplz0EPyO1G8YMDg",
-	"fy6KCVeYDklweN3MVh4fWjtTpEJpzJE5LvXi5NjnXhMot3GZ0ctLbyhJwCknfxlPjpM5oGfDg9bMFxcX",
-	"Q2w+D7mYjVxfOXp7fPTq/dmrwbPhwVDrWltnVLRcjJ3OQZWT6u6B3mHv6fBgeGDOFOTAcE56h70/mp/s",
-	"lSmGGqPEXpFl/jED1ZHJxJQi33KsSVRWJ49T1+bID2S29xupM4M+Ozgo4zF7lNKduNa9R/4aNpsYqfH8",
-	"lvmT8o6v1tUMLSr6pKxJYnpwrWKwRYcdwNwSupYaagHlKAhCcHtvkCyyDItVDPU2pSBN8cL/9En3Kck4",
-	"unJ/HafXnSR9DUp7n7ah9tpIGiHqa/A07dVDmV9aIbUb6filudupd+gTN47xS5B6oeax53wrjDYNwacv",
-	"ZKRd+adNGr+uwP25z+wSIetuDDMKbw/sVgZmn75tqGfxE0aZSHc48aM+SDbaVR+V97hs1kceMQ+Lweha",
-	"Hrghx42uNJHXqywfYdXms5vY3Rc9RlyTBYdFvj4L9q/ihPfppchE7su905bVLUXfBTdvx1JfxtOj8Cqq",
-	"bq1au4wqOM1WVr3WsPU/qvumHtn7btm7pNO9dxw3MNQtMfXoyv21ne6uVriWoV+Wzb5flu6cY1kWfCPT",
-	"VB+/nvDEU5C1e3rKvF6n3FSUfyhGIQ2Z8HZlZVStaqPIuFtv9iox6Fe1SlDJ/lpJsrfzPErRdyJFbrgH",
-	"IkEbWXeNYNkM8RZ5qar2iXynrhSVH/Nrp6j8rdA7pqg8uA8hRRWhQkhc/1OduKMr99fmbFV7/LWJK4fx",
-	"TWrPjdSp9jx09zJxVd413k5cuXU9qLxCF4V3Y6NRtfNwJ9VRdetQHu+rcR8kV+2qtILboHdTWwEBHpji",
-	"YiGJIzzX7+Vcqo7L1QAtngdDRLjoqHFpy7djI7OX6G/cbsW6bbKE14i3KBJchlUeGq/OVrdWct1i+qd3",
-	"yvRNltrM+dWC/PlwWSQJSDktKF3dZwmIcW0n3xcRtv9ot7hvYnvb7JHtv4jtD74N+1Rwu7s6Hwx3x5jz",
-	"xp6EDeTN39dWDijEbnY2d1E2JvXbSCOSYZt/Y8loBdBvIgaxO1j3n++de7yZe+pksjR9OAy+jte61Ph2",
-	"8dU2rPs6PEH0yLdf34481NBuS17dUSmPZkQtCql4Rn6vbl3esJ/JX3ORohkxt13bgzukMwT0j0ZtYvg3",
-	"7Uj26/H+lBaXSbWkmixUF8jrVgO5kgqy+yQju/rrjWe8dgtUm1R/GFvBurk2kKTaz7cgTaOr2r/fr92r",
-	"cQqqEAzhigfdmzJxO+Jo+ChUtwVY7GGUODwtot5Hg9h8qq8lNq/94h+ITeyUj68swCMRnMNZJ8l+x1f5",
-	"tIe7VcTDv85cuhkehfsuhNsTZC1c31bId7XotXPNm+25PwOmWfQByPwmSerWABtyzxgxuPC3S/hTZ5vF",
-	"1PauBPVRTh+0nN5pgrO6RaJTCr+TnH55GdHDzOhvowvWKpo1yX7MEFwSaY5O7qxt7BiP2uZR29y+tnH1",
-	"iVsvpXw9bfNAKyzba4RvFd6MrtxfZdpiQ/2msQyXqNxCwdn+jwru4Su4GHwhT+yWZwn472GWytzqT8tb",
-	"Ux5krWw7uV7nGq3LktxYa/gc6KPKeFQZD0VlrHHPGrqCuEzOPc/MfKlu2NWFyXkqR1c5T69H1Qvdo6vy",
-	"7+vR2lsZzpQAnMnwquGcp0j3cVeM2yUPzoAp9GqpUYf2zs5e7ffRmLn3aFJ+wSjHqbuWOEPmFs2cYr1q",
-	"uFRoSqi9RXPMhEGTtDNgif7X2Yf36GIOLLiabEkwepEkkKu/NOmK5oBTEMO4+ntr7za4v4rvTjYU9GMP",
-	"w3cfjcn5F67nqHwMpXuSkv++bKrySlzPYhXjtBjMPHUHOEV8iqRha8JmQw/ebwWYC4QcfH68XghOqVrM",
-	"pbexx+1al2jbF3L5VINlnoAz91nKOb+oPWntS8Ua+C6QFCb0rR4hDtPTg4P2TUtfrKbjVe1qMVZcIbUi",
-	"enb2ChFpHwayb49BivZgOBv20dkFns1AoI/H+7s8cKx/gEs1MldmDyzd1oGoQbCt6oDumXuX7cXb5VMT",
-	"2KoX3QqYEqv9jmdVNQCGm9bNfGRe/1UQcKDnIjyhUDFibJL2QSyvZUsMm2v5/CVitgAp7/uWHM/TgT3T",
-	"BukGdmxjDbGcLth5YS+UhvJ+vK4NN2+eywdRQbyX+81uWHn7nqpuW/FeIAOL51uU2tpJrooTOktsFSc/",
-	"+jmVd5CD0ArTXPNp3VVJUkCpWCFRMHMZqrlUGoR0Gcbw/fQuF0GsTgvWi0BUOQSP1bLHatkW4hxTDWuK",
-	"YztqBtvrUTM8aobHytZ9rGztpBlu6jfvXJxyD0w1IFusUzS256Oi2ZibfSzd3NfSzSauj1jqtdFoMwpY",
-	"f9TpUXS+Q9H5fkoYm5j6joyVzf1t2j1eq1HYHghLyRNifAL34EWDqXCZAzTOhX+MHliac8IUykhGEvu2",
-	"wQTmeEm4SeZ+1gohUbR67MUP6J/1LaG37/h+Nnc9mkfaiDLZwCkXaCL4hQQx8qlR80BCR+nCllYetcOD",
-	"0Q51cCse0/zT5FTniMvyivfqeV1RvowVJs13uONrG/e5S346wYkIzn1XXPHVYb+iu9JdJt29SXNhSpFu",
-	"aB63RDOyBBYcYA0eeOkjwhJa+EoqESipFdtkh/Y40VA8Ko3vxaU44al7kK/9DGl1b7ZmKPcEdsgo8iFl",
-	"0jsXgbBSOJnbR4TuXIqrB2/WevuesWzzTT7+mX9p5lEsvytPv3xL6nvx9+tsvb2M5YIbt2bzHQm+ZdfL",
-	"Dn6g/y9uxHOr3fWagRLbD+F6gbyiaLkxwP+0sTJqXiRyzTsroR6L3+k1YCWTRLaO2E/fS93PL+eB1v1C",
-	"Zu3k9i1Pwm1gfNvjkfG/yt13X4/xv4MDW5uFYKMTMbpyf21bwNpBcGyPbyo4/asO6q/ZqFvh4177Mzfn",
-	"/AdaRNqN89del+farS8bPTLu17YwD+pWvAYPdevfQmrlm63y6sXaTtbMVvbJZtc2zpjvVv6N1i+kXP3h",
-	"XD3xDiisvxVbf5TWDPVpi4cx3tXXe9+p3iBPQHNDZE1w3ccMYnWFfSh2hHMyWj7taVlzPVp+aX3c5ru7",
-	"9QdnZ0TNi8kw4Zl59db8Z+Aebi6fJnYwRU6SVO+R3sY0wVusa3JewesdtzJp9bpGh6a8rZmCBEBHhu3N",
-	"8zMUbHS/jUkXz9fM95qo256vcZHiGjrmtuZxK5jVQ11/uv5/AQAA//83FZEwNcYAAA==",
+	"H4sIAAAAAAAC/+y9CXPjNv4g+lXwtFvV9v4p+egjHW9N7d+xlY4n3baf5c6xUV4HIiEJYwrQAKBtpae/",
+	"+yucBEmQomzZbSeamkrLJIjzd+F3fu7EdDanBBHBOwefO1MEE8T8n5+OKBGYZEg+SxCPGZ4LTEnnoHOU",
+	"MU4ZoGMgpggQdCvAHE5QBAgVgCMBKFFvUsj1m07U4fEUzaDsSyzmqHPQ4YJhMul8+RK5EfuXcFId7SfE",
+	"OKbEDscQpxmLEdiyv2yDMWWqxY/ZCDGCBOKAjv6FYsEjMErpCAx+OHSNJliAMU4R346GRFAwQoAjIsAI",
+	"xlcA6/mfjLsfoIinQE/QzmBGEzzGMZTz40PSiTroFs7mqVzVsLO3//LV6zfDTss1X1IB0yOaEVFduXoH",
+	"SDYb6cGxQDMOZnJKmEzUXMY4FfLcAoNhItAEsc4XOdwcMjhDwhxx/tcnGOvBymMfqudAUMAycjAkAHQB",
+	"z/gckeTA/jDnEVMS4xSr/Sifkv6QIZ7N0IH5t8VnAJIEMPTvDHEBIAF4NkMJhqL8ne1eP0MHbb6pTrFw",
+	"gq6zTtTBcifmUEw7UYfAmXxt9ivqyJEwQ0nnQLAM+fuPSDbrHPzWMbuk2sp1dyKv89+jAFB45xLXYt/Z",
+	"HP47QyDWSMiQyBhBiYXZX7oWb0tQO2foGtOMW4RUa/t3htgiX5wbtBl2vWkicl2d4QWSjWNhtzlLhQQk",
+	"+VecZlx+KGcFCUDkGjNKZoiIwiEk6LpminLA1rObIsjECMEAbp0Qgdg1TOW+cXkqCQcjJG4QIkDcUOA+",
+	"5WBrMOiDmM7kLPk2uEJobtEvpoQgjSkwxdd1+5rPw596gsYwS0XnYO911JnBWzyTcPNydzfqzDDRf+1F",
+	"VXQuLBGPFYmqLlAS0xw6Rgs133f9yzL498BliaYBzAFDknCiBNxgMQWv9vbB1rkC3gSrJt9DnKJke0hw",
+	"CXHjKSSTHBxnCBKBZ6gHXvyvF4CSdAEM4vDid4ICdIu56IFDkGIu5DTlEjjAfEhK09ndBVvfwQRcaGzf",
+	"7jVRYXUgGhfyE7GkvT0spXCE0gFKUSwoq263x3RUS8BNU7CFbnsAzuf/mNMEkzGNBEbs//nHmFEiEEm2",
+	"CzOnV8m8h+mOhxj/qEeG4pxWWAoX/WtExEkSQIwk56zgWrPWbQs08kvAUIzwNUoAkn1EIOMoUYwip+43",
+	"im1SokmvQZG6s3gPueiq+XRPjldYBZ7hAGJ/0IhUYZuCGmzogcM0VbPULyBDOZ7cTBGxYkyvbtfVwP48",
+	"HfLu7a6GvXMYX0lyXFnF94qvS7Q1TQBDc8qxoGwBtmLIEcCEI8KxwNcoAnPIBIapFg6KIGXArmYxdgat",
+	"t70kdQWpfwF4gqAxZnTWAz97ux2pt3HGGCLCCm7qcMaYcSGb4TFGCYAcHB4f9497QyIp14sC+LwwXC8y",
+	"0pwme4qAp1jS7zJQRkDAK8TBnKEYJYjESBOT0F6Vl77Cnl3jus3Sb3KqnCJ5uHLPaJpqcVRQsMWR3r4p",
+	"5goGiu0LJ/6ybvZmEk2yS3vQ5QiyEN85KsEmEPJ+oFvbi4GckRLxvE/tihRS1hyAGbP1vgs4aYNaAk7A",
+	"liV1B0ouuYWxkM8jQBmAgKOZ5GOxg+mYEi4YxESALdSb9MBe7zYCw84w2919if6x19uPgPod7w872wZU",
+	"8480YMdTFF9JiJ5ATLgWl+wAktHRTD/LSYABFp6Nx/gWbL3pfdPbU1ca9as7392r8EL3pmZP5R6131A8",
+	"QzQLXVX0C1+achCqeLQiwAjGUysCRkV5UEyhAAlV1AASfoOY7EuOZwj0nDKhsX+sZI+65ZgZhgWtXU/Q",
+	"erMSpb6BIWZzMgYKg4B87e6WvlAzyq8fKAFbFwgmCwlTehHb5qIjuY/6lGYiprM6OVJNIriyMUw5cmsY",
+	"UZoiSEJruKw7Qcs31ZZ7xyhoeXH+ZSrSHFO1wFxtRsPcL5sO583uSlLwF9sJN71gokTTT/rmeqGuHfLV",
+	"nNE5YgIj1XDJfZdlxBIpe4qdqPWNLnyLLGFU1LnCJCB4/YhJUhbOC5j8Y8YFneE/oRGmKv3OEOdBaeKD",
+	"fmF710CYC/Nb4zS7jZNtCZfzqaTfWz9mI3p0vN04n8P5PMUocWTpAMwgJv/Np3Dv4E28/83ut8neaD80",
+	"UQ0S5VmeSq7QNKBhdrzLF1ygWV3PfA7jmu7Vq8Yx5FY09G/xs3or1y9s3xrMjN5koMEGJQdtVB+YW/0K",
+	"SvT35ppT8726rZkGYEvST4lq6nKmP4bJ4qAyhEeUNAmCyUJ/cErF8m9GmZLI9HdaOjHQZ4Y1qF7q5AZy",
+	"85nryVD5IfHwzG1YJ+q4xavfMJEkxU6xE1nGU8Q++7JyfG6jDgMU8CeYZh5wmBn2NHLIS5n3NYCEUKEP",
+	"gCOhZVz1NnBEBQDb391/3d19093du9zbPdiV/+/t2v/9305ILZSLa79p2uHDuf7diXLNlIXRXMWkpelO",
+	"1LmdpXLdhiQXKKUcyKeiWYL1JbE9DZUyzpWhYrNM7g6ZAPmhYRWGg3ABmVBQJ/9Cuj0kABOBmOz7GgGO",
+	"uJJ0tuY0AegWxdseeMQMQSGXnM0T/WNu7vIJSpHQCI3irhrI/oFIUgQS93EFSoxQcpI0XvNNK3ByDARk",
+	"EyRyNYtbc+Hkr7IR3X2pb/OVMRM8HodkVZQmIEXXKDWaFU9L5Sv1oNPGWkrgzlwL1JVDlDew8BG6Tq8l",
+	"QvhL2JMUcN588Hqa3mnBRDPMGb3WDHOeSrgtsUv9MLAxSv0aHDFRF0cp38j1ynYWea0Saiy3r3AGfI7i",
+	"Xi4S9eTAOIY8NLKg4XEJuqluzX4ZUY3amM6rePjFPYCMwYX8Gwdg7SPBUtGLE0TU1depcqHETa19KfKu",
+	"V9/AeG931H39No67r15+s9+Fr998091Fu/uj/fhl8uqbb9vLI/6hKqBWk28J6xeaVa8kpVxqTjGnhDtu",
+	"kmtmjAI544gVRhpkcYw4H2fpAmiUTtwt2siWO7kKJDAbMaU1q//h8vIc6Aal20xhBucfL9sLOZf2+nuX",
+	"XW1YR4Pg49Es16rlgGYDV5KEfp4iMUWs2Cvg8piQZum5OK2OrhN15G0oYyWikL9egShYLmzQL+9tB87x",
+	"zvXejr1x7jhyvON2he9YiLGiZnjwRUqhghmYaBEapucecdVKlaa56Q4CdME0OamByJgyhlK9oyfHJaAE",
+	"W790jbTUPTk2irDt0Aq4gCLjDUCvG4CYJssxcH931w3hrmeRuohzAWfzsLIAQAFupjieluBESohWwRyU",
+	"m/b2L/deablJSktjymZQSFCFAnXloKEFqzkHVN2ICCycMk22AjdTCuaIyX5RUkGNIg9FM4jTgNIk6qR0",
+	"gknwjSULlReMprpXx7ArTcpcg2ejaruoc9ud0K5VmWUGtkrsSX4amQXYsauMqvQRTjr+wZp99URPQ00j",
+	"y/0MoK0ik+ZyZ0kiPYICpnRSlWRihtRBwjQg5iQ0vkIspmSMJ//ilNRs/YiKwziWZvjT+vPJG13SK0TC",
+	"2rIKShdgLtAvTqqPi2d4ctwIOEY9WISd4i7UfFs6XgJDJxSAO4bm9CNTx+bwL2O4EzUuQ3118T4MVeYS",
+	"429WPo63xqUAZMGkDD2a7AcuM5lmJZSgs3Hn4LfPxbkR++HvX6Liq6tshDRcVd/Fsn9lT0XVlyMEmerw",
+	"9zI9MW+qc5zjAWLXiK2444fnJ+a7L5HpvAFy/TnmIxY/DEGHv9iHmXoMjxATy9Dk6FC1ku2VrSf8jXv9",
+	"I1qstg/5Z4UR3PRCe5PDz8HninY28mHogXaOEml/CRMewlGcMTS4wvPLlP+EGB4vwvO08lb7zTIMwI5f",
+	"N1qQ5VR20XiZrJt0NtAh7WZy9bYNwTEHHCY4J0TyMsoWAb61opqheJGFJBnR25CwE/TJ6ecOBe6WbCZe",
+	"dbypdsmYdnr4nwyNOwed/7GTuw/uGDX8jrd4DQYX5jJXZlLFmZ3rN9byxaUOnijNEfGn6Sx0HGC7p9u+",
+	"eqPl1Mxw1oYb4G5zRpXxOTBV86a0g2DLfnLPqeleBgIKHma7MJ7CUbrk0mVnpc1ouYq1EwUw2110AqZp",
+	"/aayWPvJ/RZruh9ksxlki+pyS8iZI4u/D22RM8fCEpYeMzwOaDgnWFzU2uyP6GyGtZ53xCCRZm298dKO",
+	"TMfOvZShOQVzqsy90oT/Q//wuOiakVtJ4m/QbvJqvJ+8HL2Gr9C347fwm/jN6HXyCr0cB20omAwWJG6G",
+	"BOWuA0tmGmWnM1OUU1K6Q2hcYtzZQqatgHKMKMi5fGNUVXGplOag0ApY2rqKQUcuwRia6k/kvXZLMuuz",
+	"CzaajXFgKoVZhKxWq59HgwKmdjOsBr+1+akeWxUgl7xCeAS4tpqPFvl46rzNUdwRadVgYQqlz+BkXcDZ",
+	"Cdq0i2J48Vz9ffXROAxKlSk7xPI2eymVMftRJC39WxR/WGaRRbdWVw05gOBnNBrIC6vQXjNj5mkKrSXE",
+	"2ka49SpRoijgSFnrpUcUFwkmL9Q5v2CI4z/RC6tP5drtgiv+XPqGZsJ8xEWCGPM+GhIxRQRAAl6gWyxe",
+	"KK8Y+QdjNG8HRmhMmZwR5dZD1kxWe6WU5B+a1ChI5Rha+WR5D53N5My25JvtTkjfFNO0RqOVewLGNM1m",
+	"xHE0gdgME5gqh0f8p/Kiyt1DXr9++drzQQgquRIoYHhQ+QbcMCwEIlZzps4FKKdtmCgXOPuYZmJHb3pp",
+	"xXdQoENOiVGtKRuqfwxgS51YUCnI6M3SDZRt1rd7+kloRPnGjmOX6+mN5T5qJwz8J1JqpsS4lag9VBQV",
+	"y7/VasO+9j4NUW+XYrmP0CVcfycJjfXPXFFDxVHMkLhA4+U3lLxp6K5ZqyAqsKZaXbqoMptxzfN1an9y",
+	"HY8cz13AfDJu75LeLi49rOKJVI9roF0/Hu1SVmBTPCwjgIKHDwdbbhOUiWIbMDRGDJHYEVfrv9JeZSyZ",
+	"oORvUgdfo5n3fb2l/DdllJg5ga2y75BgUDl6UkXYIBN4DGNhLHHbrRX0d3RduptzkdrrIoCUffC7TQag",
+	"VZyMloy1VN6DyaKFGFU+Jt/aFrrzQR52RpbP67e6ZHQt9l+hG8W+v8tvSnAioUZ5vo4WaoMq4ni427ZO",
+	"1GpLxkjEU5Q4kKwR+V+OX8f78JsgUDo3rOYTCPtzBby5goeRsbTa/ceL9+WbZAB4pkLM+cHOzgSLaTbq",
+	"xXS2I2F3pwDAvQkWS72K/Nt1wLUoM/RZAmMbymuIa4nqLleF8VCclvlI7kfRfzi/23jRMkqALZDnVe84",
+	"VZVBlYoae3Vgvqe5sFlwbR4rf18J/wlOQl7OWzaEQwfMhYTc8KF59vMWfLFWE3LqE7WKJtqL9sjh73qv",
+	"yZvESmt5xwXr/qkHZiGvDGhl69JcnN8drzfAB/igG/hzh94QxDoHHYHgrAs7IYWz8jDDlFz6Ruy72aIl",
+	"1pyRdGHdAgIajhFK77wYD/SN+vZLg2joUcBFlzSdwNIwn1JYtussqoSkqkjEcjAqNdCZz0iH7C3fsbZG",
+	"y9zJoQhBysPZB9BD5XIofUn1jUbIYQqw6losNfdWLSJ5sJBx3XSwvRRXcxQp4epZJpSjk/WyqtJTbQQN",
+	"xvc5txHTyP6ZR4KVRCHzgq/DVTLYh475qoW0koEAJGie0kWu4LPeXlvGuU+17gk42a4EwuyaQJgAEgrE",
+	"xQf4r1Bs53v10o4fATyWPoAly8k3vd2l3WOyvHsd4qokCCmyzuSUlgz9pvd22dDn4SDhFkNLfjqTE7et",
+	"eO0s8kCjlkK5jjJwB1jRD9/N3y2Xwa1dZrukGF3Bx03hWb38B/VGuB0s4pIOaeZBua829PPs6MQP9iz1",
+	"WATyvF0R1v+dwYX0WJc+bnGyYzG4yRHStDldenuyM8HEpyJtz00LXEfalzlgW9EvymPpr3KVmSMBXoyp",
+	"lvwVPFOC7uL4rJx67brKQ3QafJ+1DhfMYIIsUUoLmLWiO7RusxZX6KoLtOf9nG9MD5MJQ5z3HPdawSG6",
+	"sG/VhduRJTe/n5e0gJMPmEvdcvONrAIehIKUkgliBiPtZBmaYC7YYrmZYckNKWeeOest4lSFz3nkpbCy",
+	"pWJBmfuXhIPznLCEncCaHZLzlTQp/qp9mCvr2dFFYFebNYJRx7KWQFyeywZh27hIxHymbdVfIdHRGzxf",
+	"4dIzsJsc3vt/Ds5OBy7CsWz1cB457b2HZYdA9wi2EgbHAuzv7u929/bLrA3kHjcVdComAXqQ0b0hlsnG",
+	"haZ21m033tvh8BF40mQVC7Szd4PkwEvryp1SxBRzj7i1V7waaC2aCdpwfiMkhcTzOjbP55BddbXfMmUN",
+	"3L5Nwq2G8fd6r3pv6kS+elt1riNZtr1LNCDVPS2szJtD5J97WyBzPkJlCBPx9Mw5hVeWp9BFNQJbF98f",
+	"gTff7kpEqfUiDwskuhcql86cAVHrFFMaF1SNUpTQei86X+QDBS9sdSKM+woI5/++XGqJOuaBHFkOh7gI",
+	"mNvqRJfCGu1e7W3b5eoYFbfcYjiHlIN3chqyYyK5dCq1wAx0xFaD/GI2EyZJBMz61KZqiTLf1DIQ0rk1",
+	"UbWAqwLklOGKJidSavb1NJpfQEwU0f7tcwfPFG/vcIHGkMwZTSCxYv2Blr06njml8x2Mr7p0PAavZ7sc",
+	"MKRiIiWmGYWk6x7kQruZbf7AGgw6Rwzy6XtK57Lbs/HYuPjL1j9DLPRW55OcLSib7HCcoBiyfHamf/Pc",
+	"6+NCU4HOl9+NCk4H6hp926vu/uvLvb2DV28PXr2V+rYpgqmYasNFsqhMvPvNm7ejV6/fjL+Ju3AU7+0X",
+	"RDf/4lceP+rcUHZlg3y0QrNzrIRKk6qstEVfvkQBNm+PrXLrNhJN3sbKo+c08ZlKsUuzq1V3NHuCuoGP",
+	"JTjB7E+0o3zc72J1UwqBXFAACRIQp1oDoFx3YuWG5w95F4BrqS7IV1rRFLhea1hRo8HLCpQ2+ZACh6Y1",
+	"BtAgGGPVuAbdoBA06cCvhcoz6tjj1h21uT95OFVRstEEWK03SMozq0HAdrZdi6ahIfU74OKEWsTwL7fv",
+	"aiyqqCNC9OBueiVMvNg1uQzM3Z2zrW6pBj5kbytARpFStbRw/Ww+kY4kNbDlX3HtdHLocWca+XRuOfsz",
+	"DK7M94wxIOQ282hWmFKUVsFQklsrqp9hPk/h4rRiX/mwAGZdYK/GST+324THa1Sktv4gN4Osxd6x3PRS",
+	"OXWze+FT/8ERh5KVJgeKoA9+lQUYc95KNxE9eskqVes+e3eHWbPKUAhnQyjuDWVc2Fjc2jnqvhc6EZPf",
+	"ZKqSrfg6bwMA5otOJDdTqf00bTlGEwa1n8L3NsdWnoqlACFe02YYyXHH0fj2frVFGAkDkI6WeDQ/rnie",
+	"mRjrwKENspk9p6Pzj3nqs9yDl3tCX/Eq/Xp3N+gCFCSC7RhviTgto0EB8XBG2aLVcnXTlVa8/w63Z+8W",
+	"63OXuO0wBQjSQGPxr+hb5OMyYm3ZFKzqIy/Pz3Y5UGrvv82fPcqCrFmuvkn7oXYHk5JFvOon20aTUjSl",
+	"teq2PWdYzXmoqHdRm9AW003oUxHRPSt3MELJxgwYDWWeqM5sxeH5Sa+idCn6tJR0zucnPzlb8xgTk6vY",
+	"qKVQYs1RCnIwBwzNGeKICKeVgcQk9ugNiY6F44BPaZaq+881YjoF1oTgP113vGTEkifF5KVLqSciqYsY",
+	"khlcmNzMICNeF6qNjAj4QFXgzpgeAM89rHf1lkuYll7lGcFisSORkuFRJiiTDOsapTscT7qQxVMsUCwy",
+	"hmTGjK6aLhEq3mCW/A/rFsJXzGuHOYBAt9RzzTfNOrNe9AeXXupEubF6D/Om3NtOuROYjJXFB/PcNIhI",
+	"omKugPBiI7LRDAvu56LsDcmRwm0wQjZvTG9ITgg4gjOUyiymD7+bcgd5V24bX+YFVaK8ApIEMpvlCdiW",
+	"ATi/o79UCSPYCAsG2aIwUju3qdL91zQBLo1Er/VFUmX3wpS8YzBG54hhmgx0lsrADukXMrCN3qBEUYWJ",
+	"/G6cpTZ0QTYtjI6JePMqSCvt0A0rOzZN7rKysQykwH82aovyNr2VDA8TRBCDosayf86QTCWrtkc3lPgI",
+	"QaaTT0ny3AvN2DYO0c+BRDMSu8CRAqrnH8pFJYhjhsyVt+VZ3MFhrkSR0KKridAcYqbobgwFmlCG/8yT",
+	"FfIgiM8ggROUqMRsPJRFVb3WZneu/ZzlqiUNAVvyrpCiW4O128EB2ufG7K0rAWavVma6MAEH4fhg+05u",
+	"IL1R9pvC5tXoMYust5aLtM+ck4XyAAQTCVTjFVdNZi4RRbp7K1VhrPzjBaNpcAs5SsfvMbkK0Yo5Q7Fy",
+	"1pCNuikmV9KCHuwma0gOd3IMIOd4Ymo85FebXhtvxUjlw6sVrAZzFBckoAK2SigyDXudKGzlDl1sqRRK",
+	"r3HiTNWZknEYlmpWPiQKriRIHenXuUzgdaTOgdMZAuh2nkJiqsGYT3T48gwxrxLFmEpOIGGUsgSxgyHp",
+	"qsoXk5SOlGZbaeoAJQhs6TWrT49Udo5t29qhl5082Dp0P7W2HMg9xWMcq8YR8DrTqT4iYMhh7oWS+7/p",
+	"u40eDnM9V5QoJ4ghOdaTPAC//V6PXncKA7trJiCtEzytJzouQzZMEgA+A0y4gGl6AD6D0rcHqiH4Ar4o",
+	"gqy2CszgXIphGRe6QpOIAOS64gQmYEaTLEW9C0QSxLa2vQ1S+bCDfncJGmUBo/c7RrM5gLp81djzY5CD",
+	"SZlBApz6diJhyF49pIHw3FnGiweRZLP5Ue7tUaIA+Uu1JyyLVRZZDqUtN3ClMOVm5AdDYgBnoG5wPQ0q",
+	"coIqg5mb5f8Jb0A2m58XPEGqM8vft59cvmX3mV84g5ZSQZEYoxpNmcpfBm6U55dKKEt6YAvO5yYAVt+r",
+	"JGqbuWYSYNKFPEnjL7Bdi11LBayS6rgkC00pE9U6B+rWqGknGGAySRFIsZyf1FHX+2uEV29eynVqK0SV",
+	"HCMmBn6caUko91+DGBKJbBN8jbxyDfJWC3Qjq9HBZDIkCCu3O8rAiCpV6pBI6gXBef9DF5GYyhMw9zAv",
+	"UxTY+kOkvBcz8YdOgj9n+BoKNCRXaGFeXqHFH9v/u9rb0WGppxjqjuTQsi9lnEHXiCk+wDMd9B+BGyxL",
+	"aiBNPwwnV3VAyMQAyZBY5zVdF8mbuJqlnJzs0xAFPAYLmsknQyKNrIgIOSfZn2EG3kT/t1YM6skDzPNO",
+	"hsT0AjKuxW8lFRi+boL1vZ703MxhzDLJGkYathdzBP7QFcL+kGfyx1UuEWC6I1L+R0/u0ikV6AAMsvlc",
+	"gqdVmfwRw+9xiv6IwB9yNPVbLfuPK7TQf12hBQdTeI3kkEg54xtJpioEtBFllQwpep07h3ngCaEsxHjU",
+	"c0CvEWM4MQKMoe7oNk6zRHvKCsSIU5f1tKSh+wT6LjIkW9rYh3nuGw85kIF1uuF2D5yMVUyXkWySCEAn",
+	"UfhAFw1JTIkuiKbcduNs5uioPIUFzZjv1DxWCXgzmSsTym+o5DksLLHbDFuBvTBv9G2Yl8AeAkJJ9/L9",
+	"QOf+zA30DhWCfASbCmqFIg6d17NOVFdqrZDy8+zoJPfbUt6jmLuKKIpXKKWMznKBObDDyWZwPmf0Fs8k",
+	"9qv4BLhQ4kFmkulS8C8sjN8UIjyT6InGYxwrZM440r5n3lXFAELnoPP/bf222/329//aGg57+tf2/9ma",
+	"8f/w/8z+M93e/q//GSTP+txZmD7b5PcGEKaQJKmW1yGIpzhNVI6ao2NwFmNvT+wEI7Nr9ntjh9We5lJG",
+	"NH5+QyILW+VyrrmD2s+Mg5SwIrHTr+k0InYJOXH0CRolkeULL+ANfxGBF/DPjCH5YxLPVUKQF+pqj+MX",
+	"vSHJKztpYViihAESdV3023YljRplOE0QOzCNDv5hGnhmq/wJvOHyv3ICnagziedhnzNGbxcNXO+88N7N",
+	"1CiTy5zOqtNvF0Y2zZi5dAiqQOpmilOdtyMjBSZgJbcqUn0Fcska/E/PIRPah/sEZCwFNMYHOzu6jFH+",
+	"nfobHejHAk703+H7b+3ee9KG3hlLn+VjLXCUtt7uG1CpdYfEywZhKozR9BppH6hcS6lhm+ecEniMssgb",
+	"y5liv9IBSW6PY1TKRlvevXIby5x8T37Pzln8wFXs8zAcqRQ/ZvvmWapkG5sqCMcIQPOttCpDIaAKrlcN",
+	"9e7yHvieMjCzGnPJOnUJFKs5r2w33xGQX/Edi0+oO6dJ16GK99xMomsmsfM/YJJ01VzlDMwEuoJ2Yblp",
+	"Q1x/iFjLe0oKJ0CgNOUOc2UpNs1NllW+xRxUdGoezwwWJFs32hWKdFm+/GaXd0I5yU1jRfAZmlGhODPw",
+	"2FDu+gpSfKWBA5NJkZ6/2W3PRuuY6LVLQFrxR8fjRWtCIVimCmDMs1GKYyWwDomFeD2G7gJPCBRKKiKJ",
+	"R/01s3Xs0FB3QbVwMiQ3JtBIbpNGF8xzTKpSDVWS8ezk+MimGw9pjUtNSswI28eu9nPMsEAMQzO9IdHc",
+	"Ry9PNoBETdAlZDEiBOTA3Cr0TeOIyj/lJqWI8yGRf2Ey0RcM+/ELns9Aebqhmd7NkRkRo0R5SxIbtDAk",
+	"ju/rOWuLnYinhWJ3tteCsrhko/d2RW2TtzV0LlxAUL5yhbTe9dAOEg0J7qGeHpjzDDFXNcUKjnRc/rp6",
+	"nPrTgIytuyweHEMTdGtvGXLHSntgAVITX7lWOzVzIfk+S2NMq3c/26VlaXBIrmGKE/COykGzFDKpGWUm",
+	"+1qYCo7CHlYD/eJeS3HwYrd2hfWoyywEq62nxF7NKeWrbKPMLErxlnLGCkUqxPPcNC5tk0DxlNCUThaO",
+	"y8rP7ZUHHBqc6vn1jOwI1lQbFGdbSVPFyRQkgIA8OyQBYvlV5J6q15g+iaUt3S20INpqTvv70hi4Kj+8",
+	"yMPochk/N0+oEMZckOlKiETsGnUzckXoDemOjWFQBbuqjRQoDoaQO824qoqLRlNKr/Rlbc5UbR9gjc6t",
+	"9NqufETYeqxeg3GWjnGaepdHp4V8NHUpv8Jz822tdvxkDBY2V6XVoxjl9xbf9tThYzzRxh551xTwChFJ",
+	"aYzsNyRRi42TKKMCc75bfFD2hCbL8kqHX/KhkPdyubmqWJlawA8onVkTmpyG4mQqyZG2bITsww1JqRxE",
+	"ef0aNUohDxWAwvqLqdJiQ7KlVSwcnJ5dqplNSzPrmY+VdGwVElbtglShZW0pU5Wo21lidHhXg+2oaHBz",
+	"2m2ApXb/A1wAmHIlhEBX/1o218oLMMtSgfMkN9wD3VMqbBn6RBmUsLDaDY6EtAMRqmS8G7iIjE3By0hu",
+	"OhqSLSdQmEf6dgTG+BYlfkocygBH14jBVKIV3+55G2S32d1ZG/LyhOMfl6S/WTEht/5upUw2GomWe/nV",
+	"RKwXsgpXK7jgcb0Tgl8gz2sYedlVJV5hovKoCVp4VMhq/oEmWl51foPSFrN9rzwSOvVaq/J5fgqJ0tTk",
+	"sUGmCbPp8euV1nuYknqFDBKBugT3rK73dbLP+KZ9zMEWZdYHdIS28xwVgq4SMpS1zP1tSs/qrNYHtWCP",
+	"ueP1Gl71ZxYZ6j/UyOb5XVa60OksTmwBlIPyfujGrpKs249i2YdSZ++wqHRUzgdVzLOu+7fpP+wMPSRy",
+	"ib/touXP0uT9R+9wqdqs912ruL1ybFXGW1POYKLxYoxJhU6lkIsLV2+3Jnm+LrSu2L7b2iJAad2BK7aY",
+	"Lrwivq39HL8OIt4t+ZPLE9c42fm03OVF//D41zuHA9TPdT8cGgCTxfmSjk2RZqpZSYpkcehlo+3VjMaD",
+	"WVp0IK3rT0N1BNBsLrzAJA9npy4EyY34W2dPTkrK95AhFS6svbW8IOLO7x47XnrTMN8FndCEzrSp3vvu",
+	"NSYeuwT76ui8ub4uOqq+3A8HWGASo/dL0E+lXEYpnHOUAPWFl9bXoRvwC8p7UUPT/dlLvgqvOJxMGJpo",
+	"z8NCYFmRb1RKlruTK9Um14FiIeLev7g4u9D4oZt6wWaV9qWi4AskImA9/BSkKkcOAwW6OxuCVulLg7uk",
+	"++pzn6bld0XVKDBGEOz0gCZsrjieLZ6CBc87MdXU1xtyl3dR9RAlDXTgvIro5v5W2It6QXd5/HYBJr3i",
+	"7G2Du2hSS/S73yTfxm9Hb8avu7f7t2+TVcL7v8eM62r45kSt338hDYH5etX4/nricrGUrMxpsio1CYsS",
+	"bvvdbNqIwzeQSQ2gqrIZ8mGnXCEjIgKYprrYtKr4RNPr3CSroClSwF9EM8ocRtQDljbQ+cHMe/utCGtD",
+	"oJHZYT1Rvwy6V6+ZJnWVhgZIF0dcOWl9IP9FQ+qLu6U1aFrTnbClOOkgnJeL7eiLv4vyM5iTl+iwNle3",
+	"mcvhsbWQbEWyXA4xMpUvBnmoUKSLZbBvK3KHg4/NS5uvp6SxWFLxdYIDao7aMhwmQXxTJr1QXQ1zQ85C",
+	"2ezuUyykJrlg0a+k8p0xfDdDVwsFfhhY8txkEyxan2woF4V55Zcaq5aLpawZUV0daaXNEU67EwzvVsXk",
+	"ApkJIJ/6JQB0u5yP6/mBLfmuorGqjOIKVZeKQsrHd5l1beKg47Kjs+0FbJkVmE+dz6m/gO2G7A2razJb",
+	"Fo3QQWpGY7gHtuSkaJogr6RcIfT75YrVzo9NrEzx3MxuKN183Va04UIVFHKFzfIptUUIB/VhpBhcoRSJ",
+	"EFLMtG6kKargHIop9wvWyxn7YRbSVY5mwpl7TNSzmKKFs3Ib2xUmrtKYiiYtiiNDUrxVlvWT438nZNV7",
+	"5L0gUK/1XptTzM1q85K0WGdUeWwT/cYp5PzUWGPvmEA11xsEVhkFwKItJDpQq4HEmstt4XUhco2OlKUu",
+	"ELo2JIcqcuQGEmECVoxri4wswzEWAI4kYFrfbt+7LZJfJpS80F7FL+hM7uRcLF5IIMWCg3zyvSHZ6t/G",
+	"aK6drV4Ye+YLZYByXmbGLVjFyChj23ZdbF04t5iKJ7IeG9LRXHkL6RcqpZmJgddopIcxfjSr2rZbhOWo",
+	"GZSgF8gdSq3rtr2RKnRfyY7smSjlsaHGiOAScy19auJzRqgGVqybtvdd5VRcqaYGVcwy2h2sr1MxJK86",
+	"+8rcuZfQqDKWQdpje7iLGjmwiZrZd1VIzL/TwXxrBUinsa1Ol2FivQ1CM/Zf238LOSsENXmoM2axqQek",
+	"GVn5++AYpql2+ImU4gkT4XwpRgiYRDxSZwY5UJPRpSjDsQSYiOOmmLLzUgsZ9zpflHU83jcRoEzttVbI",
+	"ylZbFguTbYeYOm1+eELn9Y4s54X3ettiMyH3OLIBUb/uEEXXeuCSulgsTOwehSfQMLYdNqc1Bb8F960V",
+	"LPSh2AIKxTZDcuKopzZJ2oCU0cJ306mrqpYsLhrKaXuvy7BlFg9+2fnVd73QmrmHArQaj6EHJ9yZvTrc",
+	"MX3DR26cbjW0kwSpbbTJH/2TVUP9doUWv/fAITZeqzb8XF14PGbeG5IfkfQzlibaF1MxS2V0i+HRSkuc",
+	"QjLJ1OBJBJCIe71eMM15Oxknd7SoL4v+eHnN7pFmrIlWBS6Erl4PZWUIwzqMjiOxfTcdWcjG94Dmw9qM",
+	"Y4+Z7X15UZd7pnlvrOxTYyY9nwZMyFvKNhpp01AEfj48ufx0dnRifl30z8/Mzx8u+u/Nz+P++SACg4+D",
+	"8/7pcb9UQL/e1uqzjJVpddl0WtRtb/2y82txGns7e0vrR97B/829kiZUVnQosWlftcHJSz57r0KPSxLq",
+	"t76+GeoVpm0f58rmtRbaVtlzXfG5/U1dpxO7QHxOib6wSz1QbT6YI5Miel6qSdZAIxrrjk10ZdhwPeA4",
+	"KfmyVAoCl2qEgilNk4p1Rr7VsGbTCwTrz+5YAG+oR/us/DeC3m3nni+bnbBxbbK72LxjBvxUmje3ZZYG",
+	"9xZwlq5ijh8UbPCZxoywSf48hYSUzd03xp/MfprIAi0LwDKyrb8yyFa1ktsPrJ+YCrc4KGXyqNwpqOLP",
+	"M+fB7GdCRLIWWl6qLmDhL47puw6EV24MZwVLutmGTtSxS+tEHTP93IpesJx7DQMeia3rDtZsYNmBr4Fb",
+	"rkaMfULkT3RlrzFLcCvkWIcqntIgMa7PFX/k54kvJxNr7Qs2YTSbB5NvAvWq3LF1J3LZ+inLXxZTy8L5",
+	"nK+Sib6YBjof0Miibkg3mtNW65MAW9r5/QOc88iE2vAI9Hq97fX6gBQ/XCUBZ/WU/GTOXmWLOwndgU7X",
+	"RvQDfTdSfYaIDIUKK6aUF0Opa0v/BUMVIMt5zuqZv3XvKsl++7zfhiHMimXvg7uwJ9/u2+SlNr+DFgjh",
+	"NcSpqVvRMqHcyXHjaK/hy/ib0beouzfeT7qv4rew++3oDep+k7wa78O9+CV6PaqJ6q3NslsVnwIDXy8n",
+	"pJqU5GNFvqNCuZa5YhN+LQMPZFpQVI9m1tBTE6JXJKdfgdptqMEG0lsDtK0FEoDnS4ZC1SRpElZzWhZZ",
+	"SFPjXUfymgpy6nkAQS5j3rh6rtK9pwf6MiJOjmfjTGOZJ1L5l2mKXhRuLcr4Fo+o/ES1kl3IZ4ALylSd",
+	"Wc26lYLTdDMkOdfHeZiC7lAlSc0rUuqgsbz91oWmyQMlDkg3JC0U9IbkHhxF0571mKmLhx+27eqTbg1J",
+	"ClxKoFS6ZR987hSfXKqeK3hDgJSdivmDbZSXsHnRmOlD5XThnrwlE/l/0o06Ucfmj9FOQp8YmlOJPW/5",
+	"JxtvVJNDqGB5TVCQZY9SBGYwnmKCugzBRD1Qqgggv4kUhBhEPwDfHR5/uuj/vx/7g8sI/HT4/uT48PLk",
+	"7PTT94cn7/vHEfh4evjx8oezi5P/K//6/uziu5Pj4/5pJKMzP31/9vH0OAJHZ6ffvz85uoyG5Oj9x8Fl",
+	"/+KT9/b08EN/cH541A8/PHyvFGef+r+cDC4HETg6vDx8f/bOb3x+ePTj4Tv/+yG56L/vHw4KfdpH5R7t",
+	"czdN9+TkVK1YPvjpZCCXXehucPbx4qj/yen7IvDOqAb9dvLZ+cfBD58u+v/sH1325ezkM7lvbhvlA6Np",
+	"/PHjd/2L0/5lf2CfXPTfnQwuL379VNxs9/i4f3pSeFCYpXmm+xqSw4/HJ5eqRf/08Ds1+NHZ6eXJ6cf+",
+	"p/4v5ycX8sn5Rf/o7PT4pHTUg4/n52cXl/3jTx/6xyeHny5/Pe9HQ3J+eHmUL+Vn9Zfr6vLs7NOHw9Nf",
+	"LRRJBWn/4qeTo75czk+HJ+/lLCLw7vCy//Phr58uTz70zz5eRsBs/qeL/uD87HTQl08u+xenh+/1Wno6",
+	"NlalySM2/MBhGO8VfWs65VMOWwdUybia0M2uCd3UjSx31Jizpe70+qapnvCmaEzZW7O6B5sUGfcLYax1",
+	"ffshm0ECHPYnVcOHXpbRJNhZtPZm/1CmL7phY+f5EtVu/yRtdbmupFnUsAtt41FeuynGmKgJtHP9c7G5",
+	"ljgHNkE5BZwk4S5jyhhKNWj40pz6CGz90jVFa7onx2CKYILY9ipaOTmEyoWoGygaHnREF0G+JT+Xb+y8",
+	"SoxqrSyq5tA8p2n1wVLuXdaDl/i30giHvZ1hlmDRJxIkawwdpiR6o6HF5fpKgGse2nGrfpV91ZACRK6L",
+	"3u0Jug6dPk6KzRqso7kTiqct9AX33sv93v5K5KKv8HVmC23aGDkvMNeE5sTTunt8/rKxGr5nO5E3EQON",
+	"dFweLzBWXTV8rOPObfs2JGJCg1s3oXu9/Ve91zXoyYQ0SAdlLmaiVs1CJCp1zdpUknGygi4ym4vgMB/n",
+	"LYbIF/PN/vTlbJevdDksmWG9MQpd7/Ze9XaX0uz8WjgpqovNPrqVeoi0nDTkyF8iC7WWvIQtLjLSDJZW",
+	"5a9cYeYMXWN0U8p/7dGQ2gTYYTO6U86vyZBeX4LrY265kPgAOEqtQ1Llwmv2PPKymsib5v1qCzrtfjjV",
+	"zjzkNmXC2vVF19q9ZOo7yr3CWdoTxtRIYggmgbUUerjDIszsB1d4HlqBtsIFAaloENdqzyy39LjkWi6C",
+	"vxgb0gI1GwxAxeHubgLKwTNHXbPmyGLRKhUTa0w95rGRilayvMtoRoZjUcBZlTU6aJOPVDSsx1mUs2mD",
+	"ik5V8xkopKGs/fCFs3BpEXXk9AilBg0pK2tKe8UspHPIRJeO/yHJ7orKyVYzU87DDQl9ViZspjnYMncW",
+	"9Vcvb7ddMqEqZ3Yzu/sSQR8ZXXY8h14rIaixaceB3CC8LhWI7UGZO/T3k1I6EF72iOBgaxzwpODbfl/O",
+	"N5XrSgwmNmeOmOunYIJeI0nZEnCy7R1R+ejWRlbaUg5LIsIERJHpJ+q3sx5XGsMyPWcQQoknn99MaVqQ",
+	"ms0Hd/eqaXAK0JvS9ug0Dy2dG0fsnNExTgNSmosKDNvmi3espW69Ki/3aoW7nAdy+1F4NloWDWtTfpZ3",
+	"Vn5qp+mMJHoP3IrtnJbvubexpT33y6vXaC90sI/KEG8aa04xpwnYyg1wkQpIkkVCBkhE4BiiGSXq5z/p",
+	"KAJHjBL1wzgaFE93ufnPjr1W81+w01qm0hBfvvQIimXsZVfoVpdMPaZxKJXwj8fnxZIbJj76oGNTlNva",
+	"v7q+hdZ2yNOCOk+uQZgOTjDr4T+xoBlBBP235KECpj1M7eTNaK5CaONIgSTk6lKkKqLKK7szezlLk+p9",
+	"YK+JKY6RsauY0Q/nMJ4isN/brYx8c3PTg+q1rFu8Y77lO+9Pjvqng353v7fbk87vCvuwSN1i9HBmVnPs",
+	"MZeDzl5vt7fbUfntEIFz3DnovFSPtB+eOo0dmMww2XEOdJ8dqfuy49+vggL/O6QFrdTkMxXT3OvVD4BW",
+	"4qeX9FGhVZULKX7uMptLztU5lLOT6VJ/fMsvvPrFXqTTb23ZmySENgLfnIhP13OQV/k6O5q/BSPEwgwx",
+	"n5T381OKZ1h0VvtGQjcmGVrxs6LUvtq3HEEWT9VHZYtAKndwtChm13o4t3F1TP/OEFvk5+T0pms6FCuK",
+	"rfaVgJPABg0oEzJHs6ogqJOMjhbgRfeFSXkqW9tUpSxBrG6JlInCAq1ga3hiN5CDo+v/UQ0WiTrd0EOb",
+	"sKNrf+SCaTf/KRcbdbrFjA9ut3+POs4AJSe7v7trybIpXA/nurINpmTnX8Z2ki/ujmqVYPCzP5Jy9X2g",
+	"kSpMwTRV5E/n/UmMEvyX7pFF4ZqRTWP776ejHOU7v3RVKrTukU3F06YD9Yn+wkzV3AZXOJU7ivnVndEt",
+	"tPlLnRG3oVN35RkaIrlOsDrDpPO77NZwrwkW1geriVVZJYi8e4B3+maiLujY8KSyksRWCsIM8AWJp4wS",
+	"e1XRtZF1JZuMOK178VpsriG9Jsb2DouB8x9r5GsF3YYJBqzX+dSQGZ/jPT8O14JLBQ9KOv2o1HI2D9p2",
+	"XXa0u3Ofx+UK/jl263zWuwEHNMtHVDKoBYmNCaJb+PsJUHyHF49A85vGqtC2d1jY4nEbwn8Hwq+o78Rt",
+	"YpDyNlL7OaPy6tmO1tvGy+i7chrURYdkvXmJ8pgLHPN10PhzO+MNhf/Kd5jcxmduMYexwNcoApcmCyUm",
+	"kyJv0A3+KpzBYwCt7wssv3d3vd8my19X/fsEGIZBMqmc44/AM5YM9yVQmuhfqoCToywb9nEX9tFE0BvZ",
+	"RittFgRmxKVXE+HXQQnOZh2co63Oa8M5Ntqvv6T2qy03+1urxVxqh8fSjtUPWKsks3R1w/TuwPQKriur",
+	"MD1j315mwPGdKF2uAO2VmNfjFF5gue8ZbOZRx8beIeE5K94TQdpuetE7shkH7tJn9RDVWz9zVecZwBWv",
+	"zroJmow/jDq5OeUBgPoum80LLlHOp6bgGqUcbOi4CtteNlXrsunJW058knX15XP7JPIduhTEwpLLma1o",
+	"6ifRUK6hrmYEQ2CsahOYCBeU2DqqunrqkqwaNpClUtTCbFpixMGwryLCygW37FtFWTkPCl/uFlUZKNUJ",
+	"k6Aoz1zNAU8I1SX3DKLfWWB13krtZFZXGVGyBO6lpc4zOUhMsfWDZzWig3PIrEhHuaP87y545TuaLB4C",
+	"HQOeWmuhOpV+qxdLizHuUEliwL6aBKnkeVi0eX95HOLsu8Ouc49Cm3NoKEfZAbyEfjLrncvaQwmw6Xok",
+	"Dd/f/ea578KAzry4p9BOWOqlYmM9B3O7Q3lRHp82eUQJ5fThKTO+jyGPTp/x6FuzRyPrGGKWYFErV6kC",
+	"v3IM1cwWamEopkyCm3UYmmVCZ0r3yvlv6RD9yPjBRqYyrURqVXoYbQfIrxzvUIV8qZGWUd78VqvSbRrf",
+	"vAgoNzx5sNYBL0Rv5SeraTzz4R5EG9FiMP9CGBrLf3+nsWBshKdQ7+5l4HqrDluFACX6hzruTtTRh610",
+	"BCjuqmgh+wciSTDosH56NBMxndWtPn9bnaAJN+5EHZn2K2Oo1chnRJUiFBnT0ksZAaCQUAbHQoUdYQ4E",
+	"rp2dKunqT61dBYa7TcmUMFg2J0HXMKMP8BbPspmpdaEEUj0nQc1EIzDzyj4p6bRmQlrP5s/JUeC93d2o",
+	"M9Njqb/kn5iYPwNFrR5biZETrkdQYDQOVuEk7z0nFZ+UP2UWF+Q9PheTjw0X84OA6xmZvH7ZljXM5ygP",
+	"D35U2DHjPgLg1I/UCDVuh586xPiH7EGLe1QEmJ3P5tdJ8mWJQcU0lGwIJwHweYcs9CwTW0yzem9eO6WV",
+	"vHl/f5xbj4Ofdcn7eYeVs7Yb9Yy0USU4WQ0CXaBcMx2T5XxNQzmKHTAIlcptw/b6VeDy2fnglSUst9dT",
+	"aKpTYu7dqsIhdCHhJv/mCblZFOxPT8H9wRn1HtzzoXakOt0UL2mYN8af9mQxbaRcd6STO58l2DZzbtO2",
+	"OJ7SQ9g3so8wQz939tKvQzjD8b0GZQMDmTdPTmhwqLYuoSHvsB2uPnH0aAej90OSHcN+lggXtpVhL3yO",
+	"YjzGsZ1II578ZEfY4Muzwxd38E/+hrcEQteEJTufza923CVfYSOGHLtmf10ciZbkpQgPk798PGwM1wjT",
+	"o1YLbzUj4ip91eJgDkXPhWMlPkCvF+/U6vKVNTr+5KikuKapomh8HcolbuXra8R0VUhBAReQJJAl4J+D",
+	"s1MwUN/IUidwLMD+7v5ud29/OxoSa+2SUgWYIIIYFNQMKJ//evjhfV7FTXsh8R74eYqIJFPGjq1y4Zmi",
+	"lJFKSaYyRXtDD4nNI6Aby4to2Fshpyvyc/31hq7cn65EVbdkpQ1QpyWP2gcUd1gKyDC3J113EUf1VrS5",
+	"X+DYwPBD3MkpQWdjBRuriQ8elH2JViJ5vy8noF9lUrWU2Dvh50KKdd8A8iBBWz95Nn4HXe6Vbq8h0opW",
+	"IgB1ybuuoF0kbTql2l5anit53B0Uazhyr067IbxD4lN/hsCczjNVfTZPBmzOr8IJ9J5pp7pAbfQoH4MS",
+	"6c81xfE0UDF+i9BibfntSM1EKj1QAmBKyUQ7vHkOGzUUvVylfEPP10/PA1U9oxxGbH2IwJJLxNx8ewpn",
+	"K3pcNNSfW6NfRz5KCYdcNJCgNSOW60c+gUtxqHz/Wi7H1Y7rvf9dm6fMEzxyW5y2JogVGrt+3tBCbC+x",
+	"rS2f6hrZR9VB3m4UfDdC7+YyXbND/DlKb/Vo0ICkXtr6Zu+Xq4rfYq0jTB6V87iOMHrcx3CEqR2p2RHG",
+	"bsxzcIQJnLcPRvZREYz83HlLfGKq/Te6x7jUts3E+kGS3T2We4yFqrW5x7gOq+4xZqOelXtMHcisBpc7",
+	"XkatVahe/lkN3TvN+/0qYLrJZ/JXzWdyt7QlT8AjJ78EPjxTbhqrkS0XMuxt/HLuKiUEExV69DiqiZdV",
+	"VbsRuHrrdRGgsLqZr1X4WpLAgwZRelC8LjHA77JyyJ42Sd2KpAZrhGyR1BbxkXsPSkCq6fSXYXa+ILMG",
+	"Wy5wnKXp4ikLOSFEqEWlTIRK/iRtMEk322DSU8Ok3a8Dkfm8bcX254IwIXi/811g57P7/UWjVopEoKbA",
+	"sXpeHNSKoQFk082/MrJFDfnhK3GhVa2jff3kbszLoad4TPpMnw+AN8FaHWdop3JpA7rvkNjA7e5fizU9",
+	"V31PS/Bfkc7LrOnF+lstokDztE8u94NJoF6jF7Jp1p9C0Y7aEU01MrukAnoFC0g9JbS7Q7Jrl/h+8TjJ",
+	"tRvHa1RPlKHseYTR1mOJh7mFx2vA3p3Phb9PGwN8jGMezGHeVHsPs0JzhhskXtfEagpxBeZTOdSnyNNL",
+	"OL4uvl7uNpiLX+3pM2HttWj3yHRhR6ek4Tuf9Y8v9Rn9BhmfI6IyQzHEs5n2iRoHyzeqHH9cf7Ad6U90",
+	"9X8sOMCzGUowFEglXSExTrH5zv6NerpfWYPNOXsfCgAJobo43HZvSJRj+A3Eys1bIYD+y/qZFyclKJhC",
+	"kqR63nY+kCQ2VFlOTVX/kHRbu0+EPAwvMvKj3/Fh3CYaZUMR70QRq/U5104ZV7LEaSxZ0XwngfIOn0gb",
+	"Fs2km/Xj0G4NyBcqZ/j6SHep1wqRPNM5p1xyHbvBnVe7r74O2dauzKayS0IRVznw0C3mQs/r2685rwLB",
+	"VAnrDJ1FST5rP0bmKbNBw1Miy1EocyusZy+PzSIThsf1Gf7OIdbcpuiLz8v5WsFWgrjyjucCCrRd8Wav",
+	"rawNtlJ8jexnvq1frSsaEs3E5pSVkkTPMOfS3I4L/UX+czk9G381xihNQIquUQoSPDZVTa3LP2YqUlRW",
+	"M7jQY8GUU1l7WWWs1cXduVzsNdbpRyV4aSeAWkkBc7dJP/QPj3sNtw69pmN1GBtm+0yZ7SOxMg0la+Nh",
+	"trsKaVMvwAiJG4RK6G6RKs69JJ+syZXO5pChVlTMUa28zP6jEuOlxVvMzcpmS6lbSJOW8ulUF94QmQeX",
+	"6L9Owqy/d4Xi51NQRVd5eKxqKjWjbeoNr1Hltowx1DO0Ja57Oll4KSJ3OdfRX+d8Z8N2nrVsezdnrpVD",
+	"9/Pi5GssbP4QOQYeaqK1NDHkdqY00LCK+prrjbL0CljH8d6QHIJZlgrcdXkpVF6SEU0W8rqqdcgJgDzQ",
+	"o76/rtdZ9FG3fJmnadPOhzxNe+CwuL9G2c79+oQ2MMw2KVXkkZt6h/IbD+9k+11hYTdTxJAujVEutVKo",
+	"pGG2SasSd7+eKrFYAsmbL0iomutM1ZsoVjzCgoNcIn3qTsRtWHIjv2/wL4ZEq4SlsLwy09d9bJj+3+yu",
+	"iccfJE51HsHv2zGBNed46LRlvcaNeu0e3w9P1u1y6hzBnz3VfrW3/wQsa7rEWwI4Jia/ysm4q/AD9C/h",
+	"BNzAPHfbM/C/b88PvpbKdOezl22njXd/aRnG8tSCvenvN+ztL8HeKvPzYWI1F7ZitqdHYbJPIfzC7Jnl",
+	"K3XxFxuy/ABRI+1oWNMloMnKdGcKWTBqb8jjhjzehzw+bvq4R7lSlEim2TPP0CFpUVsLhWr7HGwT9ydW",
+	"umhkwElJ0m+4RDCNQKbcgkwNaqizn+pvty6+PwJvvt3dj8CL8n511aj/JX++2B4S6j79gNgE+R188/Lt",
+	"m1IHM9mm0INLCBzyBlKdbUjn34Z0rleKrKk77uukFSTmVZFdBXJdX11d77C4RwXyR1AYedh03wJPIp6e",
+	"Wfxbagf3xl5HcamVxi6TkeLgzekT/W+rE1819WKL9IqSHjrrkqC6ur3Nmpsnsv0qqRIamPKlhx5FbF0H",
+	"b96o1Tb3N08kaieyPA1d2s4UcxWZtqzKhvWNdlC2TCBLEMPXfh52zYZkgfYyoI5l2NSyq+YPZqIbsWlz",
+	"4/yKN847eoJcGPR5PI+8hhEDdqMScrvE+A57aZogLiynjzPGVN0Tgp56GgqfeAFD7drRsCdCoRlN0xGM",
+	"r+rjWy9Q1xDXMlUFugASBHO5BTTj+VZs6U+22+1FMJzUTGxzp90Q569gLWE5gWt1PbZLcSTOIMITuib/",
+	"tTShSrinqk6OJBMPcvH6qoG2Bp5YEZ4Ccbeb69U6Nc40TTVALWdbSkVRZX6Pzdn1CawrY0UhpQgwp2uo",
+	"2pPMZlHIILRYZzqLd1gM1Po3qSw2qSw2qSw2qSw2qSweLpVFiYyHOM76GOucJnzn85wmX3bkZkFM9If2",
+	"95cdVQWpTm05EAzBGQcemZvTRCofuTEV6w3pDhARoH8tNxZsDQb97QgMCUxTesNBQm9ISqGKKhVTNNOR",
+	"M/MUSilD1hiWV2zFVYfE3jTUCNAU9b2RjNHBALjGEBzGMZqLf5RPHWjhtkYL+l4u9SnztgdJ5lqtf0aT",
+	"BuPqnN5zPUcWthoGcfC3JvutBbEccCoABjDhAsFEIhxXYI3JpFd3QTX9Fa6ojvCMYcpRFLyylhLOZrMR",
+	"YnJEqa5PMUGqNCaf0ptcJagEVE0E5OR7teUkcfpe9hCe097urpsRJgJNVMGj+3LbcDrOfDH2oqNRdDDo",
+	"A6w5DM/mc6rqg2+h3qQXgcENnEwQAx9PttUKrTa2dMYrq3WfwgwljO0gSfy6GrKapiinoFsVJ7qlIt5U",
+	"L8CgB7duNLIVIoIt1MwqaKEnoOC9aWSZ5UO5ROY4YuEcjlKUo0pokKoR2/IBt8OYuKRB6tIyg4I/B7V2",
+	"qtmC5biSZd6B0y7NS+KG85LauoRP9uuarCQ/vuXPIivJQzGwv2NBL6uLMeW8LvqHx79GoH9xcXYRgZ8P",
+	"Ty4/nR2dmF8X/fMz8/OHi/578/O4fz6IwODj4Lx/etw/Llb/Uv3dq/jX3zuFScvqY1FHnZ98aX88n+Qn",
+	"j2dm3SQ9eWgm18R1PO539bZFppOqtjjnAbUZTnIetrmD5TeXOWJSVJLCGNdXaY4TBBK2ACzLrWpzxLiJ",
+	"LRSeSuce9rVNspJNspKnkKykEp69yVmyyVnyd85Z0sRcQ4y6IUXJinxaf7Xh00+KT2/yizxyfpG1ofom",
+	"jcjGIWf1NCIrkf+7KilXzgQS9hG6auIm+ssNN3nkCMdNnowN3blLnoxlGB4QPRvNHGUlU3OF1Q2ZeEwy",
+	"sckX8dzzRSzDstBFsSkzRKGvIvw9m6QQGxryNJIp/JWvypvMCpvMCs8ws8JGYF57YoIGjvlA1/R7B76U",
+	"eEIhrAU9YGBLwNkbc108zn1kS979gNKZwZOyZqoHlkTI8NLnSyNkdFG7UowMj/JYNN8DRzkQYgGmkANC",
+	"/ZHqisQ6eeQZhNX8HRUgmwCYTQDMJgDm8QJgHpldKl/qZXUCC7Et+gsAOacxVjYbVe2wkoMIOs9sJTEr",
+	"Foc5QCSZU0wEmOEZjrW3wAhN4TWmKgjgD6lNi0UK9ERGOYcZZru7L2Nv9uoB+kMxK8wBz7BQPtqS040Y",
+	"veGI7ViH9YzDSV3iHx2Ss7kWP00G9HVKD9Y5HOvYA7WgmhAU/arqM3tK2QymnajzM2QEk6AL66M78qaQ",
+	"i4JzbvmBWU7X/MsQ5EpI65pfD+CHW9yBnIJI6lCmQ8bazV38N5+jGI9xruzTOO9u/zDRAixMz5kkBAIj",
+	"bgG2dHO+Z5jN4ZOY85eoNUWvnU6AlG9ci1fQQYe3Gj40a58imIppPWuXuS0qN7hIXWqnKJ3Z51w/khE/",
+	"rlzzjSZhdjnZHGCi0l9IJXiqq7BnfEi2flBzWETgnNEJQ6qgegSO0YTBRDpxfg+x9LykDAy8K7ZX9F0S",
+	"GR6pPzLC1CVUziQyr7mATAxJLAHBPJxRLgBDscS00jzt9AWeIU/Dogqy5/bTXCDrLbPD6eVtRIa/mjXO",
+	"nOu6bXKu2zrLnN0/g7pPJe1Q9Ur41E1+cDJhaKJuJ6U9fShie9fcpjX3Pelebdvqy20sBTylAlQu1FpR",
+	"XNL+DckMJpKoMZpNpoBeJfOuNi+BLevOHBlfu0jr0rWqz6TX245ygpjPFDIErtBcLCeHzyBv6oYebnKL",
+	"/tVyiz6yzkgFfy/TGMl5yYZaY+cOApM4zWyWE8xAnnDFSmfmoSRJstkNZVcy+r4HLm2PkKEhSTCP6TVi",
+	"KHHkTp3vDUEMMKQi7GPE85MvoWTZCGLtKmAGCZygZEjsyBxsHasNniElZA4EFGicpQMk/zqGaEaJ/v1P",
+	"OuLb3jrkbGuI5rncww2d/MvRyXOanJAxfQT6WD9ShbQcuqg2/xZXwL7OMwrPrV0EgEJA5R6gLqOPQAab",
+	"00ahWxTXEsqPc3UJ5iZhPSFIGYL01H9GowGNr5CxxGaEu7z28oFJPemGMomXaVJmfEMCOXhhVepyPi+U",
+	"NK+pKRcJJlrk4yKhmXC/EWP5bRmxGSZQ3os5/hNpeRDdWk8FyIdE6Yb6tyj+gDiHE5smhcGZGwpxxbkQ",
+	"SbgxGBDwAt1i8QLMzEcxZGxhXZ3kKxDTxIq4Q2IWryzPeQ8SXlwXxo7ubAyYA30JGC0Mo0wkKKME9H/p",
+	"HwGYiSllNoOlNsRF4GaK46nNgDNhkHgdvPhfL0w7M5RSQLit8tIUmQXr3XLSuzk4mCW6PEGIO8h9PKfJ",
+	"hjsU7qCP4Jz318n5denRCkGVR6H8iYUEx0lmBBlKEFCGgjzQCcwRc016KhfKPKUJsnMIGRfMQOGUW791",
+	"dkaY7PCptBS0TxdV6zcp0+VJDOYAgsvLXx0dQ4xJlBVTRIDyp0u0SjKnbbU5w8QiPPWCgr2UE8CTKPZ2",
+	"9x6CY3rUNMgub7CIp4pY6kXmHGPOqKAxTY06VPeh6VANif7qgXbOoUoTXZ//ZZpN2iZfXScmmazTh41Q",
+	"SvMjKGXpzrnz89Gfnc0R0UW6Uex4ttHt+7RpTXnAyuUnjDdHVzCEWt0wJwzOp7nQoz/nLmGB4dru9snx",
+	"DKeQ2QM7ZBMKjo7dh0COeyDJ5JAU7oe5sCkfAy4okyjEUcyQyI0RZnR3HTfDF7oq3CWHpPkyGYEjRon+",
+	"JU8Nx4hH4IQoU4r8ef7TkWxEyRhPPsC5aqbm5K6h+byGREzRQt6QwdYFUpCmRzpXBpVer7etyWm+EsgQ",
+	"qLloKxORvagjBlSiL70VevM8/8bc8lK+nnu2Hq2fdS21CcnJhnnmCD23pbrIC9PwkqGNT8dfyUDjHesa",
+	"7TOFXgOKxWbKsrHU3EOnqWdeoMEPdWVvVd/IREMYT4i6mkbNqlfDEsxwBQOOcqYg6MZ11VTvaBOu9WT9",
+	"0trUBHqOUV6bwkCbwkCb8KaVCgPVMIHlFYHWyty0xNzCA0FPzvpoNYvRA5vldsN8/lIeTuZc102CXbet",
+	"cg88I/HUZXu+H+reSFK3PA26LSkiZwAT5Sk5o4l2ypUXZJv0pQiUzu3BDbik/khI8vy5mCVgk0p9mRSo",
+	"OdtPiPHV4+SmCDIxQlCsnFWd66iVk6SN1HbH2gv6EpNAAZ36RXWiAlOBAhQ1iYMhAQAnBy5Cp7Al6iGS",
+	"TdTHB+Dw+Lh/DP4DPpwdn3x/on4e99/3L9Wv787OfvxwePEj+I/O4i6/kzOwneejKod023mbYgwBLMhr",
+	"TPi4TqjAY0N+nrRh/GcXZB0gBLCAHZZsKQJ0b8K1WhCZT82qvvClzb8jxdrEcD3tu/KGSv5VqKRe3DOk",
+	"kTm9CbkGPQhhbPSUbCvkWS/KlaikvHXbODPIEDDeYrX0c+OPuCGbG7L5gGRT+g08Q6JZceFehWTSTKis",
+	"zt2lF15Zdk2SKTWkTp4MrvURV+3pBSnXBDHm1NluuCVRzsBs+xsSOvYH4s6dEgqoqguCM5KWhtJzkEQX",
+	"EmDXZbs0xSjMX8aBkFAgnUMQGxKlhHXDMDTBXLBFZFwDtbbTmt6LN3k3Rd2n/IBQUZ6weo4nhDKU9Gqq",
+	"o52ZOT+Ze/1je2aXNuARPLSXjxjKDZP4UWs8AlwXYBwtchhX4oH86zlo0Gh5SZqcGKBpr1GbM6rEmVoa",
+	"IqFc0QPbsgYTzm1HSzDg6KFknL9job4cdk2pPpkl6RpF4FJ7l0vjZ7H2nm5wr+J7X7UoXsvad0+gkJ1B",
+	"iMeIWakdqUJZ3nu12OY5xm6yTrShvRVS6Lts2kdLK9id/Xh8bnuorVhnj/SrENOHr+DiIHZdhrK8w2pi",
+	"Vv0qWO9s7TXIHr6Ill1OqHrYMygx5UN/Lfo01JVSXtTGq2cJJukvviomPdUKSs8I/3afKP7VllbaeASt",
+	"vzJRHdaHKcjSy87OZ/OrbQ2iFaiO/uJrU50wzDaE4eX78Qyq/jwelm/qAD1iHaDVsLyhCBC0XzZX/vm7",
+	"IOkj+cB9DaFCOryxmRrnr15zpwLUteJzU7WdOhx7NqV2/kactSb8wa8IbQt3WNAIxD5gsalrs6lr81B1",
+	"bXIW/VXq2jSwiMsqemwK2jzNgjYPer1blrE3kNxSf6E8KK4RW1gbW0lxbnKn6T9sZLXOliHzq3H3jI6H",
+	"BAtuu6lNXqZ7apf9diOUrkQg1p16ttxtrYD6RHLOWih91jlnc7SE66UQj+BR49GNqu9M2S9lSB7Wd0af",
+	"fj7M1/GdMSiyqgvN357y/T1ddDYkdN2+QktJKCbXiAjKFsu9hOxQtqHvRGgpsaN7mJmMNdLhSiY/VIKS",
+	"lP9iHoEJo9lcn7v5UrVD5BozSoxuQJXjg44EUwYEnICxdozBHHAkIkAtzXSzYhlRlQsgmEGT6MtV4/Ap",
+	"n/b0dhOX7+TlGaMExJSY/MbpIiquT0yhAGOIUzmfhCoggYTfyCkRXR5BjzLXkO0u62Z6zXIhP7HHUaWQ",
+	"7e/oiFyv6IZk9njFrwScrPjFmlylVpjiI9fFy89vbWKo32WdCMoBzls9fWLVREFCxMEjX/k6y/RrqVyn",
+	"FaxmNpUsNCHX6KY5BQjWY9KTJfdMK2ht6Ml9XC+LFVq3LvqHx79GOgAiAj8fnlx+Ojs6Mb8u+udn5ucP",
+	"F/335udx/3wQgcHHwXn/9Lh/XPTTVP3dy01zQwjz7FMGfZ8VIVyV6LQihPbGuVyQK19utxiaU45lL4q8",
+	"CTjZLsXP3Yc+Hiq6xoGyGqgBU3yFwLCjr5mOJnKB01SleeJzyK66mrpRBvZ6t8MO2DLz/kfxrYw52n8j",
+	"4OQfe73b7ccW7/SU6sixibn6q5Pjv7y0VUaY5yV1lWdvb0qU3Jf4ZFxCwmwxZ3SMU9RIeWYLIJsD0zaM",
+	"MB8W56are0LS3Kv7+LkjB15h2z9yxOw8vnzx9T+/6a5+j1Y2xj36hCqg8qF4AE8ddEvw4kGhfKz0GPIb",
+	"1YkmpRlLOwedHTjHO9d7ihiZLyr+xsV+0a1AjMD0mMb6cFQ/UyHm/GBnZ4LFNBv1YjrbkdXBdrwSYZ0v",
+	"Tm7TcwqksDdK0zUNY3WwncbIb6NGXdugtrtaTeq6RnK6qNo48x/fDoCnTV7HoFdvG8Z7h8W6x3PCFkZL",
+	"znFOk3UNqrqqDnZoy2qsaRhVpiM0TjLDBHPBrDvXWgaTnQYGe4+vSymJwFY12nx7TbPQMd3VWXzIUoG7",
+	"VirGngS4jlHz/r78/uX/HwBpT+Xd2D0CAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
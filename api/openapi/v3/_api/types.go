@@ -11,6 +11,98 @@ import (
 	"github.com/oapi-codegen/runtime"
 )
 
+// Defines values for ActionResultAction.
+const (
+	ActionResultActionReconcile ActionResultAction = "reconcile"
+	ActionResultActionResume    ActionResultAction = "resume"
+	ActionResultActionSuspend   ActionResultAction = "suspend"
+)
+
+// Defines values for ActionResultOutcome.
+const (
+	ActionResultOutcomeNotReady  ActionResultOutcome = "NotReady"
+	ActionResultOutcomeReady     ActionResultOutcome = "Ready"
+	ActionResultOutcomeRequested ActionResultOutcome = "Requested"
+	ActionResultOutcomeSuspended ActionResultOutcome = "Suspended"
+	ActionResultOutcomeTimeout   ActionResultOutcome = "Timeout"
+)
+
+// Defines values for AuditEventAction.
+const (
+	AuditEventActionCreate    AuditEventAction = "create"
+	AuditEventActionDelete    AuditEventAction = "delete"
+	AuditEventActionExecEnd   AuditEventAction = "exec-end"
+	AuditEventActionExecStart AuditEventAction = "exec-start"
+	AuditEventActionPatch     AuditEventAction = "patch"
+	AuditEventActionUpdate    AuditEventAction = "update"
+)
+
+// Defines values for AuditEventDiffOp.
+const (
+	AuditEventDiffOpAdd     AuditEventDiffOp = "add"
+	AuditEventDiffOpRemove  AuditEventDiffOp = "remove"
+	AuditEventDiffOpReplace AuditEventDiffOp = "replace"
+)
+
+// Defines values for AuditEventOutcome.
+const (
+	AuditEventOutcomeFailure AuditEventOutcome = "failure"
+	AuditEventOutcomeSuccess AuditEventOutcome = "success"
+)
+
+// Defines values for ClusterInventoryErrorType.
+const (
+	ClusterInventoryErrorTypeGitRepo    ClusterInventoryErrorType = "git_repo"
+	ClusterInventoryErrorTypeK8sCluster ClusterInventoryErrorType = "k8s_cluster"
+	ClusterInventoryErrorTypeOkdpServer ClusterInventoryErrorType = "okdp_server"
+	ClusterInventoryErrorTypeRegistry   ClusterInventoryErrorType = "registry"
+)
+
+// Defines values for ClusterInventoryProjectsStatus.
+const (
+	ClusterInventoryProjectsStatusActive      ClusterInventoryProjectsStatus = "Active"
+	ClusterInventoryProjectsStatusTerminating ClusterInventoryProjectsStatus = "Terminating"
+)
+
+// Defines values for DriftReleasesDifferencesOp.
+const (
+	DriftReleasesDifferencesOpAdd     DriftReleasesDifferencesOp = "add"
+	DriftReleasesDifferencesOpRemove  DriftReleasesDifferencesOp = "remove"
+	DriftReleasesDifferencesOpReplace DriftReleasesDifferencesOp = "replace"
+)
+
+// Defines values for DriftReleasesStatus.
+const (
+	DriftReleasesStatusInSync           DriftReleasesStatus = "InSync"
+	DriftReleasesStatusMissingInCluster DriftReleasesStatus = "MissingInCluster"
+	DriftReleasesStatusMissingInGit     DriftReleasesStatus = "MissingInGit"
+	DriftReleasesStatusModified         DriftReleasesStatus = "Modified"
+)
+
+// Defines values for ExecMessageType.
+const (
+	Error  ExecMessageType = "error"
+	Exit   ExecMessageType = "exit"
+	Resize ExecMessageType = "resize"
+	Stderr ExecMessageType = "stderr"
+	Stdin  ExecMessageType = "stdin"
+	Stdout ExecMessageType = "stdout"
+)
+
+// Defines values for InventoryClustersErrorType.
+const (
+	InventoryClustersErrorTypeGitRepo    InventoryClustersErrorType = "git_repo"
+	InventoryClustersErrorTypeK8sCluster InventoryClustersErrorType = "k8s_cluster"
+	InventoryClustersErrorTypeOkdpServer InventoryClustersErrorType = "okdp_server"
+	InventoryClustersErrorTypeRegistry   InventoryClustersErrorType = "registry"
+)
+
+// Defines values for InventoryClustersProjectsStatus.
+const (
+	InventoryClustersProjectsStatusActive      InventoryClustersProjectsStatus = "Active"
+	InventoryClustersProjectsStatusTerminating InventoryClustersProjectsStatus = "Terminating"
+)
+
 // Defines values for NamespaceKind.
 const (
 	NamespaceKindNamespace NamespaceKind = "Namespace"
@@ -22,12 +114,53 @@ const (
 	NamespaceStatusPhaseTerminating NamespaceStatusPhase = "Terminating"
 )
 
+// Defines values for OutdatedReleaseSchemaChangesOp.
+const (
+	OutdatedReleaseSchemaChangesOpAdd     OutdatedReleaseSchemaChangesOp = "add"
+	OutdatedReleaseSchemaChangesOpRemove  OutdatedReleaseSchemaChangesOp = "remove"
+	OutdatedReleaseSchemaChangesOpReplace OutdatedReleaseSchemaChangesOp = "replace"
+)
+
+// Defines values for PatchOperationOp.
+const (
+	PatchOperationOpAdd     PatchOperationOp = "add"
+	PatchOperationOpCopy    PatchOperationOp = "copy"
+	PatchOperationOpMove    PatchOperationOp = "move"
+	PatchOperationOpRemove  PatchOperationOp = "remove"
+	PatchOperationOpReplace PatchOperationOp = "replace"
+	PatchOperationOpTest    PatchOperationOp = "test"
+)
+
 // Defines values for ProjectStatus.
 const (
 	ProjectStatusActive      ProjectStatus = "Active"
 	ProjectStatusTerminating ProjectStatus = "Terminating"
 )
 
+// Defines values for ProjectHealthReleasesStatus.
+const (
+	ProjectHealthReleasesStatusDegraded    ProjectHealthReleasesStatus = "Degraded"
+	ProjectHealthReleasesStatusFailed      ProjectHealthReleasesStatus = "Failed"
+	ProjectHealthReleasesStatusHealthy     ProjectHealthReleasesStatus = "Healthy"
+	ProjectHealthReleasesStatusProgressing ProjectHealthReleasesStatus = "Progressing"
+	ProjectHealthReleasesStatusSuspended   ProjectHealthReleasesStatus = "Suspended"
+)
+
+// Defines values for ProjectHealthStatus.
+const (
+	ProjectHealthStatusDegraded    ProjectHealthStatus = "Degraded"
+	ProjectHealthStatusFailed      ProjectHealthStatus = "Failed"
+	ProjectHealthStatusHealthy     ProjectHealthStatus = "Healthy"
+	ProjectHealthStatusProgressing ProjectHealthStatus = "Progressing"
+	ProjectHealthStatusSuspended   ProjectHealthStatus = "Suspended"
+)
+
+// Defines values for ProjectStatsStatus.
+const (
+	ProjectStatsStatusActive      ProjectStatsStatus = "Active"
+	ProjectStatsStatusTerminating ProjectStatsStatus = "Terminating"
+)
+
 // Defines values for ReleaseSpecPackageProvider.
 const (
 	ReleaseSpecPackageProviderAws     ReleaseSpecPackageProvider = "aws"
@@ -42,12 +175,253 @@ const (
 	ReleaseSpecPackageVerifyProviderNotation ReleaseSpecPackageVerifyProvider = "notation"
 )
 
+// Defines values for ReleaseDriftDifferencesOp.
+const (
+	ReleaseDriftDifferencesOpAdd     ReleaseDriftDifferencesOp = "add"
+	ReleaseDriftDifferencesOpRemove  ReleaseDriftDifferencesOp = "remove"
+	ReleaseDriftDifferencesOpReplace ReleaseDriftDifferencesOp = "replace"
+)
+
+// Defines values for ReleaseDriftStatus.
+const (
+	ReleaseDriftStatusInSync           ReleaseDriftStatus = "InSync"
+	ReleaseDriftStatusMissingInCluster ReleaseDriftStatus = "MissingInCluster"
+	ReleaseDriftStatusMissingInGit     ReleaseDriftStatus = "MissingInGit"
+	ReleaseDriftStatusModified         ReleaseDriftStatus = "Modified"
+)
+
+// Defines values for ReleaseHealthStatus.
+const (
+	ReleaseHealthStatusDegraded    ReleaseHealthStatus = "Degraded"
+	ReleaseHealthStatusFailed      ReleaseHealthStatus = "Failed"
+	ReleaseHealthStatusHealthy     ReleaseHealthStatus = "Healthy"
+	ReleaseHealthStatusProgressing ReleaseHealthStatus = "Progressing"
+	ReleaseHealthStatusSuspended   ReleaseHealthStatus = "Suspended"
+)
+
+// Defines values for ReleaseRevisionReleaseSpecPackageProvider.
+const (
+	ReleaseRevisionReleaseSpecPackageProviderAws     ReleaseRevisionReleaseSpecPackageProvider = "aws"
+	ReleaseRevisionReleaseSpecPackageProviderAzure   ReleaseRevisionReleaseSpecPackageProvider = "azure"
+	ReleaseRevisionReleaseSpecPackageProviderGcp     ReleaseRevisionReleaseSpecPackageProvider = "gcp"
+	ReleaseRevisionReleaseSpecPackageProviderGeneric ReleaseRevisionReleaseSpecPackageProvider = "generic"
+)
+
+// Defines values for ReleaseRevisionReleaseSpecPackageVerifyProvider.
+const (
+	ReleaseRevisionReleaseSpecPackageVerifyProviderCosign   ReleaseRevisionReleaseSpecPackageVerifyProvider = "cosign"
+	ReleaseRevisionReleaseSpecPackageVerifyProviderNotation ReleaseRevisionReleaseSpecPackageVerifyProvider = "notation"
+)
+
+// Defines values for ReleaseSkeletonReleaseSpecPackageProvider.
+const (
+	ReleaseSkeletonReleaseSpecPackageProviderAws     ReleaseSkeletonReleaseSpecPackageProvider = "aws"
+	ReleaseSkeletonReleaseSpecPackageProviderAzure   ReleaseSkeletonReleaseSpecPackageProvider = "azure"
+	ReleaseSkeletonReleaseSpecPackageProviderGcp     ReleaseSkeletonReleaseSpecPackageProvider = "gcp"
+	ReleaseSkeletonReleaseSpecPackageProviderGeneric ReleaseSkeletonReleaseSpecPackageProvider = "generic"
+)
+
+// Defines values for ReleaseSkeletonReleaseSpecPackageVerifyProvider.
+const (
+	ReleaseSkeletonReleaseSpecPackageVerifyProviderCosign   ReleaseSkeletonReleaseSpecPackageVerifyProvider = "cosign"
+	ReleaseSkeletonReleaseSpecPackageVerifyProviderNotation ReleaseSkeletonReleaseSpecPackageVerifyProvider = "notation"
+)
+
+// Defines values for ReleaseUpgradeErrorType.
+const (
+	ReleaseUpgradeErrorTypeGitRepo    ReleaseUpgradeErrorType = "git_repo"
+	ReleaseUpgradeErrorTypeK8sCluster ReleaseUpgradeErrorType = "k8s_cluster"
+	ReleaseUpgradeErrorTypeOkdpServer ReleaseUpgradeErrorType = "okdp_server"
+	ReleaseUpgradeErrorTypeRegistry   ReleaseUpgradeErrorType = "registry"
+)
+
+// Defines values for ReleaseUpgradeStatus.
+const (
+	ReleaseUpgradeStatusFailed   ReleaseUpgradeStatus = "Failed"
+	ReleaseUpgradeStatusInvalid  ReleaseUpgradeStatus = "Invalid"
+	ReleaseUpgradeStatusPlanned  ReleaseUpgradeStatus = "Planned"
+	ReleaseUpgradeStatusUpgraded ReleaseUpgradeStatus = "Upgraded"
+)
+
+// Defines values for ResourceNodeHealth.
+const (
+	ResourceNodeHealthDegraded    ResourceNodeHealth = "Degraded"
+	ResourceNodeHealthFailed      ResourceNodeHealth = "Failed"
+	ResourceNodeHealthHealthy     ResourceNodeHealth = "Healthy"
+	ResourceNodeHealthProgressing ResourceNodeHealth = "Progressing"
+	ResourceNodeHealthSuspended   ResourceNodeHealth = "Suspended"
+)
+
+// Defines values for ResourceTreeNodesHealth.
+const (
+	ResourceTreeNodesHealthDegraded    ResourceTreeNodesHealth = "Degraded"
+	ResourceTreeNodesHealthFailed      ResourceTreeNodesHealth = "Failed"
+	ResourceTreeNodesHealthHealthy     ResourceTreeNodesHealth = "Healthy"
+	ResourceTreeNodesHealthProgressing ResourceTreeNodesHealth = "Progressing"
+	ResourceTreeNodesHealthSuspended   ResourceTreeNodesHealth = "Suspended"
+)
+
 // Defines values for ServerResponseType.
 const (
-	GitRepo    ServerResponseType = "git_repo"
-	K8sCluster ServerResponseType = "k8s_cluster"
-	OkdpServer ServerResponseType = "okdp_server"
-	Registry   ServerResponseType = "registry"
+	ServerResponseTypeGitRepo    ServerResponseType = "git_repo"
+	ServerResponseTypeK8sCluster ServerResponseType = "k8s_cluster"
+	ServerResponseTypeOkdpServer ServerResponseType = "okdp_server"
+	ServerResponseTypeRegistry   ServerResponseType = "registry"
+)
+
+// Defines values for UpgradeReleasesErrorType.
+const (
+	UpgradeReleasesErrorTypeGitRepo    UpgradeReleasesErrorType = "git_repo"
+	UpgradeReleasesErrorTypeK8sCluster UpgradeReleasesErrorType = "k8s_cluster"
+	UpgradeReleasesErrorTypeOkdpServer UpgradeReleasesErrorType = "okdp_server"
+	UpgradeReleasesErrorTypeRegistry   UpgradeReleasesErrorType = "registry"
+)
+
+// Defines values for UpgradeReleasesStatus.
+const (
+	UpgradeReleasesStatusFailed   UpgradeReleasesStatus = "Failed"
+	UpgradeReleasesStatusInvalid  UpgradeReleasesStatus = "Invalid"
+	UpgradeReleasesStatusPlanned  UpgradeReleasesStatus = "Planned"
+	UpgradeReleasesStatusUpgraded UpgradeReleasesStatus = "Upgraded"
+)
+
+// Defines values for UpgradeSkippedErrorType.
+const (
+	UpgradeSkippedErrorTypeGitRepo    UpgradeSkippedErrorType = "git_repo"
+	UpgradeSkippedErrorTypeK8sCluster UpgradeSkippedErrorType = "k8s_cluster"
+	UpgradeSkippedErrorTypeOkdpServer UpgradeSkippedErrorType = "okdp_server"
+	UpgradeSkippedErrorTypeRegistry   UpgradeSkippedErrorType = "registry"
+)
+
+// Defines values for UpgradeTarget.
+const (
+	UpgradeTargetCluster UpgradeTarget = "cluster"
+	UpgradeTargetGit     UpgradeTarget = "git"
+)
+
+// Defines values for UpgradeRequestTarget.
+const (
+	UpgradeRequestTargetCluster UpgradeRequestTarget = "cluster"
+	UpgradeRequestTargetGit     UpgradeRequestTarget = "git"
+)
+
+// Defines values for UpgradeSkipErrorType.
+const (
+	GitRepo    UpgradeSkipErrorType = "git_repo"
+	K8sCluster UpgradeSkipErrorType = "k8s_cluster"
+	OkdpServer UpgradeSkipErrorType = "okdp_server"
+	Registry   UpgradeSkipErrorType = "registry"
+)
+
+// Defines values for WatchEventType.
+const (
+	ADDED    WatchEventType = "ADDED"
+	BOOKMARK WatchEventType = "BOOKMARK"
+	DELETED  WatchEventType = "DELETED"
+	ERROR    WatchEventType = "ERROR"
+	MODIFIED WatchEventType = "MODIFIED"
+)
+
+// Defines values for AdminListK8sReleasesParamsSort.
+const (
+	AdminListK8sReleasesParamsSortCreationTimestamp      AdminListK8sReleasesParamsSort = "creationTimestamp"
+	AdminListK8sReleasesParamsSortMinusCreationTimestamp AdminListK8sReleasesParamsSort = "-creationTimestamp"
+	AdminListK8sReleasesParamsSortMinusName              AdminListK8sReleasesParamsSort = "-name"
+	AdminListK8sReleasesParamsSortMinusNamespace         AdminListK8sReleasesParamsSort = "-namespace"
+	AdminListK8sReleasesParamsSortMinusPackage           AdminListK8sReleasesParamsSort = "-package"
+	AdminListK8sReleasesParamsSortMinusPhase             AdminListK8sReleasesParamsSort = "-phase"
+	AdminListK8sReleasesParamsSortMinusTag               AdminListK8sReleasesParamsSort = "-tag"
+	AdminListK8sReleasesParamsSortName                   AdminListK8sReleasesParamsSort = "name"
+	AdminListK8sReleasesParamsSortNamespace              AdminListK8sReleasesParamsSort = "namespace"
+	AdminListK8sReleasesParamsSortPackage                AdminListK8sReleasesParamsSort = "package"
+	AdminListK8sReleasesParamsSortPhase                  AdminListK8sReleasesParamsSort = "phase"
+	AdminListK8sReleasesParamsSortTag                    AdminListK8sReleasesParamsSort = "tag"
+)
+
+// Defines values for AdminListGitSourcesParamsSort.
+const (
+	AdminListGitSourcesParamsSortClusterId         AdminListGitSourcesParamsSort = "clusterId"
+	AdminListGitSourcesParamsSortLastSyncTime      AdminListGitSourcesParamsSort = "lastSyncTime"
+	AdminListGitSourcesParamsSortMinusClusterId    AdminListGitSourcesParamsSort = "-clusterId"
+	AdminListGitSourcesParamsSortMinusLastSyncTime AdminListGitSourcesParamsSort = "-lastSyncTime"
+	AdminListGitSourcesParamsSortMinusName         AdminListGitSourcesParamsSort = "-name"
+	AdminListGitSourcesParamsSortMinusNamespace    AdminListGitSourcesParamsSort = "-namespace"
+	AdminListGitSourcesParamsSortName              AdminListGitSourcesParamsSort = "name"
+	AdminListGitSourcesParamsSortNamespace         AdminListGitSourcesParamsSort = "namespace"
+)
+
+// Defines values for AdminListProjectsParamsSort.
+const (
+	AdminListProjectsParamsSortClusterId              AdminListProjectsParamsSort = "clusterId"
+	AdminListProjectsParamsSortCreationTimestamp      AdminListProjectsParamsSort = "creationTimestamp"
+	AdminListProjectsParamsSortMinusClusterId         AdminListProjectsParamsSort = "-clusterId"
+	AdminListProjectsParamsSortMinusCreationTimestamp AdminListProjectsParamsSort = "-creationTimestamp"
+	AdminListProjectsParamsSortMinusName              AdminListProjectsParamsSort = "-name"
+	AdminListProjectsParamsSortMinusPods              AdminListProjectsParamsSort = "-pods"
+	AdminListProjectsParamsSortMinusReleases          AdminListProjectsParamsSort = "-releases"
+	AdminListProjectsParamsSortName                   AdminListProjectsParamsSort = "name"
+	AdminListProjectsParamsSortPods                   AdminListProjectsParamsSort = "pods"
+	AdminListProjectsParamsSortReleases               AdminListProjectsParamsSort = "releases"
+)
+
+// Defines values for AdminListReleasesParamsSort.
+const (
+	AdminListReleasesParamsSortClusterId              AdminListReleasesParamsSort = "clusterId"
+	AdminListReleasesParamsSortCreationTimestamp      AdminListReleasesParamsSort = "creationTimestamp"
+	AdminListReleasesParamsSortMinusClusterId         AdminListReleasesParamsSort = "-clusterId"
+	AdminListReleasesParamsSortMinusCreationTimestamp AdminListReleasesParamsSort = "-creationTimestamp"
+	AdminListReleasesParamsSortMinusName              AdminListReleasesParamsSort = "-name"
+	AdminListReleasesParamsSortMinusNamespace         AdminListReleasesParamsSort = "-namespace"
+	AdminListReleasesParamsSortMinusPackage           AdminListReleasesParamsSort = "-package"
+	AdminListReleasesParamsSortMinusPhase             AdminListReleasesParamsSort = "-phase"
+	AdminListReleasesParamsSortMinusTag               AdminListReleasesParamsSort = "-tag"
+	AdminListReleasesParamsSortName                   AdminListReleasesParamsSort = "name"
+	AdminListReleasesParamsSortNamespace              AdminListReleasesParamsSort = "namespace"
+	AdminListReleasesParamsSortPackage                AdminListReleasesParamsSort = "package"
+	AdminListReleasesParamsSortPhase                  AdminListReleasesParamsSort = "phase"
+	AdminListReleasesParamsSortTag                    AdminListReleasesParamsSort = "tag"
+)
+
+// Defines values for AdminUpgradeReleasesJSONBodyTarget.
+const (
+	AdminUpgradeReleasesJSONBodyTargetCluster AdminUpgradeReleasesJSONBodyTarget = "cluster"
+	AdminUpgradeReleasesJSONBodyTargetGit     AdminUpgradeReleasesJSONBodyTarget = "git"
+)
+
+// Defines values for ListAuditEventsParamsAction.
+const (
+	ListAuditEventsParamsActionCreate    ListAuditEventsParamsAction = "create"
+	ListAuditEventsParamsActionDelete    ListAuditEventsParamsAction = "delete"
+	ListAuditEventsParamsActionExecEnd   ListAuditEventsParamsAction = "exec-end"
+	ListAuditEventsParamsActionExecStart ListAuditEventsParamsAction = "exec-start"
+	ListAuditEventsParamsActionPatch     ListAuditEventsParamsAction = "patch"
+	ListAuditEventsParamsActionUpdate    ListAuditEventsParamsAction = "update"
+)
+
+// Defines values for ListAuditEventsParamsOutcome.
+const (
+	ListAuditEventsParamsOutcomeFailure ListAuditEventsParamsOutcome = "failure"
+	ListAuditEventsParamsOutcomeSuccess ListAuditEventsParamsOutcome = "success"
+)
+
+// Defines values for ListPackagesParamsSort.
+const (
+	ListPackagesParamsSortMinusName ListPackagesParamsSort = "-name"
+	ListPackagesParamsSortName      ListPackagesParamsSort = "name"
+)
+
+// Defines values for GetPackageJSONSchemaParamsSection.
+const (
+	Context    GetPackageJSONSchemaParamsSection = "context"
+	Parameters GetPackageJSONSchemaParamsSection = "parameters"
+)
+
+// Defines values for ListNamespacesParamsSort.
+const (
+	ListNamespacesParamsSortCreationTimestamp      ListNamespacesParamsSort = "creationTimestamp"
+	ListNamespacesParamsSortMinusCreationTimestamp ListNamespacesParamsSort = "-creationTimestamp"
+	ListNamespacesParamsSortMinusName              ListNamespacesParamsSort = "-name"
+	ListNamespacesParamsSortName                   ListNamespacesParamsSort = "name"
 )
 
 // Defines values for CreateNamespaceJSONBodyKind.
@@ -72,18 +446,37 @@ const (
 	UpdateNamespaceJSONBodyStatusPhaseTerminating UpdateNamespaceJSONBodyStatusPhase = "Terminating"
 )
 
-// Defines values for CreateGitReleaseJSONBodySpecPackageProvider.
+// Defines values for RunKustomizationActionParamsAction.
+const (
+	RunKustomizationActionParamsActionReconcile RunKustomizationActionParamsAction = "reconcile"
+	RunKustomizationActionParamsActionResume    RunKustomizationActionParamsAction = "resume"
+	RunKustomizationActionParamsActionSuspend   RunKustomizationActionParamsAction = "suspend"
+)
+
+// Defines values for ListGitReleasesParamsSort.
+const (
+	ListGitReleasesParamsSortMinusName      ListGitReleasesParamsSort = "-name"
+	ListGitReleasesParamsSortMinusNamespace ListGitReleasesParamsSort = "-namespace"
+	ListGitReleasesParamsSortMinusPackage   ListGitReleasesParamsSort = "-package"
+	ListGitReleasesParamsSortMinusTag       ListGitReleasesParamsSort = "-tag"
+	ListGitReleasesParamsSortName           ListGitReleasesParamsSort = "name"
+	ListGitReleasesParamsSortNamespace      ListGitReleasesParamsSort = "namespace"
+	ListGitReleasesParamsSortPackage        ListGitReleasesParamsSort = "package"
+	ListGitReleasesParamsSortTag            ListGitReleasesParamsSort = "tag"
+)
+
+// Defines values for CreateGitReleaseJSONBody1SpecPackageProvider.
 const (
-	CreateGitReleaseJSONBodySpecPackageProviderAws     CreateGitReleaseJSONBodySpecPackageProvider = "aws"
-	CreateGitReleaseJSONBodySpecPackageProviderAzure   CreateGitReleaseJSONBodySpecPackageProvider = "azure"
-	CreateGitReleaseJSONBodySpecPackageProviderGcp     CreateGitReleaseJSONBodySpecPackageProvider = "gcp"
-	CreateGitReleaseJSONBodySpecPackageProviderGeneric CreateGitReleaseJSONBodySpecPackageProvider = "generic"
+	CreateGitReleaseJSONBody1SpecPackageProviderAws     CreateGitReleaseJSONBody1SpecPackageProvider = "aws"
+	CreateGitReleaseJSONBody1SpecPackageProviderAzure   CreateGitReleaseJSONBody1SpecPackageProvider = "azure"
+	CreateGitReleaseJSONBody1SpecPackageProviderGcp     CreateGitReleaseJSONBody1SpecPackageProvider = "gcp"
+	CreateGitReleaseJSONBody1SpecPackageProviderGeneric CreateGitReleaseJSONBody1SpecPackageProvider = "generic"
 )
 
-// Defines values for CreateGitReleaseJSONBodySpecPackageVerifyProvider.
+// Defines values for CreateGitReleaseJSONBody1SpecPackageVerifyProvider.
 const (
-	CreateGitReleaseJSONBodySpecPackageVerifyProviderCosign   CreateGitReleaseJSONBodySpecPackageVerifyProvider = "cosign"
-	CreateGitReleaseJSONBodySpecPackageVerifyProviderNotation CreateGitReleaseJSONBodySpecPackageVerifyProvider = "notation"
+	CreateGitReleaseJSONBody1SpecPackageVerifyProviderCosign   CreateGitReleaseJSONBody1SpecPackageVerifyProvider = "cosign"
+	CreateGitReleaseJSONBody1SpecPackageVerifyProviderNotation CreateGitReleaseJSONBody1SpecPackageVerifyProvider = "notation"
 )
 
 // Defines values for UpdateGitReleaseJSONBodySpecPackageProvider.
@@ -100,18 +493,51 @@ const (
 	UpdateGitReleaseJSONBodySpecPackageVerifyProviderNotation UpdateGitReleaseJSONBodySpecPackageVerifyProvider = "notation"
 )
 
-// Defines values for CreateK8sReleaseJSONBodySpecPackageProvider.
+// Defines values for PatchGitReleaseApplicationJSONPatchPlusJSONBodyOp.
+const (
+	PatchGitReleaseApplicationJSONPatchPlusJSONBodyOpAdd     PatchGitReleaseApplicationJSONPatchPlusJSONBodyOp = "add"
+	PatchGitReleaseApplicationJSONPatchPlusJSONBodyOpCopy    PatchGitReleaseApplicationJSONPatchPlusJSONBodyOp = "copy"
+	PatchGitReleaseApplicationJSONPatchPlusJSONBodyOpMove    PatchGitReleaseApplicationJSONPatchPlusJSONBodyOp = "move"
+	PatchGitReleaseApplicationJSONPatchPlusJSONBodyOpRemove  PatchGitReleaseApplicationJSONPatchPlusJSONBodyOp = "remove"
+	PatchGitReleaseApplicationJSONPatchPlusJSONBodyOpReplace PatchGitReleaseApplicationJSONPatchPlusJSONBodyOp = "replace"
+	PatchGitReleaseApplicationJSONPatchPlusJSONBodyOpTest    PatchGitReleaseApplicationJSONPatchPlusJSONBodyOp = "test"
+)
+
+// Defines values for RunGitSourceActionParamsAction.
 const (
-	CreateK8sReleaseJSONBodySpecPackageProviderAws     CreateK8sReleaseJSONBodySpecPackageProvider = "aws"
-	CreateK8sReleaseJSONBodySpecPackageProviderAzure   CreateK8sReleaseJSONBodySpecPackageProvider = "azure"
-	CreateK8sReleaseJSONBodySpecPackageProviderGcp     CreateK8sReleaseJSONBodySpecPackageProvider = "gcp"
-	CreateK8sReleaseJSONBodySpecPackageProviderGeneric CreateK8sReleaseJSONBodySpecPackageProvider = "generic"
+	RunGitSourceActionParamsActionReconcile RunGitSourceActionParamsAction = "reconcile"
+	RunGitSourceActionParamsActionResume    RunGitSourceActionParamsAction = "resume"
+	RunGitSourceActionParamsActionSuspend   RunGitSourceActionParamsAction = "suspend"
 )
 
-// Defines values for CreateK8sReleaseJSONBodySpecPackageVerifyProvider.
+// Defines values for ListK8sReleasesParamsSort.
 const (
-	CreateK8sReleaseJSONBodySpecPackageVerifyProviderCosign   CreateK8sReleaseJSONBodySpecPackageVerifyProvider = "cosign"
-	CreateK8sReleaseJSONBodySpecPackageVerifyProviderNotation CreateK8sReleaseJSONBodySpecPackageVerifyProvider = "notation"
+	ListK8sReleasesParamsSortCreationTimestamp      ListK8sReleasesParamsSort = "creationTimestamp"
+	ListK8sReleasesParamsSortMinusCreationTimestamp ListK8sReleasesParamsSort = "-creationTimestamp"
+	ListK8sReleasesParamsSortMinusName              ListK8sReleasesParamsSort = "-name"
+	ListK8sReleasesParamsSortMinusNamespace         ListK8sReleasesParamsSort = "-namespace"
+	ListK8sReleasesParamsSortMinusPackage           ListK8sReleasesParamsSort = "-package"
+	ListK8sReleasesParamsSortMinusPhase             ListK8sReleasesParamsSort = "-phase"
+	ListK8sReleasesParamsSortMinusTag               ListK8sReleasesParamsSort = "-tag"
+	ListK8sReleasesParamsSortName                   ListK8sReleasesParamsSort = "name"
+	ListK8sReleasesParamsSortNamespace              ListK8sReleasesParamsSort = "namespace"
+	ListK8sReleasesParamsSortPackage                ListK8sReleasesParamsSort = "package"
+	ListK8sReleasesParamsSortPhase                  ListK8sReleasesParamsSort = "phase"
+	ListK8sReleasesParamsSortTag                    ListK8sReleasesParamsSort = "tag"
+)
+
+// Defines values for CreateK8sReleaseJSONBody1SpecPackageProvider.
+const (
+	CreateK8sReleaseJSONBody1SpecPackageProviderAws     CreateK8sReleaseJSONBody1SpecPackageProvider = "aws"
+	CreateK8sReleaseJSONBody1SpecPackageProviderAzure   CreateK8sReleaseJSONBody1SpecPackageProvider = "azure"
+	CreateK8sReleaseJSONBody1SpecPackageProviderGcp     CreateK8sReleaseJSONBody1SpecPackageProvider = "gcp"
+	CreateK8sReleaseJSONBody1SpecPackageProviderGeneric CreateK8sReleaseJSONBody1SpecPackageProvider = "generic"
+)
+
+// Defines values for CreateK8sReleaseJSONBody1SpecPackageVerifyProvider.
+const (
+	CreateK8sReleaseJSONBody1SpecPackageVerifyProviderCosign   CreateK8sReleaseJSONBody1SpecPackageVerifyProvider = "cosign"
+	CreateK8sReleaseJSONBody1SpecPackageVerifyProviderNotation CreateK8sReleaseJSONBody1SpecPackageVerifyProvider = "notation"
 )
 
 // Defines values for UpdateK8sReleaseJSONBodySpecPackageProvider.
@@ -128,6 +554,47 @@ const (
 	Notation UpdateK8sReleaseJSONBodySpecPackageVerifyProvider = "notation"
 )
 
+// Defines values for PatchK8sReleaseApplicationJSONPatchPlusJSONBodyOp.
+const (
+	PatchK8sReleaseApplicationJSONPatchPlusJSONBodyOpAdd     PatchK8sReleaseApplicationJSONPatchPlusJSONBodyOp = "add"
+	PatchK8sReleaseApplicationJSONPatchPlusJSONBodyOpCopy    PatchK8sReleaseApplicationJSONPatchPlusJSONBodyOp = "copy"
+	PatchK8sReleaseApplicationJSONPatchPlusJSONBodyOpMove    PatchK8sReleaseApplicationJSONPatchPlusJSONBodyOp = "move"
+	PatchK8sReleaseApplicationJSONPatchPlusJSONBodyOpRemove  PatchK8sReleaseApplicationJSONPatchPlusJSONBodyOp = "remove"
+	PatchK8sReleaseApplicationJSONPatchPlusJSONBodyOpReplace PatchK8sReleaseApplicationJSONPatchPlusJSONBodyOp = "replace"
+	PatchK8sReleaseApplicationJSONPatchPlusJSONBodyOpTest    PatchK8sReleaseApplicationJSONPatchPlusJSONBodyOp = "test"
+)
+
+// Defines values for RunK8sReleaseActionParamsAction.
+const (
+	RunK8sReleaseActionParamsActionReconcile RunK8sReleaseActionParamsAction = "reconcile"
+	RunK8sReleaseActionParamsActionResume    RunK8sReleaseActionParamsAction = "resume"
+	RunK8sReleaseActionParamsActionSuspend   RunK8sReleaseActionParamsAction = "suspend"
+)
+
+// Defines values for GetEventsReleaseParamsType.
+const (
+	Normal  GetEventsReleaseParamsType = "Normal"
+	Warning GetEventsReleaseParamsType = "Warning"
+)
+
+// Defines values for GetEventsReleaseParamsSort.
+const (
+	LastTimestamp      GetEventsReleaseParamsSort = "lastTimestamp"
+	MinusLastTimestamp GetEventsReleaseParamsSort = "-lastTimestamp"
+	MinusReason        GetEventsReleaseParamsSort = "-reason"
+	MinusType          GetEventsReleaseParamsSort = "-type"
+	Reason             GetEventsReleaseParamsSort = "reason"
+	Type               GetEventsReleaseParamsSort = "type"
+)
+
+// Defines values for ListProjectsParamsSort.
+const (
+	ListProjectsParamsSortCreationTimestamp      ListProjectsParamsSort = "creationTimestamp"
+	ListProjectsParamsSortMinusCreationTimestamp ListProjectsParamsSort = "-creationTimestamp"
+	ListProjectsParamsSortMinusName              ListProjectsParamsSort = "-name"
+	ListProjectsParamsSortName                   ListProjectsParamsSort = "name"
+)
+
 // Defines values for CreateProjectJSONBodyStatus.
 const (
 	CreateProjectJSONBodyStatusActive      CreateProjectJSONBodyStatus = "Active"
@@ -136,10 +603,138 @@ const (
 
 // Defines values for UpdateProjectJSONBodyStatus.
 const (
-	UpdateProjectJSONBodyStatusActive      UpdateProjectJSONBodyStatus = "Active"
-	UpdateProjectJSONBodyStatusTerminating UpdateProjectJSONBodyStatus = "Terminating"
+	Active      UpdateProjectJSONBodyStatus = "Active"
+	Terminating UpdateProjectJSONBodyStatus = "Terminating"
+)
+
+// Defines values for PatchProjectApplicationJSONPatchPlusJSONBodyOp.
+const (
+	PatchProjectApplicationJSONPatchPlusJSONBodyOpAdd     PatchProjectApplicationJSONPatchPlusJSONBodyOp = "add"
+	PatchProjectApplicationJSONPatchPlusJSONBodyOpCopy    PatchProjectApplicationJSONPatchPlusJSONBodyOp = "copy"
+	PatchProjectApplicationJSONPatchPlusJSONBodyOpMove    PatchProjectApplicationJSONPatchPlusJSONBodyOp = "move"
+	PatchProjectApplicationJSONPatchPlusJSONBodyOpRemove  PatchProjectApplicationJSONPatchPlusJSONBodyOp = "remove"
+	PatchProjectApplicationJSONPatchPlusJSONBodyOpReplace PatchProjectApplicationJSONPatchPlusJSONBodyOp = "replace"
+	PatchProjectApplicationJSONPatchPlusJSONBodyOpTest    PatchProjectApplicationJSONPatchPlusJSONBodyOp = "test"
 )
 
+// ActionResult defines model for ActionResult.
+type ActionResult struct {
+	// Action Action run on the resource
+	Action ActionResultAction `json:"action"`
+
+	// Kind Kind of the resource
+	Kind string `json:"kind"`
+
+	// Message Message of the Ready condition (fluxcd) or phase (KuboCD) of the resource
+	Message *string `json:"message,omitempty"`
+
+	// Name Name of the resource
+	Name string `json:"name"`
+
+	// Namespace Namespace of the resource
+	Namespace string `json:"namespace"`
+
+	// Outcome Outcome of the action:
+	//   - Suspended: the reconciliation of the resource is suspended
+	//   - Requested: the reconciliation is requested (not waited)
+	//   - Ready: the resource is reconciled and ready
+	//   - NotReady: the resource is reconciled but not ready (see message)
+	//   - Timeout: the resource was not reconciled in time
+	Outcome ActionResultOutcome `json:"outcome"`
+
+	// RequestedAt Value of the reconcile.fluxcd.io/requestedAt annotation set to request the reconciliation
+	RequestedAt *string `json:"requestedAt,omitempty"`
+}
+
+// ActionResultAction Action run on the resource
+type ActionResultAction string
+
+// ActionResultOutcome Outcome of the action:
+//   - Suspended: the reconciliation of the resource is suspended
+//   - Requested: the reconciliation is requested (not waited)
+//   - Ready: the resource is reconciled and ready
+//   - NotReady: the resource is reconciled but not ready (see message)
+//   - Timeout: the resource was not reconciled in time
+type ActionResultOutcome string
+
+// AuditEvent defines model for AuditEvent.
+type AuditEvent struct {
+	// Action The kind of mutating operation, or the start and the end of an interactive session (pod exec)
+	Action AuditEventAction `json:"action"`
+
+	// ClusterId Kubernetes cluster ID targeted by the operation
+	ClusterId *string `json:"clusterId,omitempty"`
+
+	// Diff Field level changes between the previous and the requested object
+	Diff *[]struct {
+		// From The previous value
+		From *interface{} `json:"from,omitempty"`
+
+		// Op The kind of change
+		Op AuditEventDiffOp `json:"op"`
+
+		// Path The dot separated path of the changed field
+		Path string `json:"path"`
+
+		// To The new value
+		To *interface{} `json:"to,omitempty"`
+	} `json:"diff,omitempty"`
+
+	// Id Unique identifier of the audit event
+	Id string `json:"id"`
+
+	// Kind The kind of the object targeted by the operation
+	Kind *string `json:"kind,omitempty"`
+
+	// Message The response message returned to the user
+	Message *string `json:"message,omitempty"`
+
+	// Method The HTTP method of the request
+	Method string `json:"method"`
+
+	// Name The name of the object targeted by the operation
+	Name *string `json:"name,omitempty"`
+
+	// Namespace Kubernetes namespace targeted by the operation
+	Namespace *string `json:"namespace,omitempty"`
+
+	// Outcome Whether the operation succeeded
+	Outcome AuditEventOutcome `json:"outcome"`
+
+	// Path The request path
+	Path string `json:"path"`
+
+	// Payload The request payload
+	Payload *map[string]interface{} `json:"payload,omitempty"`
+
+	// RequestId The correlation ID of the request (X-Request-ID header)
+	RequestId *string `json:"requestId,omitempty"`
+
+	// Status The HTTP status code returned to the user
+	Status int `json:"status"`
+
+	// Timestamp Time at which the operation was received
+	Timestamp time.Time `json:"timestamp"`
+
+	// User Identity of the user who performed the operation
+	User struct {
+		Email   string   `json:"email"`
+		Login   *string  `json:"login,omitempty"`
+		Name    *string  `json:"name,omitempty"`
+		Roles   []string `json:"roles"`
+		Subject string   `json:"sub"`
+	} `json:"user"`
+}
+
+// AuditEventAction The kind of mutating operation, or the start and the end of an interactive session (pod exec)
+type AuditEventAction string
+
+// AuditEventDiffOp The kind of change
+type AuditEventDiffOp string
+
+// AuditEventOutcome Whether the operation succeeded
+type AuditEventOutcome string
+
 // Catalog defines model for Catalog.
 type Catalog struct {
 	Credentials *struct {
@@ -198,61 +793,510 @@ type Cluster_Auth struct {
 	union json.RawMessage
 }
 
-// GitCommit defines model for GitCommit.
-type GitCommit struct {
-	// Commit The hash of the commit
-	Commit *string `json:"commit,omitempty"`
+// ClusterInventory defines model for ClusterInventory.
+type ClusterInventory struct {
+	// ClusterId Kubernetes cluster ID
+	ClusterId string `json:"clusterId"`
+
+	// Env Environment of the cluster
+	Env   *string `json:"env,omitempty"`
+	Error *struct {
+		// Code Stable machine-readable error code, for example: BAD_REQUEST, VALIDATION_FAILED, UNAUTHORIZED, FORBIDDEN, NOT_FOUND, CONFLICT,
+		// CLUSTER_NOT_FOUND, NAMESPACE_NOT_FOUND, NAMESPACE_ALREADY_EXISTS, CATALOG_NOT_FOUND, PACKAGE_NOT_FOUND,
+		// RELEASE_NOT_FOUND, RELEASE_ALREADY_EXISTS, RELEASE_CONFLICT, RELEASE_INVALID, REVISION_NOT_FOUND, RESOURCE_SUSPENDED, GIT_REPO_NOT_FOUND, GIT_PUSH_REJECTED,
+		// GIT_AUTH_FAILED, GIT_ERROR, KUBERNETES_ERROR, REGISTRY_UNAUTHORIZED, REGISTRY_DENIED, REGISTRY_NOT_FOUND, REGISTRY_ERROR,
+		// AUDIT_NOT_ENABLED, CONTINUE_EXPIRED, PRECONDITION_FAILED, UNSUPPORTED_MEDIA_TYPE,
+		// PATCH_FAILED, WATCH_EXPIRED, TOO_MANY_REQUESTS, SERVICE_UNAVAILABLE, GATEWAY_TIMEOUT, INVALID_RESPONSE, INTERNAL_ERROR.
+		// Not set on success responses.
+		Code *string `json:"code,omitempty"`
+
+		// Details Field-level details of the error (validation errors)
+		Details *[]struct {
+			// Field Path of the invalid field
+			Field *string `json:"field,omitempty"`
+
+			// Message Human readable description of the error on the field
+			Message string `json:"message"`
+
+			// Reason Machine-readable reason of the error on the field
+			Reason *string `json:"reason,omitempty"`
+		} `json:"details,omitempty"`
+
+		// Message The response message from the server
+		Message string `json:"message"`
+
+		// RequestId The correlation ID of the request (X-Request-ID header)
+		RequestId *string `json:"requestId,omitempty"`
+
+		// Status The HTTP status code
+		Status int `json:"status"`
+
+		// Type The type of the server response
+		Type ClusterInventoryErrorType `json:"type"`
+	} `json:"error,omitempty"`
+
+	// Packages Package versions running on the cluster (versions inventory)
+	Packages *[]struct {
+		// Namespaces Namespaces of the releases running this version
+		Namespaces []string `json:"namespaces"`
+
+		// PackageRepository OCI repository of the KuboCD package
+		PackageRepository string `json:"packageRepository"`
+
+		// PackageTag Version of the KuboCD package
+		PackageTag string `json:"packageTag"`
+
+		// Releases Number of releases running this version
+		Releases int `json:"releases"`
+	} `json:"packages,omitempty"`
+
+	// Projects Projects of the cluster (projects inventory)
+	Projects *[]struct {
+		// ClusterId Kubernetes cluster ID
+		ClusterId string `json:"clusterId"`
+
+		// CpuRequests Sum of the CPU requests of the pods containers
+		CpuRequests       *string    `json:"cpuRequests,omitempty"`
+		CreationTimestamp *time.Time `json:"creationTimestamp,omitempty"`
+		DisplayName       *string    `json:"displayName,omitempty"`
+		Environment       *string    `json:"environment,omitempty"`
 
-	// CommitMessage A commit Message
-	CommitMessage *string `json:"commitMessage,omitempty"`
+		// MemoryRequests Sum of the memory requests of the pods containers
+		MemoryRequests *string `json:"memoryRequests,omitempty"`
 
-	// CommitterEmail Email of the committer
-	CommitterEmail *string `json:"committerEmail,omitempty"`
+		// Name Project (namespace) name
+		Name string `json:"name"`
 
-	// CommitterName Name of the committer
-	CommitterName *string `json:"committerName,omitempty"`
+		// Owner Owner of the project (okdp.io/owner annotation)
+		Owner *string `json:"owner,omitempty"`
 
-	// Force Whether to force commit
-	Force *bool `json:"force,omitempty"`
+		// Pods Number of pods in the project
+		Pods int `json:"pods"`
 
-	// TargetPath Target file path of the commit in the git repository
-	TargetPath *string `json:"targetPath,omitempty"`
-}
+		// Releases Number of KuboCD releases in the project
+		Releases int                             `json:"releases"`
+		Status   *ClusterInventoryProjectsStatus `json:"status,omitempty"`
+	} `json:"projects,omitempty"`
 
-// GitRepository defines model for GitRepository.
-type GitRepository struct {
-	Credentials struct {
-		SecretRef string `json:"secretRef"`
-	} `json:"credentials"`
-	Name      string `json:"name"`
-	Namespace string `json:"namespace"`
-	Path      string `json:"path"`
-	Ref       string `json:"ref"`
-	RepoURL   string `json:"repoUrl"`
-}
+	// Reachable Whether the cluster answered in time
+	Reachable bool `json:"reachable"`
 
-// Namespace defines model for Namespace.
-type Namespace struct {
-	ApiVersion string        `json:"apiVersion"`
-	Kind       NamespaceKind `json:"kind"`
-	Metadata   struct {
-		Annotations       *map[string]string `json:"annotations,omitempty"`
-		CreationTimestamp *time.Time         `json:"creationTimestamp,omitempty"`
-		Labels            *map[string]string `json:"labels,omitempty"`
-		Name              string             `json:"name"`
-	} `json:"metadata"`
-	Status *struct {
-		Phase *NamespaceStatusPhase `json:"phase,omitempty"`
-	} `json:"status,omitempty"`
-}
+	// Releases Releases of the cluster (releases inventory)
+	Releases *[]struct {
+		// ClusterId Kubernetes cluster ID
+		ClusterId         string     `json:"clusterId"`
+		CreationTimestamp *time.Time `json:"creationTimestamp,omitempty"`
 
-// NamespaceKind defines model for Namespace.Kind.
-type NamespaceKind string
+		// Description Description of the release (or of the package if not set)
+		Description *string `json:"description,omitempty"`
 
-// NamespaceStatusPhase defines model for Namespace.Status.Phase.
-type NamespaceStatusPhase string
+		// Name Name of the release
+		Name string `json:"name"`
 
-// Package defines model for Package.
+		// Namespace Namespace of the release
+		Namespace string `json:"namespace"`
+
+		// PackageRepository OCI repository of the KuboCD package
+		PackageRepository string `json:"packageRepository"`
+
+		// PackageTag Version of the KuboCD package
+		PackageTag string `json:"packageTag"`
+
+		// Phase Phase of the release (READY, ERROR, WAIT_OCI, WAIT_REPO, WAIT_HREL, WAIT_DEPS, SUSPENDED)
+		Phase     *string `json:"phase,omitempty"`
+		Protected *bool   `json:"protected,omitempty"`
+
+		// ReadyReleases Number of ready helm releases (X/Y)
+		ReadyReleases *string `json:"readyReleases,omitempty"`
+		Suspended     *bool   `json:"suspended,omitempty"`
+
+		// TargetNamespace Namespace where the release workloads are deployed
+		TargetNamespace *string `json:"targetNamespace,omitempty"`
+	} `json:"releases,omitempty"`
+}
+
+// ClusterInventoryErrorType The type of the server response
+type ClusterInventoryErrorType string
+
+// ClusterInventoryProjectsStatus defines model for ClusterInventory.Projects.Status.
+type ClusterInventoryProjectsStatus string
+
+// Drift defines model for Drift.
+type Drift struct {
+	// GitRevision Commit the branch or the tag of the git repo points to (HEAD)
+	GitRevision string `json:"gitRevision"`
+
+	// InSync Whether the last applied revision is the git HEAD and all the releases are in sync
+	InSync bool `json:"inSync"`
+
+	// Kustomization Fluxcd kustomization name
+	Kustomization string `json:"kustomization"`
+
+	// LastAppliedRevision Last revision applied by the fluxcd kustomization
+	LastAppliedRevision string `json:"lastAppliedRevision"`
+
+	// Namespace Fluxcd kustomization namespace
+	Namespace string `json:"namespace"`
+
+	// Releases Drift of the releases, sorted by namespace and name
+	Releases []struct {
+		// Differences Field level differences, from the spec in git to the spec in the cluster (Modified releases only)
+		Differences *[]struct {
+			// From The value in git
+			From *interface{} `json:"from,omitempty"`
+
+			// Op The change made in the cluster compared to git
+			Op DriftReleasesDifferencesOp `json:"op"`
+
+			// Path The dot separated path of the field
+			Path string `json:"path"`
+
+			// To The value in the cluster
+			To *interface{} `json:"to,omitempty"`
+		} `json:"differences,omitempty"`
+
+		// Name KuboCD release name
+		Name string `json:"name"`
+
+		// Namespace Namespace the release is (or should be) deployed to
+		Namespace string `json:"namespace"`
+
+		// Status Drift of the release:
+		//   - InSync: the spec in the cluster is the one in git
+		//   - Modified: the spec in the cluster differs from the one in git
+		//   - MissingInCluster: the release is in git but not deployed on the cluster
+		//   - MissingInGit: the release is deployed by the kustomization but no longer in git
+		Status DriftReleasesStatus `json:"status"`
+	} `json:"releases"`
+
+	// RevisionInSync Whether the last applied revision is the git HEAD
+	RevisionInSync bool `json:"revisionInSync"`
+}
+
+// DriftReleasesDifferencesOp The change made in the cluster compared to git
+type DriftReleasesDifferencesOp string
+
+// DriftReleasesStatus Drift of the release:
+//   - InSync: the spec in the cluster is the one in git
+//   - Modified: the spec in the cluster differs from the one in git
+//   - MissingInCluster: the release is in git but not deployed on the cluster
+//   - MissingInGit: the release is deployed by the kustomization but no longer in git
+type DriftReleasesStatus string
+
+// ExecMessage Message exchanged as a WebSocket text frame of the pod exec sessions.
+// The client sends the 'stdin' and 'resize' messages, the server sends the 'stdout' and 'stderr' messages,
+// then an 'exit' or an 'error' message before closing the session.
+type ExecMessage struct {
+	// Code The exit code of the command (exit)
+	Code *int `json:"code,omitempty"`
+
+	// Cols The number of columns of the terminal (resize)
+	Cols *int `json:"cols,omitempty"`
+
+	// Data The data written to the stdin or read from the stdout/stderr of the command
+	Data *string `json:"data,omitempty"`
+
+	// Message The reason which ended the session (error)
+	Message *string `json:"message,omitempty"`
+
+	// Rows The number of rows of the terminal (resize)
+	Rows *int `json:"rows,omitempty"`
+
+	// Type The type of the message
+	Type ExecMessageType `json:"type"`
+}
+
+// ExecMessageType The type of the message
+type ExecMessageType string
+
+// GitCommit defines model for GitCommit.
+type GitCommit struct {
+	// Commit The hash of the commit
+	Commit *string `json:"commit,omitempty"`
+
+	// CommitMessage A commit Message
+	CommitMessage *string `json:"commitMessage,omitempty"`
+
+	// CommitterEmail Email of the committer
+	CommitterEmail *string `json:"committerEmail,omitempty"`
+
+	// CommitterName Name of the committer
+	CommitterName *string `json:"committerName,omitempty"`
+
+	// Force Whether to force commit
+	Force *bool `json:"force,omitempty"`
+
+	// TargetPath Target file path of the commit in the git repository
+	TargetPath *string `json:"targetPath,omitempty"`
+}
+
+// GitRepository defines model for GitRepository.
+type GitRepository struct {
+	Credentials struct {
+		SecretRef string `json:"secretRef"`
+	} `json:"credentials"`
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Path      string `json:"path"`
+	Ref       string `json:"ref"`
+	RepoURL   string `json:"repoUrl"`
+}
+
+// GitSource defines model for GitSource.
+type GitSource struct {
+	// ClusterId Kubernetes cluster ID
+	ClusterId string `json:"clusterId"`
+
+	// Kustomizations Flux Kustomizations (namespace/name) referencing the source
+	Kustomizations *[]string `json:"kustomizations,omitempty"`
+
+	// LastSyncTime Time of the last synchronization (Ready condition transition or artifact update)
+	LastSyncTime *time.Time `json:"lastSyncTime,omitempty"`
+
+	// Message Message of the Ready condition
+	Message *string `json:"message,omitempty"`
+
+	// Name Name of the Flux GitRepository
+	Name string `json:"name"`
+
+	// Namespace Namespace of the Flux GitRepository
+	Namespace string `json:"namespace"`
+
+	// Ready Whether the last synchronization succeeded
+	Ready bool `json:"ready"`
+
+	// Reason Reason of the Ready condition
+	Reason *string `json:"reason,omitempty"`
+
+	// Ref Branch or tag tracked by Flux
+	Ref *string `json:"ref,omitempty"`
+
+	// Revision Revision of the last fetched artifact
+	Revision *string `json:"revision,omitempty"`
+
+	// Suspended Whether the reconciliation of the source is suspended
+	Suspended *bool `json:"suspended,omitempty"`
+
+	// Url URL of the git repository
+	Url string `json:"url"`
+}
+
+// Inventory defines model for Inventory.
+type Inventory struct {
+	// Clusters Inventory of each cluster, sorted by environment and cluster ID
+	Clusters []struct {
+		// ClusterId Kubernetes cluster ID
+		ClusterId string `json:"clusterId"`
+
+		// Env Environment of the cluster
+		Env   *string `json:"env,omitempty"`
+		Error *struct {
+			// Code Stable machine-readable error code, for example: BAD_REQUEST, VALIDATION_FAILED, UNAUTHORIZED, FORBIDDEN, NOT_FOUND, CONFLICT,
+			// CLUSTER_NOT_FOUND, NAMESPACE_NOT_FOUND, NAMESPACE_ALREADY_EXISTS, CATALOG_NOT_FOUND, PACKAGE_NOT_FOUND,
+			// RELEASE_NOT_FOUND, RELEASE_ALREADY_EXISTS, RELEASE_CONFLICT, RELEASE_INVALID, REVISION_NOT_FOUND, RESOURCE_SUSPENDED, GIT_REPO_NOT_FOUND, GIT_PUSH_REJECTED,
+			// GIT_AUTH_FAILED, GIT_ERROR, KUBERNETES_ERROR, REGISTRY_UNAUTHORIZED, REGISTRY_DENIED, REGISTRY_NOT_FOUND, REGISTRY_ERROR,
+			// AUDIT_NOT_ENABLED, CONTINUE_EXPIRED, PRECONDITION_FAILED, UNSUPPORTED_MEDIA_TYPE,
+			// PATCH_FAILED, WATCH_EXPIRED, TOO_MANY_REQUESTS, SERVICE_UNAVAILABLE, GATEWAY_TIMEOUT, INVALID_RESPONSE, INTERNAL_ERROR.
+			// Not set on success responses.
+			Code *string `json:"code,omitempty"`
+
+			// Details Field-level details of the error (validation errors)
+			Details *[]struct {
+				// Field Path of the invalid field
+				Field *string `json:"field,omitempty"`
+
+				// Message Human readable description of the error on the field
+				Message string `json:"message"`
+
+				// Reason Machine-readable reason of the error on the field
+				Reason *string `json:"reason,omitempty"`
+			} `json:"details,omitempty"`
+
+			// Message The response message from the server
+			Message string `json:"message"`
+
+			// RequestId The correlation ID of the request (X-Request-ID header)
+			RequestId *string `json:"requestId,omitempty"`
+
+			// Status The HTTP status code
+			Status int `json:"status"`
+
+			// Type The type of the server response
+			Type InventoryClustersErrorType `json:"type"`
+		} `json:"error,omitempty"`
+
+		// Packages Package versions running on the cluster (versions inventory)
+		Packages *[]struct {
+			// Namespaces Namespaces of the releases running this version
+			Namespaces []string `json:"namespaces"`
+
+			// PackageRepository OCI repository of the KuboCD package
+			PackageRepository string `json:"packageRepository"`
+
+			// PackageTag Version of the KuboCD package
+			PackageTag string `json:"packageTag"`
+
+			// Releases Number of releases running this version
+			Releases int `json:"releases"`
+		} `json:"packages,omitempty"`
+
+		// Projects Projects of the cluster (projects inventory)
+		Projects *[]struct {
+			// ClusterId Kubernetes cluster ID
+			ClusterId string `json:"clusterId"`
+
+			// CpuRequests Sum of the CPU requests of the pods containers
+			CpuRequests       *string    `json:"cpuRequests,omitempty"`
+			CreationTimestamp *time.Time `json:"creationTimestamp,omitempty"`
+			DisplayName       *string    `json:"displayName,omitempty"`
+			Environment       *string    `json:"environment,omitempty"`
+
+			// MemoryRequests Sum of the memory requests of the pods containers
+			MemoryRequests *string `json:"memoryRequests,omitempty"`
+
+			// Name Project (namespace) name
+			Name string `json:"name"`
+
+			// Owner Owner of the project (okdp.io/owner annotation)
+			Owner *string `json:"owner,omitempty"`
+
+			// Pods Number of pods in the project
+			Pods int `json:"pods"`
+
+			// Releases Number of KuboCD releases in the project
+			Releases int                              `json:"releases"`
+			Status   *InventoryClustersProjectsStatus `json:"status,omitempty"`
+		} `json:"projects,omitempty"`
+
+		// Reachable Whether the cluster answered in time
+		Reachable bool `json:"reachable"`
+
+		// Releases Releases of the cluster (releases inventory)
+		Releases *[]struct {
+			// ClusterId Kubernetes cluster ID
+			ClusterId         string     `json:"clusterId"`
+			CreationTimestamp *time.Time `json:"creationTimestamp,omitempty"`
+
+			// Description Description of the release (or of the package if not set)
+			Description *string `json:"description,omitempty"`
+
+			// Name Name of the release
+			Name string `json:"name"`
+
+			// Namespace Namespace of the release
+			Namespace string `json:"namespace"`
+
+			// PackageRepository OCI repository of the KuboCD package
+			PackageRepository string `json:"packageRepository"`
+
+			// PackageTag Version of the KuboCD package
+			PackageTag string `json:"packageTag"`
+
+			// Phase Phase of the release (READY, ERROR, WAIT_OCI, WAIT_REPO, WAIT_HREL, WAIT_DEPS, SUSPENDED)
+			Phase     *string `json:"phase,omitempty"`
+			Protected *bool   `json:"protected,omitempty"`
+
+			// ReadyReleases Number of ready helm releases (X/Y)
+			ReadyReleases *string `json:"readyReleases,omitempty"`
+			Suspended     *bool   `json:"suspended,omitempty"`
+
+			// TargetNamespace Namespace where the release workloads are deployed
+			TargetNamespace *string `json:"targetNamespace,omitempty"`
+		} `json:"releases,omitempty"`
+	} `json:"clusters"`
+
+	// Failures Number of clusters that failed or did not answer in time (partial result)
+	Failures int `json:"failures"`
+}
+
+// InventoryClustersErrorType The type of the server response
+type InventoryClustersErrorType string
+
+// InventoryClustersProjectsStatus defines model for Inventory.Clusters.Projects.Status.
+type InventoryClustersProjectsStatus string
+
+// Namespace defines model for Namespace.
+type Namespace struct {
+	ApiVersion string        `json:"apiVersion"`
+	Kind       NamespaceKind `json:"kind"`
+	Metadata   struct {
+		Annotations       *map[string]string `json:"annotations,omitempty"`
+		CreationTimestamp *time.Time         `json:"creationTimestamp,omitempty"`
+		Labels            *map[string]string `json:"labels,omitempty"`
+		Name              string             `json:"name"`
+
+		// ResourceVersion Version of the namespace, returned in the ETag header of the project
+		ResourceVersion *string `json:"resourceVersion,omitempty"`
+	} `json:"metadata"`
+	Status *struct {
+		Phase *NamespaceStatusPhase `json:"phase,omitempty"`
+	} `json:"status,omitempty"`
+}
+
+// NamespaceKind defines model for Namespace.Kind.
+type NamespaceKind string
+
+// NamespaceStatusPhase defines model for Namespace.Status.Phase.
+type NamespaceStatusPhase string
+
+// OutdatedRelease defines model for OutdatedRelease.
+type OutdatedRelease struct {
+	// CatalogId ID of the catalog of the package
+	CatalogId string `json:"catalogId"`
+
+	// ClusterId Kubernetes cluster ID
+	ClusterId string `json:"clusterId"`
+
+	// CurrentVersion Package version deployed by the release (spec.package.tag)
+	CurrentVersion string `json:"currentVersion"`
+
+	// LatestMajor Latest version, if newer
+	LatestMajor *string `json:"latestMajor,omitempty"`
+
+	// LatestMinor Latest version with the same major version, if newer
+	LatestMinor *string `json:"latestMinor,omitempty"`
+
+	// LatestPatch Latest version with the same major and minor versions, if newer
+	LatestPatch *string `json:"latestPatch,omitempty"`
+
+	// Name KuboCD release name
+	Name string `json:"name"`
+
+	// Namespace Namespace (project) of the release
+	Namespace string `json:"namespace"`
+
+	// Outdated Whether a newer version of the package exists
+	Outdated bool `json:"outdated"`
+
+	// Package OCI repository of the package (spec.package.repository)
+	Package string `json:"package"`
+
+	// PackageName Name of the package in the catalog
+	PackageName string `json:"packageName"`
+
+	// SchemaChanges Changes of the package schema from the deployed version to the latest one
+	SchemaChanges *[]struct {
+		// From The value in the deployed version
+		From *interface{} `json:"from,omitempty"`
+
+		// Op The change made by the latest version
+		Op OutdatedReleaseSchemaChangesOp `json:"op"`
+
+		// Path The dot separated path of the field
+		Path string `json:"path"`
+
+		// To The value in the latest version
+		To *interface{} `json:"to,omitempty"`
+	} `json:"schemaChanges,omitempty"`
+
+	// TagMissing Whether the deployed version no longer exists in the registry
+	TagMissing bool `json:"tagMissing"`
+}
+
+// OutdatedReleaseSchemaChangesOp The change made by the latest version
+type OutdatedReleaseSchemaChangesOp string
+
+// Package defines model for Package.
 type Package struct {
 	// Name The name of the package
 	Name string `json:"name"`
@@ -264,6 +1308,48 @@ type Package struct {
 	Versions []string `json:"versions"`
 }
 
+// PackageJSONSchema defines model for PackageJSONSchema.
+type PackageJSONSchema struct {
+	// Context JSON Schema (draft 2020-12) of the release context
+	Context map[string]interface{} `json:"context"`
+
+	// Parameters JSON Schema (draft 2020-12) of the release parameters
+	Parameters map[string]interface{} `json:"parameters"`
+}
+
+// PackageVersion defines model for PackageVersion.
+type PackageVersion struct {
+	// Namespaces Namespaces of the releases running this version
+	Namespaces []string `json:"namespaces"`
+
+	// PackageRepository OCI repository of the KuboCD package
+	PackageRepository string `json:"packageRepository"`
+
+	// PackageTag Version of the KuboCD package
+	PackageTag string `json:"packageTag"`
+
+	// Releases Number of releases running this version
+	Releases int `json:"releases"`
+}
+
+// PatchOperation JSON Patch (RFC 6902) operation
+type PatchOperation struct {
+	// From JSON Pointer to the source location of the move and copy operations
+	From *string `json:"from,omitempty"`
+
+	// Op The operation to perform
+	Op PatchOperationOp `json:"op"`
+
+	// Path JSON Pointer (RFC 6901) to the target location
+	Path string `json:"path"`
+
+	// Value The value of the add, replace and test operations
+	Value *interface{} `json:"value,omitempty"`
+}
+
+// PatchOperationOp The operation to perform
+type PatchOperationOp string
+
 // PodInfo defines model for PodInfo.
 type PodInfo struct {
 	// Containers List of containers in the Pod
@@ -298,6 +1384,15 @@ type PodInfo struct {
 
 	// State Pod state
 	State string `json:"state"`
+
+	// Workload The controlling workload of a pod (Deployment, StatefulSet, DaemonSet, Job, CronJob, ...)
+	Workload *struct {
+		// Kind Kind of the workload
+		Kind string `json:"kind"`
+
+		// Name Name of the workload
+		Name string `json:"name"`
+	} `json:"workload,omitempty"`
 }
 
 // Project defines model for Project.
@@ -313,6 +1408,124 @@ type Project struct {
 // ProjectStatus defines model for Project.Status.
 type ProjectStatus string
 
+// ProjectHealth defines model for ProjectHealth.
+type ProjectHealth struct {
+	// Project Project name
+	Project string `json:"project"`
+
+	// Releases Health of the project releases, sorted by name
+	Releases []struct {
+		// LastReconciled Last time a helm release of the release was successfully reconciled
+		LastReconciled *time.Time `json:"lastReconciled,omitempty"`
+
+		// Name KuboCD release name
+		Name string `json:"name"`
+
+		// Namespace Namespace of the release
+		Namespace string `json:"namespace"`
+
+		// Phase KuboCD release phase
+		Phase string `json:"phase"`
+
+		// Pods Number of pods of the release
+		Pods int `json:"pods"`
+
+		// ReadyPods Number of ready (or completed) pods of the release
+		ReadyPods int `json:"readyPods"`
+
+		// Reasons Reasons of the status, empty when the release is healthy
+		Reasons []string `json:"reasons"`
+
+		// Restarts Total restarts of the containers of the release pods
+		Restarts int32 `json:"restarts"`
+
+		// SinceLastReconciled Time elapsed since the last successful reconcile
+		SinceLastReconciled *string `json:"sinceLastReconciled,omitempty"`
+
+		// Status Aggregated status of the release:
+		//   - Suspended: the release is suspended
+		//   - Failed: the release is in ERROR phase
+		//   - Progressing: the release is not reconciled yet, or some pods are starting
+		//   - Degraded: the release is ready but some helm releases are not ready, or some pods are failing or restarting
+		//   - Healthy: the release and all its pods are ready
+		Status ProjectHealthReleasesStatus `json:"status"`
+
+		// UnreadyPods Pods of the release which are not ready
+		UnreadyPods []struct {
+			// Health Pod health
+			Health string `json:"health"`
+
+			// Name Pod name
+			Name string `json:"name"`
+
+			// Reason First waiting or terminated container reason
+			Reason *string `json:"reason,omitempty"`
+
+			// Restarts Restarts of the containers of the pod
+			Restarts int32 `json:"restarts"`
+		} `json:"unreadyPods"`
+
+		// WarningEvents Most recent warning events involving the release, its helm releases or its pods
+		WarningEvents []struct {
+			Count int32 `json:"count"`
+
+			// Kind Kind of the involved object
+			Kind     string    `json:"kind"`
+			LastSeen time.Time `json:"lastSeen"`
+			Message  string    `json:"message"`
+
+			// Name Name of the involved object
+			Name   string `json:"name"`
+			Reason string `json:"reason"`
+		} `json:"warningEvents"`
+	} `json:"releases"`
+
+	// Status The worst status of the project releases, Healthy when the project has no release
+	Status ProjectHealthStatus `json:"status"`
+}
+
+// ProjectHealthReleasesStatus Aggregated status of the release:
+//   - Suspended: the release is suspended
+//   - Failed: the release is in ERROR phase
+//   - Progressing: the release is not reconciled yet, or some pods are starting
+//   - Degraded: the release is ready but some helm releases are not ready, or some pods are failing or restarting
+//   - Healthy: the release and all its pods are ready
+type ProjectHealthReleasesStatus string
+
+// ProjectHealthStatus The worst status of the project releases, Healthy when the project has no release
+type ProjectHealthStatus string
+
+// ProjectStats defines model for ProjectStats.
+type ProjectStats struct {
+	// ClusterId Kubernetes cluster ID
+	ClusterId string `json:"clusterId"`
+
+	// CpuRequests Sum of the CPU requests of the pods containers
+	CpuRequests       *string    `json:"cpuRequests,omitempty"`
+	CreationTimestamp *time.Time `json:"creationTimestamp,omitempty"`
+	DisplayName       *string    `json:"displayName,omitempty"`
+	Environment       *string    `json:"environment,omitempty"`
+
+	// MemoryRequests Sum of the memory requests of the pods containers
+	MemoryRequests *string `json:"memoryRequests,omitempty"`
+
+	// Name Project (namespace) name
+	Name string `json:"name"`
+
+	// Owner Owner of the project (okdp.io/owner annotation)
+	Owner *string `json:"owner,omitempty"`
+
+	// Pods Number of pods in the project
+	Pods int `json:"pods"`
+
+	// Releases Number of KuboCD releases in the project
+	Releases int                 `json:"releases"`
+	Status   *ProjectStatsStatus `json:"status,omitempty"`
+}
+
+// ProjectStatsStatus defines model for ProjectStats.Status.
+type ProjectStatsStatus string
+
 // Release Release is the Schema for the releases API.
 type Release struct {
 	// ApiVersion APIVersion defines the versioned schema of this representation of an object.
@@ -594,113 +1807,1660 @@ type Release struct {
 		// as printcolumn
 		ReadyReleases *string `json:"readyReleases,omitempty"`
 
-		// Roles The result of the package template and release value
-		Roles *[]string `json:"roles,omitempty"`
+		// Roles The result of the package template and release value
+		Roles *[]string `json:"roles,omitempty"`
+
+		// Usage Usage is the rendering of the Package.spec.usage[key]. Aimed to provide user information.
+		// Key could 'html', 'text', some language id, etc...
+		Usage *map[string]string `json:"usage,omitempty"`
+	} `json:"status,omitempty"`
+}
+
+// ReleaseSpecPackageProvider The source will be handled by a child fluxCD OciRepository resource, which will be created by this operator
+// All following fields will be replicated in this object
+// The provider used for authentication, can be 'aws', 'azure', 'gcp' or 'generic'.
+// When not specified, defaults to 'generic'.
+// -kubebuilder:default:=generic
+type ReleaseSpecPackageProvider string
+
+// ReleaseSpecPackageVerifyProvider Provider specifies the technology used to sign the OCI Artifact.
+type ReleaseSpecPackageVerifyProvider string
+
+// ReleaseDrift defines model for ReleaseDrift.
+type ReleaseDrift struct {
+	// Differences Field level differences, from the spec in git to the spec in the cluster (Modified releases only)
+	Differences *[]struct {
+		// From The value in git
+		From *interface{} `json:"from,omitempty"`
+
+		// Op The change made in the cluster compared to git
+		Op ReleaseDriftDifferencesOp `json:"op"`
+
+		// Path The dot separated path of the field
+		Path string `json:"path"`
+
+		// To The value in the cluster
+		To *interface{} `json:"to,omitempty"`
+	} `json:"differences,omitempty"`
+
+	// Name KuboCD release name
+	Name string `json:"name"`
+
+	// Namespace Namespace the release is (or should be) deployed to
+	Namespace string `json:"namespace"`
+
+	// Status Drift of the release:
+	//   - InSync: the spec in the cluster is the one in git
+	//   - Modified: the spec in the cluster differs from the one in git
+	//   - MissingInCluster: the release is in git but not deployed on the cluster
+	//   - MissingInGit: the release is deployed by the kustomization but no longer in git
+	Status ReleaseDriftStatus `json:"status"`
+}
+
+// ReleaseDriftDifferencesOp The change made in the cluster compared to git
+type ReleaseDriftDifferencesOp string
+
+// ReleaseDriftStatus Drift of the release:
+//   - InSync: the spec in the cluster is the one in git
+//   - Modified: the spec in the cluster differs from the one in git
+//   - MissingInCluster: the release is in git but not deployed on the cluster
+//   - MissingInGit: the release is deployed by the kustomization but no longer in git
+type ReleaseDriftStatus string
+
+// ReleaseHealth defines model for ReleaseHealth.
+type ReleaseHealth struct {
+	// LastReconciled Last time a helm release of the release was successfully reconciled
+	LastReconciled *time.Time `json:"lastReconciled,omitempty"`
+
+	// Name KuboCD release name
+	Name string `json:"name"`
+
+	// Namespace Namespace of the release
+	Namespace string `json:"namespace"`
+
+	// Phase KuboCD release phase
+	Phase string `json:"phase"`
+
+	// Pods Number of pods of the release
+	Pods int `json:"pods"`
+
+	// ReadyPods Number of ready (or completed) pods of the release
+	ReadyPods int `json:"readyPods"`
+
+	// Reasons Reasons of the status, empty when the release is healthy
+	Reasons []string `json:"reasons"`
+
+	// Restarts Total restarts of the containers of the release pods
+	Restarts int32 `json:"restarts"`
+
+	// SinceLastReconciled Time elapsed since the last successful reconcile
+	SinceLastReconciled *string `json:"sinceLastReconciled,omitempty"`
+
+	// Status Aggregated status of the release:
+	//   - Suspended: the release is suspended
+	//   - Failed: the release is in ERROR phase
+	//   - Progressing: the release is not reconciled yet, or some pods are starting
+	//   - Degraded: the release is ready but some helm releases are not ready, or some pods are failing or restarting
+	//   - Healthy: the release and all its pods are ready
+	Status ReleaseHealthStatus `json:"status"`
+
+	// UnreadyPods Pods of the release which are not ready
+	UnreadyPods []struct {
+		// Health Pod health
+		Health string `json:"health"`
+
+		// Name Pod name
+		Name string `json:"name"`
+
+		// Reason First waiting or terminated container reason
+		Reason *string `json:"reason,omitempty"`
+
+		// Restarts Restarts of the containers of the pod
+		Restarts int32 `json:"restarts"`
+	} `json:"unreadyPods"`
+
+	// WarningEvents Most recent warning events involving the release, its helm releases or its pods
+	WarningEvents []struct {
+		Count int32 `json:"count"`
+
+		// Kind Kind of the involved object
+		Kind     string    `json:"kind"`
+		LastSeen time.Time `json:"lastSeen"`
+		Message  string    `json:"message"`
+
+		// Name Name of the involved object
+		Name   string `json:"name"`
+		Reason string `json:"reason"`
+	} `json:"warningEvents"`
+}
+
+// ReleaseHealthStatus Aggregated status of the release:
+//   - Suspended: the release is suspended
+//   - Failed: the release is in ERROR phase
+//   - Progressing: the release is not reconciled yet, or some pods are starting
+//   - Degraded: the release is ready but some helm releases are not ready, or some pods are failing or restarting
+//   - Healthy: the release and all its pods are ready
+type ReleaseHealthStatus string
+
+// ReleaseInfo defines model for ReleaseInfo.
+type ReleaseInfo struct {
+	Description *string `json:"description,omitempty"`
+	Git         struct {
+		Path string `json:"path"`
+		URL  string `json:"url"`
+	} `json:"git"`
+	Name      string  `json:"name"`
+	Namespace *string `json:"namespace,omitempty"`
+	Package   struct {
+		Repository string `json:"repository"`
+		Tag        string `json:"tag"`
+	} `json:"package"`
+}
+
+// ReleaseRevision defines model for ReleaseRevision.
+type ReleaseRevision struct {
+	// Author Name of the user who made the change
+	Author *string `json:"author,omitempty"`
+
+	// Commit Hash of the git commit of the revision (git releases only)
+	Commit *string `json:"commit,omitempty"`
+
+	// Email Email of the user who made the change
+	Email *string `json:"email,omitempty"`
+
+	// Message Description of the change (commit message for the git releases)
+	Message *string `json:"message,omitempty"`
+
+	// Release Release is the Schema for the releases API.
+	Release *struct {
+		// ApiVersion APIVersion defines the versioned schema of this representation of an object.
+		// Servers should convert recognized schemas to the latest internal value, and
+		// may reject unrecognized values.
+		// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+		ApiVersion string `json:"apiVersion"`
+
+		// Kind Kind is a string value representing the REST resource this object represents.
+		// Servers may infer this from the endpoint the client submits requests to.
+		// Cannot be updated.
+		// In CamelCase.
+		// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+		Kind string `json:"kind"`
+
+		// Metadata Standard object metadata.
+		Metadata struct {
+			// Annotations Arbitrary metadata.
+			Annotations *map[string]string `json:"annotations,omitempty"`
+
+			// CreationTimestamp Creation timestamp.
+			CreationTimestamp *time.Time `json:"creationTimestamp,omitempty"`
+
+			// DeletionGracePeriodSeconds Seconds allowed for graceful termination.
+			DeletionGracePeriodSeconds *int64 `json:"deletionGracePeriodSeconds,omitempty"`
+
+			// DeletionTimestamp Deletion timestamp.
+			DeletionTimestamp *time.Time `json:"deletionTimestamp,omitempty"`
+
+			// Finalizers List of finalizers.
+			Finalizers *[]string `json:"finalizers,omitempty"`
+
+			// GenerateName Prefix for generating a unique name.
+			GenerateName *string `json:"generateName,omitempty"`
+
+			// Generation Sequence number representing generation of desired state.
+			Generation *int64 `json:"generation,omitempty"`
+
+			// Labels Key-value pairs to categorize resources.
+			Labels *map[string]string `json:"labels,omitempty"`
+
+			// ManagedFields Managed fields tracking info (complex object).
+			ManagedFields *map[string]interface{} `json:"managedFields,omitempty"`
+
+			// Name Name of the resource.
+			Name *string `json:"name,omitempty"`
+
+			// Namespace Namespace of the resource.
+			Namespace *string `json:"namespace,omitempty"`
+
+			// OwnerReferences References to owning resources.
+			OwnerReferences *[]struct {
+				ApiVersion *string `json:"apiVersion,omitempty"`
+				Kind       *string `json:"kind,omitempty"`
+				Name       *string `json:"name,omitempty"`
+				Uid        *string `json:"uid,omitempty"`
+			} `json:"ownerReferences,omitempty"`
+
+			// ResourceVersion Resource version for concurrency control.
+			ResourceVersion *string `json:"resourceVersion,omitempty"`
+
+			// SelfLink Deprecated self-link URL.
+			SelfLink *string `json:"selfLink,omitempty"`
+
+			// Uid Unique ID assigned by Kubernetes.
+			Uid *string `json:"uid,omitempty"`
+		} `json:"metadata"`
+
+		// Spec ReleaseSpec defines the desired state of Release.
+		Spec struct {
+			// Contexts To provide contextual variables
+			// Refer to Context resource description for some explanation
+			// Contexts are merged in the following order:
+			// - The global default one (defined in Config)
+			// - The namespace context (A context with a specific name, defined in config, present in the release namespace)
+			// - This ordered list
+			// Default: []
+			Contexts *[]struct {
+				Name      string  `json:"name"`
+				Namespace *string `json:"namespace,omitempty"`
+			} `json:"contexts,omitempty"`
+
+			// CreateNamespace If true, add  { install: { createNamespace: true } } to config map.
+			// Must be set, as used in module.Render()
+			// Default: false
+			CreateNamespace *bool `json:"createNamespace,omitempty"`
+
+			// Debug Group a set of parameters useful for debugging Release and Package
+			Debug *struct {
+				// DumpContext DumpContext instruct to save a representation of the context
+				// in the Status. This for user debugging?
+				DumpContext *bool `json:"dumpContext,omitempty"`
+
+				// DumpParameters DumpParameters instruct to save a representation of the parameters
+				// in the Status. This for user debugging?
+				DumpParameters *bool `json:"dumpParameters,omitempty"`
+			} `json:"debug,omitempty"`
+
+			// Dependencies The roles we depend on. (appended to the one of the underlying package)
+			// Default: []
+			Dependencies *[]string `json:"dependencies,omitempty"`
+
+			// Description Short description of this release. Single line only
+			Description *string `json:"description,omitempty"`
+
+			// Package The package to deploy
+			Package struct {
+				// CertSecretRef CertSecretRef can be given the name of a Secret containing
+				// either or both of
+				//
+				// - a PEM-encoded client certificate (`tls.crt`) and private
+				// key (`tls.key`);
+				// - a PEM-encoded CA certificate (`ca.crt`)
+				//
+				// and whichever are supplied, will be used for connecting to the
+				// registry. The client cert and key are useful if you are
+				// authenticating with a certificate; the CA cert is useful if
+				// you are using a self-signed server certificate. The Secret must
+				// be of type `Opaque` or `kubernetes.io/tls`.
+				//
+				// Note: Support for the `caFile`, `certFile` and `keyFile` keys have
+				// been deprecated.
+				CertSecretRef *struct {
+					// Name Name of the referent.
+					Name string `json:"name"`
+				} `json:"certSecretRef,omitempty"`
+
+				// Ignore Ignore overrides the set of excluded patterns in the .sourceignore format
+				// (which is the same as .gitignore). If not provided, a default will be used,
+				// consult the documentation for your version to find out what those are.
+				Ignore *string `json:"ignore,omitempty"`
+
+				// Insecure Insecure allows connecting to a non-TLS HTTP container registry.
+				Insecure *bool `json:"insecure,omitempty"`
+
+				// Interval Interval at which the OCIRepository URL is checked for updates.
+				// This interval is approximate and may be subject to jitter to ensure
+				// efficient use of resources.
+				Interval string `json:"interval"`
+
+				// Provider The source will be handled by a child fluxCD OciRepository resource, which will be created by this operator
+				// All following fields will be replicated in this object
+				// The provider used for authentication, can be 'aws', 'azure', 'gcp' or 'generic'.
+				// When not specified, defaults to 'generic'.
+				// -kubebuilder:default:=generic
+				Provider *ReleaseRevisionReleaseSpecPackageProvider `json:"provider,omitempty"`
+
+				// ProxySecretRef ProxySecretRef specifies the Secret containing the proxy configuration
+				// to use while communicating with the container registry.
+				ProxySecretRef *struct {
+					// Name Name of the referent.
+					Name string `json:"name"`
+				} `json:"proxySecretRef,omitempty"`
+
+				// Repository Part of OCI url oci://<repository>:<tag>
+				Repository string `json:"repository"`
+
+				// SecretRef SecretRef contains the secret name containing the registry login
+				// credentials to resolve image metadata.
+				// The secret must be of type kubernetes.io/dockerconfigjson.
+				SecretRef *struct {
+					// Name Name of the referent.
+					Name string `json:"name"`
+				} `json:"secretRef,omitempty"`
+
+				// ServiceAccountName ServiceAccountName is the name of the Kubernetes ServiceAccount used to authenticate
+				// the image pull if the service account has attached pull secrets. For more information:
+				// https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/#add-imagepullsecrets-to-a-service-account
+				ServiceAccountName *string `json:"serviceAccountName,omitempty"`
+
+				// Suspend This flag tells the controller to suspend the reconciliation of this source.
+				Suspend *bool `json:"suspend,omitempty"`
+
+				// Tag Part of OCI url oci://<repository>:<tag>
+				Tag string `json:"tag"`
+
+				// Timeout The timeout for remote OCI Repository operations like pulling, defaults to 60s.
+				Timeout *string `json:"timeout,omitempty"`
+
+				// Verify Verify contains the secret name containing the trusted public keys
+				// used to verify the signature and specifies which provider to use to check
+				// whether OCI image is authentic.
+				Verify *struct {
+					// MatchOIDCIdentity MatchOIDCIdentity specifies the identity matching criteria to use
+					// while verifying an OCI artifact which was signed using Cosign keyless
+					// signing. The artifact's identity is deemed to be verified if any of the
+					// specified matchers match against the identity.
+					MatchOIDCIdentity *[]struct {
+						// Issuer Issuer specifies the regex pattern to match against to verify
+						// the OIDC issuer in the Fulcio certificate. The pattern must be a
+						// valid Go regular expression.
+						Issuer string `json:"issuer"`
+
+						// Subject Subject specifies the regex pattern to match against to verify
+						// the identity subject in the Fulcio certificate. The pattern must
+						// be a valid Go regular expression.
+						Subject string `json:"subject"`
+					} `json:"matchOIDCIdentity,omitempty"`
+
+					// Provider Provider specifies the technology used to sign the OCI Artifact.
+					Provider ReleaseRevisionReleaseSpecPackageVerifyProvider `json:"provider"`
+
+					// SecretRef SecretRef specifies the Kubernetes Secret containing the
+					// trusted public keys.
+					SecretRef *struct {
+						// Name Name of the referent.
+						Name string `json:"name"`
+					} `json:"secretRef,omitempty"`
+				} `json:"verify,omitempty"`
+			} `json:"package"`
+
+			// Parameters The Release configuration variables
+			Parameters *interface{} `json:"parameters,omitempty"`
+
+			// Protected If true, the webhook will prevent deletion
+			// Default: false
+			Protected *bool `json:"protected,omitempty"`
+
+			// Roles List of roles fulfilled by this release. (appended to the one of the underlying package)
+			// Default: []
+			Roles *[]string `json:"roles,omitempty"`
+
+			// SkipDefaultContext If yes, the default context(s) of the configs are not taken in account
+			// ,Default: false
+			SkipDefaultContext *bool `json:"skipDefaultContext,omitempty"`
+
+			// SpecPatchByModule Allow to patch the HelmRelease.spec for each module
+			SpecPatchByModule *map[string]interface{} `json:"specPatchByModule,omitempty"`
+
+			// Suspended If true, HelmRelease update is suspended at KuboCD level
+			// (This is NOT the helmRelease.spec.suspend flag, which may be set by Config part)
+			// Default: false
+			Suspended *bool `json:"suspended,omitempty"`
+
+			// TargetNamespace The namespace to deploy in. (May also be a partial name for a multi-namespaces package)
+			// Not required, as it can be setup another way, depending on the package
+			// (i.e. the package has a fixed namespace, or several ones).
+			// Default: Release.metadata.namespace
+			TargetNamespace *string `json:"targetNamespace,omitempty"`
+		} `json:"spec"`
+
+		// Status ReleaseStatus defines the observed state of Release.
+		// As we want Status to be explicit about provided information, we don't use 'omitempty' in its definition.
+		// (Except for 'context', as controlled by a debug flag)
+		Status *struct {
+			// Context Context is the resulting context, if requested in debug options
+			Context *interface{} `json:"context,omitempty"`
+
+			// Dependencies The result of the package template and release value
+			Dependencies *[]string `json:"dependencies,omitempty"`
+
+			// HelmReleaseStates HelmReleaseState describe the observed state of child HelmReleases by name
+			HelmReleaseStates *map[string]struct {
+				Ready  string  `json:"ready"`
+				Status *string `json:"status,omitempty"`
+			} `json:"helmReleaseStates,omitempty"`
+			MissingDependency *string `json:"missingDependency,omitempty"`
+
+			// Parameters Parameters is the resulting parameters set, if requested in debug options
+			Parameters *interface{} `json:"parameters,omitempty"`
+			Phase      *string      `json:"phase,omitempty"`
+
+			// PrintContexts PrintContextsContexts is a string to list our context. Not technically used, but intended to be displayed
+			// as printcolumn
+			PrintContexts *string `json:"printContexts,omitempty"`
+
+			// PrintDescription PrintDescription
+			// Copy of the release description, or, if empty the (templated) package one
+			PrintDescription *string `json:"printDescription,omitempty"`
+
+			// PrintProtected PrintProtected is a copy of Protected, with a Y/n flag. To be used in display
+			PrintProtected *string `json:"printProtected,omitempty"`
+
+			// Protected Protected result of Release.spec.protected defaulted to package.spec.protected
+			// It is the value checked by the webhook
+			Protected *bool `json:"protected,omitempty"`
+
+			// ReadyReleases ReadyReleases is a string to display X/Y helmRelease ready. Not technically used, but intended to be displayed
+			// as printcolumn
+			ReadyReleases *string `json:"readyReleases,omitempty"`
+
+			// Roles The result of the package template and release value
+			Roles *[]string `json:"roles,omitempty"`
+
+			// Usage Usage is the rendering of the Package.spec.usage[key]. Aimed to provide user information.
+			// Key could 'html', 'text', some language id, etc...
+			Usage *map[string]string `json:"usage,omitempty"`
+		} `json:"status,omitempty"`
+	} `json:"release,omitempty"`
+
+	// Revision Revision number, from 1 (the oldest revision)
+	Revision int `json:"revision"`
+
+	// Timestamp Date of the revision (commit date for the git releases)
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// ReleaseRevisionReleaseSpecPackageProvider The source will be handled by a child fluxCD OciRepository resource, which will be created by this operator
+// All following fields will be replicated in this object
+// The provider used for authentication, can be 'aws', 'azure', 'gcp' or 'generic'.
+// When not specified, defaults to 'generic'.
+// -kubebuilder:default:=generic
+type ReleaseRevisionReleaseSpecPackageProvider string
+
+// ReleaseRevisionReleaseSpecPackageVerifyProvider Provider specifies the technology used to sign the OCI Artifact.
+type ReleaseRevisionReleaseSpecPackageVerifyProvider string
+
+// ReleaseSkeleton defines model for ReleaseSkeleton.
+type ReleaseSkeleton struct {
+	// MissingParameters Paths of the required parameters without default value, they must be filled in before creating the release
+	MissingParameters []string `json:"missingParameters"`
+
+	// Release Release is the Schema for the releases API.
+	Release struct {
+		// ApiVersion APIVersion defines the versioned schema of this representation of an object.
+		// Servers should convert recognized schemas to the latest internal value, and
+		// may reject unrecognized values.
+		// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+		ApiVersion string `json:"apiVersion"`
+
+		// Kind Kind is a string value representing the REST resource this object represents.
+		// Servers may infer this from the endpoint the client submits requests to.
+		// Cannot be updated.
+		// In CamelCase.
+		// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+		Kind string `json:"kind"`
+
+		// Metadata Standard object metadata.
+		Metadata struct {
+			// Annotations Arbitrary metadata.
+			Annotations *map[string]string `json:"annotations,omitempty"`
+
+			// CreationTimestamp Creation timestamp.
+			CreationTimestamp *time.Time `json:"creationTimestamp,omitempty"`
+
+			// DeletionGracePeriodSeconds Seconds allowed for graceful termination.
+			DeletionGracePeriodSeconds *int64 `json:"deletionGracePeriodSeconds,omitempty"`
+
+			// DeletionTimestamp Deletion timestamp.
+			DeletionTimestamp *time.Time `json:"deletionTimestamp,omitempty"`
+
+			// Finalizers List of finalizers.
+			Finalizers *[]string `json:"finalizers,omitempty"`
+
+			// GenerateName Prefix for generating a unique name.
+			GenerateName *string `json:"generateName,omitempty"`
+
+			// Generation Sequence number representing generation of desired state.
+			Generation *int64 `json:"generation,omitempty"`
+
+			// Labels Key-value pairs to categorize resources.
+			Labels *map[string]string `json:"labels,omitempty"`
+
+			// ManagedFields Managed fields tracking info (complex object).
+			ManagedFields *map[string]interface{} `json:"managedFields,omitempty"`
+
+			// Name Name of the resource.
+			Name *string `json:"name,omitempty"`
+
+			// Namespace Namespace of the resource.
+			Namespace *string `json:"namespace,omitempty"`
+
+			// OwnerReferences References to owning resources.
+			OwnerReferences *[]struct {
+				ApiVersion *string `json:"apiVersion,omitempty"`
+				Kind       *string `json:"kind,omitempty"`
+				Name       *string `json:"name,omitempty"`
+				Uid        *string `json:"uid,omitempty"`
+			} `json:"ownerReferences,omitempty"`
+
+			// ResourceVersion Resource version for concurrency control.
+			ResourceVersion *string `json:"resourceVersion,omitempty"`
+
+			// SelfLink Deprecated self-link URL.
+			SelfLink *string `json:"selfLink,omitempty"`
+
+			// Uid Unique ID assigned by Kubernetes.
+			Uid *string `json:"uid,omitempty"`
+		} `json:"metadata"`
+
+		// Spec ReleaseSpec defines the desired state of Release.
+		Spec struct {
+			// Contexts To provide contextual variables
+			// Refer to Context resource description for some explanation
+			// Contexts are merged in the following order:
+			// - The global default one (defined in Config)
+			// - The namespace context (A context with a specific name, defined in config, present in the release namespace)
+			// - This ordered list
+			// Default: []
+			Contexts *[]struct {
+				Name      string  `json:"name"`
+				Namespace *string `json:"namespace,omitempty"`
+			} `json:"contexts,omitempty"`
+
+			// CreateNamespace If true, add  { install: { createNamespace: true } } to config map.
+			// Must be set, as used in module.Render()
+			// Default: false
+			CreateNamespace *bool `json:"createNamespace,omitempty"`
+
+			// Debug Group a set of parameters useful for debugging Release and Package
+			Debug *struct {
+				// DumpContext DumpContext instruct to save a representation of the context
+				// in the Status. This for user debugging?
+				DumpContext *bool `json:"dumpContext,omitempty"`
+
+				// DumpParameters DumpParameters instruct to save a representation of the parameters
+				// in the Status. This for user debugging?
+				DumpParameters *bool `json:"dumpParameters,omitempty"`
+			} `json:"debug,omitempty"`
+
+			// Dependencies The roles we depend on. (appended to the one of the underlying package)
+			// Default: []
+			Dependencies *[]string `json:"dependencies,omitempty"`
+
+			// Description Short description of this release. Single line only
+			Description *string `json:"description,omitempty"`
+
+			// Package The package to deploy
+			Package struct {
+				// CertSecretRef CertSecretRef can be given the name of a Secret containing
+				// either or both of
+				//
+				// - a PEM-encoded client certificate (`tls.crt`) and private
+				// key (`tls.key`);
+				// - a PEM-encoded CA certificate (`ca.crt`)
+				//
+				// and whichever are supplied, will be used for connecting to the
+				// registry. The client cert and key are useful if you are
+				// authenticating with a certificate; the CA cert is useful if
+				// you are using a self-signed server certificate. The Secret must
+				// be of type `Opaque` or `kubernetes.io/tls`.
+				//
+				// Note: Support for the `caFile`, `certFile` and `keyFile` keys have
+				// been deprecated.
+				CertSecretRef *struct {
+					// Name Name of the referent.
+					Name string `json:"name"`
+				} `json:"certSecretRef,omitempty"`
+
+				// Ignore Ignore overrides the set of excluded patterns in the .sourceignore format
+				// (which is the same as .gitignore). If not provided, a default will be used,
+				// consult the documentation for your version to find out what those are.
+				Ignore *string `json:"ignore,omitempty"`
+
+				// Insecure Insecure allows connecting to a non-TLS HTTP container registry.
+				Insecure *bool `json:"insecure,omitempty"`
+
+				// Interval Interval at which the OCIRepository URL is checked for updates.
+				// This interval is approximate and may be subject to jitter to ensure
+				// efficient use of resources.
+				Interval string `json:"interval"`
+
+				// Provider The source will be handled by a child fluxCD OciRepository resource, which will be created by this operator
+				// All following fields will be replicated in this object
+				// The provider used for authentication, can be 'aws', 'azure', 'gcp' or 'generic'.
+				// When not specified, defaults to 'generic'.
+				// -kubebuilder:default:=generic
+				Provider *ReleaseSkeletonReleaseSpecPackageProvider `json:"provider,omitempty"`
+
+				// ProxySecretRef ProxySecretRef specifies the Secret containing the proxy configuration
+				// to use while communicating with the container registry.
+				ProxySecretRef *struct {
+					// Name Name of the referent.
+					Name string `json:"name"`
+				} `json:"proxySecretRef,omitempty"`
+
+				// Repository Part of OCI url oci://<repository>:<tag>
+				Repository string `json:"repository"`
+
+				// SecretRef SecretRef contains the secret name containing the registry login
+				// credentials to resolve image metadata.
+				// The secret must be of type kubernetes.io/dockerconfigjson.
+				SecretRef *struct {
+					// Name Name of the referent.
+					Name string `json:"name"`
+				} `json:"secretRef,omitempty"`
+
+				// ServiceAccountName ServiceAccountName is the name of the Kubernetes ServiceAccount used to authenticate
+				// the image pull if the service account has attached pull secrets. For more information:
+				// https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/#add-imagepullsecrets-to-a-service-account
+				ServiceAccountName *string `json:"serviceAccountName,omitempty"`
+
+				// Suspend This flag tells the controller to suspend the reconciliation of this source.
+				Suspend *bool `json:"suspend,omitempty"`
+
+				// Tag Part of OCI url oci://<repository>:<tag>
+				Tag string `json:"tag"`
+
+				// Timeout The timeout for remote OCI Repository operations like pulling, defaults to 60s.
+				Timeout *string `json:"timeout,omitempty"`
+
+				// Verify Verify contains the secret name containing the trusted public keys
+				// used to verify the signature and specifies which provider to use to check
+				// whether OCI image is authentic.
+				Verify *struct {
+					// MatchOIDCIdentity MatchOIDCIdentity specifies the identity matching criteria to use
+					// while verifying an OCI artifact which was signed using Cosign keyless
+					// signing. The artifact's identity is deemed to be verified if any of the
+					// specified matchers match against the identity.
+					MatchOIDCIdentity *[]struct {
+						// Issuer Issuer specifies the regex pattern to match against to verify
+						// the OIDC issuer in the Fulcio certificate. The pattern must be a
+						// valid Go regular expression.
+						Issuer string `json:"issuer"`
+
+						// Subject Subject specifies the regex pattern to match against to verify
+						// the identity subject in the Fulcio certificate. The pattern must
+						// be a valid Go regular expression.
+						Subject string `json:"subject"`
+					} `json:"matchOIDCIdentity,omitempty"`
+
+					// Provider Provider specifies the technology used to sign the OCI Artifact.
+					Provider ReleaseSkeletonReleaseSpecPackageVerifyProvider `json:"provider"`
+
+					// SecretRef SecretRef specifies the Kubernetes Secret containing the
+					// trusted public keys.
+					SecretRef *struct {
+						// Name Name of the referent.
+						Name string `json:"name"`
+					} `json:"secretRef,omitempty"`
+				} `json:"verify,omitempty"`
+			} `json:"package"`
+
+			// Parameters The Release configuration variables
+			Parameters *interface{} `json:"parameters,omitempty"`
+
+			// Protected If true, the webhook will prevent deletion
+			// Default: false
+			Protected *bool `json:"protected,omitempty"`
+
+			// Roles List of roles fulfilled by this release. (appended to the one of the underlying package)
+			// Default: []
+			Roles *[]string `json:"roles,omitempty"`
+
+			// SkipDefaultContext If yes, the default context(s) of the configs are not taken in account
+			// ,Default: false
+			SkipDefaultContext *bool `json:"skipDefaultContext,omitempty"`
+
+			// SpecPatchByModule Allow to patch the HelmRelease.spec for each module
+			SpecPatchByModule *map[string]interface{} `json:"specPatchByModule,omitempty"`
+
+			// Suspended If true, HelmRelease update is suspended at KuboCD level
+			// (This is NOT the helmRelease.spec.suspend flag, which may be set by Config part)
+			// Default: false
+			Suspended *bool `json:"suspended,omitempty"`
+
+			// TargetNamespace The namespace to deploy in. (May also be a partial name for a multi-namespaces package)
+			// Not required, as it can be setup another way, depending on the package
+			// (i.e. the package has a fixed namespace, or several ones).
+			// Default: Release.metadata.namespace
+			TargetNamespace *string `json:"targetNamespace,omitempty"`
+		} `json:"spec"`
+
+		// Status ReleaseStatus defines the observed state of Release.
+		// As we want Status to be explicit about provided information, we don't use 'omitempty' in its definition.
+		// (Except for 'context', as controlled by a debug flag)
+		Status *struct {
+			// Context Context is the resulting context, if requested in debug options
+			Context *interface{} `json:"context,omitempty"`
+
+			// Dependencies The result of the package template and release value
+			Dependencies *[]string `json:"dependencies,omitempty"`
+
+			// HelmReleaseStates HelmReleaseState describe the observed state of child HelmReleases by name
+			HelmReleaseStates *map[string]struct {
+				Ready  string  `json:"ready"`
+				Status *string `json:"status,omitempty"`
+			} `json:"helmReleaseStates,omitempty"`
+			MissingDependency *string `json:"missingDependency,omitempty"`
+
+			// Parameters Parameters is the resulting parameters set, if requested in debug options
+			Parameters *interface{} `json:"parameters,omitempty"`
+			Phase      *string      `json:"phase,omitempty"`
+
+			// PrintContexts PrintContextsContexts is a string to list our context. Not technically used, but intended to be displayed
+			// as printcolumn
+			PrintContexts *string `json:"printContexts,omitempty"`
+
+			// PrintDescription PrintDescription
+			// Copy of the release description, or, if empty the (templated) package one
+			PrintDescription *string `json:"printDescription,omitempty"`
+
+			// PrintProtected PrintProtected is a copy of Protected, with a Y/n flag. To be used in display
+			PrintProtected *string `json:"printProtected,omitempty"`
+
+			// Protected Protected result of Release.spec.protected defaulted to package.spec.protected
+			// It is the value checked by the webhook
+			Protected *bool `json:"protected,omitempty"`
+
+			// ReadyReleases ReadyReleases is a string to display X/Y helmRelease ready. Not technically used, but intended to be displayed
+			// as printcolumn
+			ReadyReleases *string `json:"readyReleases,omitempty"`
+
+			// Roles The result of the package template and release value
+			Roles *[]string `json:"roles,omitempty"`
+
+			// Usage Usage is the rendering of the Package.spec.usage[key]. Aimed to provide user information.
+			// Key could 'html', 'text', some language id, etc...
+			Usage *map[string]string `json:"usage,omitempty"`
+		} `json:"status,omitempty"`
+	} `json:"release"`
+
+	// RequiredParameters Paths of the required parameters of the package, sorted
+	RequiredParameters []string `json:"requiredParameters"`
+}
+
+// ReleaseSkeletonReleaseSpecPackageProvider The source will be handled by a child fluxCD OciRepository resource, which will be created by this operator
+// All following fields will be replicated in this object
+// The provider used for authentication, can be 'aws', 'azure', 'gcp' or 'generic'.
+// When not specified, defaults to 'generic'.
+// -kubebuilder:default:=generic
+type ReleaseSkeletonReleaseSpecPackageProvider string
+
+// ReleaseSkeletonReleaseSpecPackageVerifyProvider Provider specifies the technology used to sign the OCI Artifact.
+type ReleaseSkeletonReleaseSpecPackageVerifyProvider string
+
+// ReleaseStatus ReleaseStatus defines the observed state of Release.
+// As we want Status to be explicit about provided information, we don't use 'omitempty' in its definition.
+// (Except for 'context', as controlled by a debug flag)
+type ReleaseStatus struct {
+	// Context Context is the resulting context, if requested in debug options
+	Context *interface{} `json:"context,omitempty"`
+
+	// Dependencies The result of the package template and release value
+	Dependencies *[]string `json:"dependencies,omitempty"`
+
+	// HelmReleaseStates HelmReleaseState describe the observed state of child HelmReleases by name
+	HelmReleaseStates *map[string]struct {
+		Ready  string  `json:"ready"`
+		Status *string `json:"status,omitempty"`
+	} `json:"helmReleaseStates,omitempty"`
+	MissingDependency *string `json:"missingDependency,omitempty"`
+
+	// Parameters Parameters is the resulting parameters set, if requested in debug options
+	Parameters *interface{} `json:"parameters,omitempty"`
+	Phase      *string      `json:"phase,omitempty"`
+
+	// PrintContexts PrintContextsContexts is a string to list our context. Not technically used, but intended to be displayed
+	// as printcolumn
+	PrintContexts *string `json:"printContexts,omitempty"`
+
+	// PrintDescription PrintDescription
+	// Copy of the release description, or, if empty the (templated) package one
+	PrintDescription *string `json:"printDescription,omitempty"`
+
+	// PrintProtected PrintProtected is a copy of Protected, with a Y/n flag. To be used in display
+	PrintProtected *string `json:"printProtected,omitempty"`
+
+	// Protected Protected result of Release.spec.protected defaulted to package.spec.protected
+	// It is the value checked by the webhook
+	Protected *bool `json:"protected,omitempty"`
+
+	// ReadyReleases ReadyReleases is a string to display X/Y helmRelease ready. Not technically used, but intended to be displayed
+	// as printcolumn
+	ReadyReleases *string `json:"readyReleases,omitempty"`
+
+	// Roles The result of the package template and release value
+	Roles *[]string `json:"roles,omitempty"`
+
+	// Usage Usage is the rendering of the Package.spec.usage[key]. Aimed to provide user information.
+	// Key could 'html', 'text', some language id, etc...
+	Usage *map[string]string `json:"usage,omitempty"`
+}
+
+// ReleaseSummary defines model for ReleaseSummary.
+type ReleaseSummary struct {
+	// ClusterId Kubernetes cluster ID
+	ClusterId         string     `json:"clusterId"`
+	CreationTimestamp *time.Time `json:"creationTimestamp,omitempty"`
+
+	// Description Description of the release (or of the package if not set)
+	Description *string `json:"description,omitempty"`
+
+	// Name Name of the release
+	Name string `json:"name"`
+
+	// Namespace Namespace of the release
+	Namespace string `json:"namespace"`
+
+	// PackageRepository OCI repository of the KuboCD package
+	PackageRepository string `json:"packageRepository"`
+
+	// PackageTag Version of the KuboCD package
+	PackageTag string `json:"packageTag"`
+
+	// Phase Phase of the release (READY, ERROR, WAIT_OCI, WAIT_REPO, WAIT_HREL, WAIT_DEPS, SUSPENDED)
+	Phase     *string `json:"phase,omitempty"`
+	Protected *bool   `json:"protected,omitempty"`
+
+	// ReadyReleases Number of ready helm releases (X/Y)
+	ReadyReleases *string `json:"readyReleases,omitempty"`
+	Suspended     *bool   `json:"suspended,omitempty"`
+
+	// TargetNamespace Namespace where the release workloads are deployed
+	TargetNamespace *string `json:"targetNamespace,omitempty"`
+}
+
+// ReleaseUpgrade defines model for ReleaseUpgrade.
+type ReleaseUpgrade struct {
+	// ClusterId Kubernetes cluster ID
+	ClusterId string `json:"clusterId"`
+	Error     *struct {
+		// Code Stable machine-readable error code, for example: BAD_REQUEST, VALIDATION_FAILED, UNAUTHORIZED, FORBIDDEN, NOT_FOUND, CONFLICT,
+		// CLUSTER_NOT_FOUND, NAMESPACE_NOT_FOUND, NAMESPACE_ALREADY_EXISTS, CATALOG_NOT_FOUND, PACKAGE_NOT_FOUND,
+		// RELEASE_NOT_FOUND, RELEASE_ALREADY_EXISTS, RELEASE_CONFLICT, RELEASE_INVALID, REVISION_NOT_FOUND, RESOURCE_SUSPENDED, GIT_REPO_NOT_FOUND, GIT_PUSH_REJECTED,
+		// GIT_AUTH_FAILED, GIT_ERROR, KUBERNETES_ERROR, REGISTRY_UNAUTHORIZED, REGISTRY_DENIED, REGISTRY_NOT_FOUND, REGISTRY_ERROR,
+		// AUDIT_NOT_ENABLED, CONTINUE_EXPIRED, PRECONDITION_FAILED, UNSUPPORTED_MEDIA_TYPE,
+		// PATCH_FAILED, WATCH_EXPIRED, TOO_MANY_REQUESTS, SERVICE_UNAVAILABLE, GATEWAY_TIMEOUT, INVALID_RESPONSE, INTERNAL_ERROR.
+		// Not set on success responses.
+		Code *string `json:"code,omitempty"`
+
+		// Details Field-level details of the error (validation errors)
+		Details *[]struct {
+			// Field Path of the invalid field
+			Field *string `json:"field,omitempty"`
+
+			// Message Human readable description of the error on the field
+			Message string `json:"message"`
+
+			// Reason Machine-readable reason of the error on the field
+			Reason *string `json:"reason,omitempty"`
+		} `json:"details,omitempty"`
+
+		// Message The response message from the server
+		Message string `json:"message"`
+
+		// RequestId The correlation ID of the request (X-Request-ID header)
+		RequestId *string `json:"requestId,omitempty"`
+
+		// Status The HTTP status code
+		Status int `json:"status"`
+
+		// Type The type of the server response
+		Type ReleaseUpgradeErrorType `json:"type"`
+	} `json:"error,omitempty"`
+
+	// FromVersion Current package version of the release
+	FromVersion string `json:"fromVersion"`
+
+	// GitRepo Fluxcd kustomization (namespace/name) of the git repo holding the release, git target only
+	GitRepo *string `json:"gitRepo,omitempty"`
+
+	// Name KuboCD release name
+	Name string `json:"name"`
+
+	// Namespace Namespace of the release
+	Namespace string `json:"namespace"`
+
+	// Path Path of the release in the git repo, git target only
+	Path *string `json:"path,omitempty"`
+
+	// Status Status of the upgrade of the release:
+	//   - Planned: the release would be upgraded (dry run)
+	//   - Upgraded: the release is upgraded
+	//   - Invalid: the parameters of the release do not match the schema of the new version, the release is not upgraded
+	//   - Failed: the upgrade of the release failed
+	Status ReleaseUpgradeStatus `json:"status"`
+
+	// ToVersion Package version the release is upgraded to
+	ToVersion string `json:"toVersion"`
+}
+
+// ReleaseUpgradeErrorType The type of the server response
+type ReleaseUpgradeErrorType string
+
+// ReleaseUpgradeStatus Status of the upgrade of the release:
+//   - Planned: the release would be upgraded (dry run)
+//   - Upgraded: the release is upgraded
+//   - Invalid: the parameters of the release do not match the schema of the new version, the release is not upgraded
+//   - Failed: the upgrade of the release failed
+type ReleaseUpgradeStatus string
+
+// ResourceNode defines model for ResourceNode.
+type ResourceNode struct {
+	// CreatedAt Creation date of the resource
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Group API group of the resource, empty for the core resources
+	Group string `json:"group"`
+
+	// Health Health of the resource, not set for the resources without status (ConfigMaps, Secrets, ...)
+	Health *ResourceNodeHealth `json:"health,omitempty"`
+
+	// Kind Kind of the resource
+	Kind string `json:"kind"`
+
+	// Name Name of the resource
+	Name string `json:"name"`
+
+	// Namespace Namespace of the resource
+	Namespace string `json:"namespace"`
+
+	// ParentRefs Parents of the resource in the tree, empty for the release
+	ParentRefs []struct {
+		// Group API group of the resource, empty for the core resources
+		Group string `json:"group"`
+
+		// Kind Kind of the resource
+		Kind string `json:"kind"`
+
+		// Name Name of the resource
+		Name string `json:"name"`
+
+		// Namespace Namespace of the resource
+		Namespace string `json:"namespace"`
+
+		// Uid UID of the resource
+		Uid string `json:"uid"`
+
+		// Version API version of the resource
+		Version string `json:"version"`
+	} `json:"parentRefs"`
+
+	// Status Status message of the resource
+	Status *string `json:"status,omitempty"`
+
+	// Uid UID of the resource
+	Uid string `json:"uid"`
+
+	// Version API version of the resource
+	Version string `json:"version"`
+}
+
+// ResourceNodeHealth Health of the resource, not set for the resources without status (ConfigMaps, Secrets, ...)
+type ResourceNodeHealth string
+
+// ResourceRef defines model for ResourceRef.
+type ResourceRef struct {
+	// Group API group of the resource, empty for the core resources
+	Group string `json:"group"`
+
+	// Kind Kind of the resource
+	Kind string `json:"kind"`
+
+	// Name Name of the resource
+	Name string `json:"name"`
+
+	// Namespace Namespace of the resource
+	Namespace string `json:"namespace"`
+
+	// Uid UID of the resource
+	Uid string `json:"uid"`
+
+	// Version API version of the resource
+	Version string `json:"version"`
+}
+
+// ResourceTree defines model for ResourceTree.
+type ResourceTree struct {
+	// Nodes Resources created by the release, sorted by kind from the release down to the pods. Each node references
+	// its parents: the release for the HelmReleases, the HelmRelease for its Helm storage secrets and for the
+	// resources it deployed, the owners for the other resources (ReplicaSets, Pods, ...).
+	Nodes []struct {
+		// CreatedAt Creation date of the resource
+		CreatedAt time.Time `json:"createdAt"`
+
+		// Group API group of the resource, empty for the core resources
+		Group string `json:"group"`
+
+		// Health Health of the resource, not set for the resources without status (ConfigMaps, Secrets, ...)
+		Health *ResourceTreeNodesHealth `json:"health,omitempty"`
+
+		// Kind Kind of the resource
+		Kind string `json:"kind"`
+
+		// Name Name of the resource
+		Name string `json:"name"`
+
+		// Namespace Namespace of the resource
+		Namespace string `json:"namespace"`
+
+		// ParentRefs Parents of the resource in the tree, empty for the release
+		ParentRefs []struct {
+			// Group API group of the resource, empty for the core resources
+			Group string `json:"group"`
+
+			// Kind Kind of the resource
+			Kind string `json:"kind"`
+
+			// Name Name of the resource
+			Name string `json:"name"`
+
+			// Namespace Namespace of the resource
+			Namespace string `json:"namespace"`
+
+			// Uid UID of the resource
+			Uid string `json:"uid"`
+
+			// Version API version of the resource
+			Version string `json:"version"`
+		} `json:"parentRefs"`
+
+		// Status Status message of the resource
+		Status *string `json:"status,omitempty"`
+
+		// Uid UID of the resource
+		Uid string `json:"uid"`
+
+		// Version API version of the resource
+		Version string `json:"version"`
+	} `json:"nodes"`
+	Release struct {
+		// Group API group of the resource, empty for the core resources
+		Group string `json:"group"`
+
+		// Kind Kind of the resource
+		Kind string `json:"kind"`
+
+		// Name Name of the resource
+		Name string `json:"name"`
+
+		// Namespace Namespace of the resource
+		Namespace string `json:"namespace"`
+
+		// Uid UID of the resource
+		Uid string `json:"uid"`
+
+		// Version API version of the resource
+		Version string `json:"version"`
+	} `json:"release"`
+}
+
+// ResourceTreeNodesHealth Health of the resource, not set for the resources without status (ConfigMaps, Secrets, ...)
+type ResourceTreeNodesHealth string
+
+// ServerResponse defines model for ServerResponse.
+type ServerResponse struct {
+	// Code Stable machine-readable error code, for example: BAD_REQUEST, VALIDATION_FAILED, UNAUTHORIZED, FORBIDDEN, NOT_FOUND, CONFLICT,
+	// CLUSTER_NOT_FOUND, NAMESPACE_NOT_FOUND, NAMESPACE_ALREADY_EXISTS, CATALOG_NOT_FOUND, PACKAGE_NOT_FOUND,
+	// RELEASE_NOT_FOUND, RELEASE_ALREADY_EXISTS, RELEASE_CONFLICT, RELEASE_INVALID, REVISION_NOT_FOUND, RESOURCE_SUSPENDED, GIT_REPO_NOT_FOUND, GIT_PUSH_REJECTED,
+	// GIT_AUTH_FAILED, GIT_ERROR, KUBERNETES_ERROR, REGISTRY_UNAUTHORIZED, REGISTRY_DENIED, REGISTRY_NOT_FOUND, REGISTRY_ERROR,
+	// AUDIT_NOT_ENABLED, CONTINUE_EXPIRED, PRECONDITION_FAILED, UNSUPPORTED_MEDIA_TYPE,
+	// PATCH_FAILED, WATCH_EXPIRED, TOO_MANY_REQUESTS, SERVICE_UNAVAILABLE, GATEWAY_TIMEOUT, INVALID_RESPONSE, INTERNAL_ERROR.
+	// Not set on success responses.
+	Code *string `json:"code,omitempty"`
+
+	// Details Field-level details of the error (validation errors)
+	Details *[]struct {
+		// Field Path of the invalid field
+		Field *string `json:"field,omitempty"`
+
+		// Message Human readable description of the error on the field
+		Message string `json:"message"`
+
+		// Reason Machine-readable reason of the error on the field
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+
+	// Message The response message from the server
+	Message string `json:"message"`
+
+	// RequestId The correlation ID of the request (X-Request-ID header)
+	RequestId *string `json:"requestId,omitempty"`
+
+	// Status The HTTP status code
+	Status int `json:"status"`
+
+	// Type The type of the server response
+	Type ServerResponseType `json:"type"`
+}
+
+// ServerResponseType The type of the server response
+type ServerResponseType string
+
+// SystemInfo defines model for SystemInfo.
+type SystemInfo struct {
+	AuditEnabled *bool `json:"auditEnabled,omitempty"`
+
+	// Catalogs Number of configured catalogs
+	Catalogs *int `json:"catalogs,omitempty"`
+	Clusters []struct {
+		Env               *string `json:"env,omitempty"`
+		Id                string  `json:"id"`
+		KubernetesVersion *string `json:"kubernetesVersion,omitempty"`
+
+		// Message Error message when the cluster is not reachable
+		Message *string `json:"message,omitempty"`
+
+		// Reachable Whether the Kubernetes API server of the cluster is reachable
+		Reachable bool `json:"reachable"`
+	} `json:"clusters"`
+	GoVersion string `json:"goVersion"`
+
+	// StartTime Start time of the okdp-server instance
+	StartTime time.Time `json:"startTime"`
+
+	// Uptime Uptime of the okdp-server instance
+	Uptime string `json:"uptime"`
+
+	// Version Version of the okdp-server
+	Version string `json:"version"`
+}
+
+// Upgrade defines model for Upgrade.
+type Upgrade struct {
+	// DryRun Whether the upgrade is a preview only
+	DryRun bool `json:"dryRun"`
+
+	// Package OCI repository of the upgraded package
+	Package string `json:"package"`
+
+	// Releases Upgrade of each selected release, sorted by cluster, namespace and name
+	Releases []struct {
+		// ClusterId Kubernetes cluster ID
+		ClusterId string `json:"clusterId"`
+		Error     *struct {
+			// Code Stable machine-readable error code, for example: BAD_REQUEST, VALIDATION_FAILED, UNAUTHORIZED, FORBIDDEN, NOT_FOUND, CONFLICT,
+			// CLUSTER_NOT_FOUND, NAMESPACE_NOT_FOUND, NAMESPACE_ALREADY_EXISTS, CATALOG_NOT_FOUND, PACKAGE_NOT_FOUND,
+			// RELEASE_NOT_FOUND, RELEASE_ALREADY_EXISTS, RELEASE_CONFLICT, RELEASE_INVALID, REVISION_NOT_FOUND, RESOURCE_SUSPENDED, GIT_REPO_NOT_FOUND, GIT_PUSH_REJECTED,
+			// GIT_AUTH_FAILED, GIT_ERROR, KUBERNETES_ERROR, REGISTRY_UNAUTHORIZED, REGISTRY_DENIED, REGISTRY_NOT_FOUND, REGISTRY_ERROR,
+			// AUDIT_NOT_ENABLED, CONTINUE_EXPIRED, PRECONDITION_FAILED, UNSUPPORTED_MEDIA_TYPE,
+			// PATCH_FAILED, WATCH_EXPIRED, TOO_MANY_REQUESTS, SERVICE_UNAVAILABLE, GATEWAY_TIMEOUT, INVALID_RESPONSE, INTERNAL_ERROR.
+			// Not set on success responses.
+			Code *string `json:"code,omitempty"`
+
+			// Details Field-level details of the error (validation errors)
+			Details *[]struct {
+				// Field Path of the invalid field
+				Field *string `json:"field,omitempty"`
+
+				// Message Human readable description of the error on the field
+				Message string `json:"message"`
+
+				// Reason Machine-readable reason of the error on the field
+				Reason *string `json:"reason,omitempty"`
+			} `json:"details,omitempty"`
+
+			// Message The response message from the server
+			Message string `json:"message"`
+
+			// RequestId The correlation ID of the request (X-Request-ID header)
+			RequestId *string `json:"requestId,omitempty"`
+
+			// Status The HTTP status code
+			Status int `json:"status"`
+
+			// Type The type of the server response
+			Type UpgradeReleasesErrorType `json:"type"`
+		} `json:"error,omitempty"`
+
+		// FromVersion Current package version of the release
+		FromVersion string `json:"fromVersion"`
+
+		// GitRepo Fluxcd kustomization (namespace/name) of the git repo holding the release, git target only
+		GitRepo *string `json:"gitRepo,omitempty"`
+
+		// Name KuboCD release name
+		Name string `json:"name"`
+
+		// Namespace Namespace of the release
+		Namespace string `json:"namespace"`
+
+		// Path Path of the release in the git repo, git target only
+		Path *string `json:"path,omitempty"`
+
+		// Status Status of the upgrade of the release:
+		//   - Planned: the release would be upgraded (dry run)
+		//   - Upgraded: the release is upgraded
+		//   - Invalid: the parameters of the release do not match the schema of the new version, the release is not upgraded
+		//   - Failed: the upgrade of the release failed
+		Status UpgradeReleasesStatus `json:"status"`
+
+		// ToVersion Package version the release is upgraded to
+		ToVersion string `json:"toVersion"`
+	} `json:"releases"`
+
+	// Skipped Clusters and git repos whose releases could not be read, sorted by cluster and git repo
+	Skipped *[]struct {
+		// ClusterId Kubernetes cluster ID
+		ClusterId string `json:"clusterId"`
+		Error     struct {
+			// Code Stable machine-readable error code, for example: BAD_REQUEST, VALIDATION_FAILED, UNAUTHORIZED, FORBIDDEN, NOT_FOUND, CONFLICT,
+			// CLUSTER_NOT_FOUND, NAMESPACE_NOT_FOUND, NAMESPACE_ALREADY_EXISTS, CATALOG_NOT_FOUND, PACKAGE_NOT_FOUND,
+			// RELEASE_NOT_FOUND, RELEASE_ALREADY_EXISTS, RELEASE_CONFLICT, RELEASE_INVALID, REVISION_NOT_FOUND, RESOURCE_SUSPENDED, GIT_REPO_NOT_FOUND, GIT_PUSH_REJECTED,
+			// GIT_AUTH_FAILED, GIT_ERROR, KUBERNETES_ERROR, REGISTRY_UNAUTHORIZED, REGISTRY_DENIED, REGISTRY_NOT_FOUND, REGISTRY_ERROR,
+			// AUDIT_NOT_ENABLED, CONTINUE_EXPIRED, PRECONDITION_FAILED, UNSUPPORTED_MEDIA_TYPE,
+			// PATCH_FAILED, WATCH_EXPIRED, TOO_MANY_REQUESTS, SERVICE_UNAVAILABLE, GATEWAY_TIMEOUT, INVALID_RESPONSE, INTERNAL_ERROR.
+			// Not set on success responses.
+			Code *string `json:"code,omitempty"`
+
+			// Details Field-level details of the error (validation errors)
+			Details *[]struct {
+				// Field Path of the invalid field
+				Field *string `json:"field,omitempty"`
+
+				// Message Human readable description of the error on the field
+				Message string `json:"message"`
+
+				// Reason Machine-readable reason of the error on the field
+				Reason *string `json:"reason,omitempty"`
+			} `json:"details,omitempty"`
+
+			// Message The response message from the server
+			Message string `json:"message"`
+
+			// RequestId The correlation ID of the request (X-Request-ID header)
+			RequestId *string `json:"requestId,omitempty"`
+
+			// Status The HTTP status code
+			Status int `json:"status"`
+
+			// Type The type of the server response
+			Type UpgradeSkippedErrorType `json:"type"`
+		} `json:"error"`
+
+		// GitRepo Fluxcd kustomization (namespace/name) of the skipped git repo, none when the whole cluster is skipped
+		GitRepo *string `json:"gitRepo,omitempty"`
+	} `json:"skipped,omitempty"`
+
+	// Target Where the releases are upgraded
+	Target UpgradeTarget `json:"target"`
+
+	// Version Package version the releases are upgraded to
+	Version string `json:"version"`
+}
+
+// UpgradeReleasesErrorType The type of the server response
+type UpgradeReleasesErrorType string
+
+// UpgradeReleasesStatus Status of the upgrade of the release:
+//   - Planned: the release would be upgraded (dry run)
+//   - Upgraded: the release is upgraded
+//   - Invalid: the parameters of the release do not match the schema of the new version, the release is not upgraded
+//   - Failed: the upgrade of the release failed
+type UpgradeReleasesStatus string
+
+// UpgradeSkippedErrorType The type of the server response
+type UpgradeSkippedErrorType string
+
+// UpgradeTarget Where the releases are upgraded
+type UpgradeTarget string
+
+// UpgradeRequest defines model for UpgradeRequest.
+type UpgradeRequest struct {
+	// ClusterId Restrict the upgrade to a Kubernetes cluster ID, all the clusters by default
+	ClusterId *string `json:"clusterId,omitempty"`
+
+	// LabelSelector Restrict the upgrade to the releases matching the label selector
+	LabelSelector *string `json:"labelSelector,omitempty"`
+
+	// Namespace Restrict the upgrade to the releases of a namespace
+	Namespace *string `json:"namespace,omitempty"`
+
+	// Package OCI repository of the package (spec.package.repository) of the releases to upgrade
+	Package string `json:"package"`
+
+	// Target Where the releases are upgraded:
+	//   - cluster: the releases deployed on the clusters are updated
+	//   - git: the releases of the git repos (fluxcd kustomizations) are updated, with a single commit per git repo
+	Target *UpgradeRequestTarget `json:"target,omitempty"`
+
+	// Version Package version (tag) to upgrade the releases to
+	Version string `json:"version"`
+}
+
+// UpgradeRequestTarget Where the releases are upgraded:
+//   - cluster: the releases deployed on the clusters are updated
+//   - git: the releases of the git repos (fluxcd kustomizations) are updated, with a single commit per git repo
+type UpgradeRequestTarget string
+
+// UpgradeSkip defines model for UpgradeSkip.
+type UpgradeSkip struct {
+	// ClusterId Kubernetes cluster ID
+	ClusterId string `json:"clusterId"`
+	Error     struct {
+		// Code Stable machine-readable error code, for example: BAD_REQUEST, VALIDATION_FAILED, UNAUTHORIZED, FORBIDDEN, NOT_FOUND, CONFLICT,
+		// CLUSTER_NOT_FOUND, NAMESPACE_NOT_FOUND, NAMESPACE_ALREADY_EXISTS, CATALOG_NOT_FOUND, PACKAGE_NOT_FOUND,
+		// RELEASE_NOT_FOUND, RELEASE_ALREADY_EXISTS, RELEASE_CONFLICT, RELEASE_INVALID, REVISION_NOT_FOUND, RESOURCE_SUSPENDED, GIT_REPO_NOT_FOUND, GIT_PUSH_REJECTED,
+		// GIT_AUTH_FAILED, GIT_ERROR, KUBERNETES_ERROR, REGISTRY_UNAUTHORIZED, REGISTRY_DENIED, REGISTRY_NOT_FOUND, REGISTRY_ERROR,
+		// AUDIT_NOT_ENABLED, CONTINUE_EXPIRED, PRECONDITION_FAILED, UNSUPPORTED_MEDIA_TYPE,
+		// PATCH_FAILED, WATCH_EXPIRED, TOO_MANY_REQUESTS, SERVICE_UNAVAILABLE, GATEWAY_TIMEOUT, INVALID_RESPONSE, INTERNAL_ERROR.
+		// Not set on success responses.
+		Code *string `json:"code,omitempty"`
+
+		// Details Field-level details of the error (validation errors)
+		Details *[]struct {
+			// Field Path of the invalid field
+			Field *string `json:"field,omitempty"`
+
+			// Message Human readable description of the error on the field
+			Message string `json:"message"`
+
+			// Reason Machine-readable reason of the error on the field
+			Reason *string `json:"reason,omitempty"`
+		} `json:"details,omitempty"`
+
+		// Message The response message from the server
+		Message string `json:"message"`
+
+		// RequestId The correlation ID of the request (X-Request-ID header)
+		RequestId *string `json:"requestId,omitempty"`
+
+		// Status The HTTP status code
+		Status int `json:"status"`
+
+		// Type The type of the server response
+		Type UpgradeSkipErrorType `json:"type"`
+	} `json:"error"`
+
+	// GitRepo Fluxcd kustomization (namespace/name) of the skipped git repo, none when the whole cluster is skipped
+	GitRepo *string `json:"gitRepo,omitempty"`
+}
+
+// UpgradeSkipErrorType The type of the server response
+type UpgradeSkipErrorType string
+
+// UserProfile defines model for UserProfile.
+type UserProfile struct {
+	Email   string   `json:"email"`
+	Groups  []string `json:"groups"`
+	Login   string   `json:"login"`
+	Name    string   `json:"name"`
+	Roles   []string `json:"roles"`
+	Subject string   `json:"sub"`
+}
+
+// WatchEvent Notification pushed by the watch endpoints as the data of a Server-Sent Event.
+// The SSE 'event' field is the type of the notification and the SSE 'id' field is the resource version.
+type WatchEvent struct {
+	// Object The added, modified or deleted object, or the ServerResponse of an ERROR
+	Object *map[string]interface{} `json:"object,omitempty"`
+
+	// ResourceVersion The resource version of the object, to be used to resume the watch
+	ResourceVersion *string `json:"resourceVersion,omitempty"`
+
+	// Type The type of the notification. BOOKMARK only carries the resource version to resume from,
+	// ERROR carries a ServerResponse and ends the stream.
+	Type WatchEventType `json:"type"`
+}
+
+// WatchEventType The type of the notification. BOOKMARK only carries the resource version to resume from,
+// ERROR carries a ServerResponse and ends the stream.
+type WatchEventType string
+
+// WorkloadRef The controlling workload of a pod (Deployment, StatefulSet, DaemonSet, Job, CronJob, ...)
+type WorkloadRef struct {
+	// Kind Kind of the workload
+	Kind string `json:"kind"`
+
+	// Name Name of the workload
+	Name string `json:"name"`
+}
+
+// AdminListK8sReleasesParams defines parameters for AdminListK8sReleases.
+type AdminListK8sReleasesParams struct {
+	// Limit Maximum number of items to return. All the items are returned when not set.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Continue Opaque cursor returned in the X-Continue header of the previous page
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+
+	// LabelSelector Kubernetes label selector (ex. app=podinfo,tier!=frontend)
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+
+	// Search Case insensitive text search on the name and description of the items
+	Search *string `form:"search,omitempty" json:"search,omitempty"`
+
+	// Status Filter by release phase (READY, ERROR, WAIT_OCI, WAIT_REPO, WAIT_HREL, WAIT_DEPS, SUSPENDED)
+	Status *string `form:"status,omitempty" json:"status,omitempty"`
+
+	// Package Filter by package repository (case insensitive, partial match)
+	Package *string `form:"package,omitempty" json:"package,omitempty"`
+
+	// Tag Filter by package tag (version): an exact tag, or a semantic version constraint (e.g. 1.x, ">=1.2, <2").
+	// The constraints are checked against the version without the package revision suffix (6.7.1 for 6.7.1-p01).
+	Tag *string `form:"tag,omitempty" json:"tag,omitempty"`
+
+	// Sort Sort key, prefixed by '-' for a descending order
+	Sort *AdminListK8sReleasesParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// AdminListK8sReleasesParamsSort defines parameters for AdminListK8sReleases.
+type AdminListK8sReleasesParamsSort string
+
+// AdminListGitSourcesParams defines parameters for AdminListGitSources.
+type AdminListGitSourcesParams struct {
+	// ClusterId Restrict the result to a Kubernetes cluster ID
+	ClusterId *string `form:"clusterId,omitempty" json:"clusterId,omitempty"`
+
+	// Limit Maximum number of items to return. All the items are returned when not set.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Continue Opaque cursor returned in the X-Continue header of the previous page
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+
+	// Search Case insensitive text search on the name and description of the items
+	Search *string `form:"search,omitempty" json:"search,omitempty"`
+
+	// Status Filter by synchronization state (Ready, NotReady)
+	Status *string `form:"status,omitempty" json:"status,omitempty"`
+
+	// Sort Sort key, prefixed by '-' for a descending order
+	Sort *AdminListGitSourcesParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// AdminListGitSourcesParamsSort defines parameters for AdminListGitSources.
+type AdminListGitSourcesParamsSort string
+
+// AdminListProjectsParams defines parameters for AdminListProjects.
+type AdminListProjectsParams struct {
+	// ClusterId Restrict the result to a Kubernetes cluster ID
+	ClusterId *string `form:"clusterId,omitempty" json:"clusterId,omitempty"`
+
+	// Limit Maximum number of items to return. All the items are returned when not set.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Continue Opaque cursor returned in the X-Continue header of the previous page
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+
+	// LabelSelector Kubernetes label selector (ex. app=podinfo,tier!=frontend)
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+
+	// Search Case insensitive text search on the name and description of the items
+	Search *string `form:"search,omitempty" json:"search,omitempty"`
+
+	// Status Filter by namespace phase (Active, Terminating)
+	Status *string `form:"status,omitempty" json:"status,omitempty"`
+
+	// Sort Sort key, prefixed by '-' for a descending order
+	Sort *AdminListProjectsParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// AdminListProjectsParamsSort defines parameters for AdminListProjects.
+type AdminListProjectsParamsSort string
+
+// AdminListReleasesParams defines parameters for AdminListReleases.
+type AdminListReleasesParams struct {
+	// ClusterId Restrict the result to a Kubernetes cluster ID
+	ClusterId *string `form:"clusterId,omitempty" json:"clusterId,omitempty"`
+
+	// Limit Maximum number of items to return. All the items are returned when not set.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Continue Opaque cursor returned in the X-Continue header of the previous page
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+
+	// LabelSelector Kubernetes label selector (ex. app=podinfo,tier!=frontend)
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+
+	// Search Case insensitive text search on the name and description of the items
+	Search *string `form:"search,omitempty" json:"search,omitempty"`
+
+	// Status Filter by release phase (READY, ERROR, WAIT_OCI, WAIT_REPO, WAIT_HREL, WAIT_DEPS, SUSPENDED)
+	Status *string `form:"status,omitempty" json:"status,omitempty"`
+
+	// Package Filter by package repository (case insensitive, partial match)
+	Package *string `form:"package,omitempty" json:"package,omitempty"`
+
+	// Tag Filter by package tag (version): an exact tag, or a semantic version constraint (e.g. 1.x, ">=1.2, <2").
+	// The constraints are checked against the version without the package revision suffix (6.7.1 for 6.7.1-p01).
+	Tag *string `form:"tag,omitempty" json:"tag,omitempty"`
+
+	// Sort Sort key, prefixed by '-' for a descending order
+	Sort *AdminListReleasesParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// AdminListReleasesParamsSort defines parameters for AdminListReleases.
+type AdminListReleasesParamsSort string
+
+// AdminUpgradeReleasesJSONBody defines parameters for AdminUpgradeReleases.
+type AdminUpgradeReleasesJSONBody struct {
+	// ClusterId Restrict the upgrade to a Kubernetes cluster ID, all the clusters by default
+	ClusterId *string `json:"clusterId,omitempty"`
+
+	// LabelSelector Restrict the upgrade to the releases matching the label selector
+	LabelSelector *string `json:"labelSelector,omitempty"`
+
+	// Namespace Restrict the upgrade to the releases of a namespace
+	Namespace *string `json:"namespace,omitempty"`
+
+	// Package OCI repository of the package (spec.package.repository) of the releases to upgrade
+	Package string `json:"package"`
+
+	// Target Where the releases are upgraded:
+	//   - cluster: the releases deployed on the clusters are updated
+	//   - git: the releases of the git repos (fluxcd kustomizations) are updated, with a single commit per git repo
+	Target *AdminUpgradeReleasesJSONBodyTarget `json:"target,omitempty"`
+
+	// Version Package version (tag) to upgrade the releases to
+	Version string `json:"version"`
+}
+
+// AdminUpgradeReleasesParams defines parameters for AdminUpgradeReleases.
+type AdminUpgradeReleasesParams struct {
+	// DryRun If true, lists the changes without applying them
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+}
+
+// AdminUpgradeReleasesJSONBodyTarget defines parameters for AdminUpgradeReleases.
+type AdminUpgradeReleasesJSONBodyTarget string
+
+// ListAuditEventsParams defines parameters for ListAuditEvents.
+type ListAuditEventsParams struct {
+	// User Filter by user subject, login or email
+	User *string `form:"user,omitempty" json:"user,omitempty"`
+
+	// ClusterId Filter by Kubernetes cluster ID
+	ClusterId *string `form:"clusterId,omitempty" json:"clusterId,omitempty"`
+
+	// Namespace Filter by Kubernetes namespace
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
 
-		// Usage Usage is the rendering of the Package.spec.usage[key]. Aimed to provide user information.
-		// Key could 'html', 'text', some language id, etc...
-		Usage *map[string]string `json:"usage,omitempty"`
-	} `json:"status,omitempty"`
-}
+	// Action Filter by action
+	Action *ListAuditEventsParamsAction `form:"action,omitempty" json:"action,omitempty"`
 
-// ReleaseSpecPackageProvider The source will be handled by a child fluxCD OciRepository resource, which will be created by this operator
-// All following fields will be replicated in this object
-// The provider used for authentication, can be 'aws', 'azure', 'gcp' or 'generic'.
-// When not specified, defaults to 'generic'.
-// -kubebuilder:default:=generic
-type ReleaseSpecPackageProvider string
+	// Outcome Filter by outcome
+	Outcome *ListAuditEventsParamsOutcome `form:"outcome,omitempty" json:"outcome,omitempty"`
 
-// ReleaseSpecPackageVerifyProvider Provider specifies the technology used to sign the OCI Artifact.
-type ReleaseSpecPackageVerifyProvider string
+	// From Only return the events recorded at or after this time
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
 
-// ReleaseInfo defines model for ReleaseInfo.
-type ReleaseInfo struct {
-	Description *string `json:"description,omitempty"`
-	Git         struct {
-		Path string `json:"path"`
-		URL  string `json:"url"`
-	} `json:"git"`
-	Name      string  `json:"name"`
-	Namespace *string `json:"namespace,omitempty"`
-	Package   struct {
-		Repository string `json:"repository"`
-		Tag        string `json:"tag"`
-	} `json:"package"`
+	// To Only return the events recorded at or before this time
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// Limit Maximum number of events to return, most recent first
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
-// ReleaseStatus ReleaseStatus defines the observed state of Release.
-// As we want Status to be explicit about provided information, we don't use 'omitempty' in its definition.
-// (Except for 'context', as controlled by a debug flag)
-type ReleaseStatus struct {
-	// Context Context is the resulting context, if requested in debug options
-	Context *interface{} `json:"context,omitempty"`
+// ListAuditEventsParamsAction defines parameters for ListAuditEvents.
+type ListAuditEventsParamsAction string
 
-	// Dependencies The result of the package template and release value
-	Dependencies *[]string `json:"dependencies,omitempty"`
+// ListAuditEventsParamsOutcome defines parameters for ListAuditEvents.
+type ListAuditEventsParamsOutcome string
 
-	// HelmReleaseStates HelmReleaseState describe the observed state of child HelmReleases by name
-	HelmReleaseStates *map[string]struct {
-		Ready  string  `json:"ready"`
-		Status *string `json:"status,omitempty"`
-	} `json:"helmReleaseStates,omitempty"`
-	MissingDependency *string `json:"missingDependency,omitempty"`
+// ListPackagesParams defines parameters for ListPackages.
+type ListPackagesParams struct {
+	// Limit Maximum number of items to return. All the items are returned when not set.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 
-	// Parameters Parameters is the resulting parameters set, if requested in debug options
-	Parameters *interface{} `json:"parameters,omitempty"`
-	Phase      *string      `json:"phase,omitempty"`
+	// Continue Opaque cursor returned in the X-Continue header of the previous page
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
 
-	// PrintContexts PrintContextsContexts is a string to list our context. Not technically used, but intended to be displayed
-	// as printcolumn
-	PrintContexts *string `json:"printContexts,omitempty"`
+	// Search Case insensitive text search on the name and description of the items
+	Search *string `form:"search,omitempty" json:"search,omitempty"`
 
-	// PrintDescription PrintDescription
-	// Copy of the release description, or, if empty the (templated) package one
-	PrintDescription *string `json:"printDescription,omitempty"`
+	// Version Only return the packages having this version
+	Version *string `form:"version,omitempty" json:"version,omitempty"`
 
-	// PrintProtected PrintProtected is a copy of Protected, with a Y/n flag. To be used in display
-	PrintProtected *string `json:"printProtected,omitempty"`
+	// Sort Sort key, prefixed by '-' for a descending order
+	Sort *ListPackagesParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+}
 
-	// Protected Protected result of Release.spec.protected defaulted to package.spec.protected
-	// It is the value checked by the webhook
-	Protected *bool `json:"protected,omitempty"`
+// ListPackagesParamsSort defines parameters for ListPackages.
+type ListPackagesParamsSort string
 
-	// ReadyReleases ReadyReleases is a string to display X/Y helmRelease ready. Not technically used, but intended to be displayed
-	// as printcolumn
-	ReadyReleases *string `json:"readyReleases,omitempty"`
+// GetPackageJSONSchemaParams defines parameters for GetPackageJSONSchema.
+type GetPackageJSONSchemaParams struct {
+	// Section Return only the JSON Schema document of this section
+	Section *GetPackageJSONSchemaParamsSection `form:"section,omitempty" json:"section,omitempty"`
+}
 
-	// Roles The result of the package template and release value
-	Roles *[]string `json:"roles,omitempty"`
+// GetPackageJSONSchemaParamsSection defines parameters for GetPackageJSONSchema.
+type GetPackageJSONSchemaParamsSection string
 
-	// Usage Usage is the rendering of the Package.spec.usage[key]. Aimed to provide user information.
-	// Key could 'html', 'text', some language id, etc...
-	Usage *map[string]string `json:"usage,omitempty"`
+// GetReleaseSkeletonParams defines parameters for GetReleaseSkeleton.
+type GetReleaseSkeletonParams struct {
+	// ReleaseName Name of the release, defaults to the package name
+	ReleaseName *string `form:"releaseName,omitempty" json:"releaseName,omitempty"`
+
+	// Namespace Namespace of the release
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+
+	// TargetNamespace Namespace the package is deployed to
+	TargetNamespace *string `form:"targetNamespace,omitempty" json:"targetNamespace,omitempty"`
 }
 
-// ServerResponse defines model for ServerResponse.
-type ServerResponse struct {
-	// Message The response message from the server
-	Message string `json:"message"`
+// ListNamespacesParams defines parameters for ListNamespaces.
+type ListNamespacesParams struct {
+	// Limit Maximum number of items to return. All the items are returned when not set.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 
-	// Status The HTTP status code
-	Status int `json:"status"`
+	// Continue Opaque cursor returned in the X-Continue header of the previous page
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
 
-	// Type The type of the server response
-	Type ServerResponseType `json:"type"`
-}
+	// LabelSelector Kubernetes label selector (ex. app=podinfo,tier!=frontend)
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
 
-// ServerResponseType The type of the server response
-type ServerResponseType string
+	// Search Case insensitive text search on the name and description of the items
+	Search *string `form:"search,omitempty" json:"search,omitempty"`
 
-// UserProfile defines model for UserProfile.
-type UserProfile struct {
-	Email   string   `json:"email"`
-	Groups  []string `json:"groups"`
-	Login   string   `json:"login"`
-	Name    string   `json:"name"`
-	Roles   []string `json:"roles"`
-	Subject string   `json:"sub"`
+	// Status Filter by namespace phase (Active, Terminating)
+	Status *string `form:"status,omitempty" json:"status,omitempty"`
+
+	// Sort Sort key, prefixed by '-' for a descending order
+	Sort *ListNamespacesParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
 }
 
+// ListNamespacesParamsSort defines parameters for ListNamespaces.
+type ListNamespacesParamsSort string
+
 // CreateNamespaceJSONBody defines parameters for CreateNamespace.
 type CreateNamespaceJSONBody struct {
 	ApiVersion string                      `json:"apiVersion"`
@@ -710,6 +3470,9 @@ type CreateNamespaceJSONBody struct {
 		CreationTimestamp *time.Time         `json:"creationTimestamp,omitempty"`
 		Labels            *map[string]string `json:"labels,omitempty"`
 		Name              string             `json:"name"`
+
+		// ResourceVersion Version of the namespace, returned in the ETag header of the project
+		ResourceVersion *string `json:"resourceVersion,omitempty"`
 	} `json:"metadata"`
 	Status *struct {
 		Phase *CreateNamespaceJSONBodyStatusPhase `json:"phase,omitempty"`
@@ -731,6 +3494,9 @@ type UpdateNamespaceJSONBody struct {
 		CreationTimestamp *time.Time         `json:"creationTimestamp,omitempty"`
 		Labels            *map[string]string `json:"labels,omitempty"`
 		Name              string             `json:"name"`
+
+		// ResourceVersion Version of the namespace, returned in the ETag header of the project
+		ResourceVersion *string `json:"resourceVersion,omitempty"`
 	} `json:"metadata"`
 	Status *struct {
 		Phase *UpdateNamespaceJSONBodyStatusPhase `json:"phase,omitempty"`
@@ -743,8 +3509,50 @@ type UpdateNamespaceJSONBodyKind string
 // UpdateNamespaceJSONBodyStatusPhase defines parameters for UpdateNamespace.
 type UpdateNamespaceJSONBodyStatusPhase string
 
+// RunKustomizationActionParams defines parameters for RunKustomizationAction.
+type RunKustomizationActionParams struct {
+	// Wait If true, wait for the resource to be reconciled (Ready or failed) and return the outcome
+	Wait *bool `form:"wait,omitempty" json:"wait,omitempty"`
+
+	// WaitTimeout Maximum time in seconds to wait for the reconciliation, when wait is true
+	WaitTimeout *int `form:"waitTimeout,omitempty" json:"waitTimeout,omitempty"`
+}
+
+// RunKustomizationActionParamsAction defines parameters for RunKustomizationAction.
+type RunKustomizationActionParamsAction string
+
+// ListGitReleasesParams defines parameters for ListGitReleases.
+type ListGitReleasesParams struct {
+	// Limit Maximum number of items to return. All the items are returned when not set.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Continue Opaque cursor returned in the X-Continue header of the previous page
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+
+	// Search Case insensitive text search on the name and description of the items
+	Search *string `form:"search,omitempty" json:"search,omitempty"`
+
+	// Package Filter by package repository (case insensitive, partial match)
+	Package *string `form:"package,omitempty" json:"package,omitempty"`
+
+	// Tag Filter by package tag (version): an exact tag, or a semantic version constraint (e.g. 1.x, ">=1.2, <2").
+	// The constraints are checked against the version without the package revision suffix (6.7.1 for 6.7.1-p01).
+	Tag *string `form:"tag,omitempty" json:"tag,omitempty"`
+
+	// Sort Sort key, prefixed by '-' for a descending order
+	Sort *ListGitReleasesParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// ListGitReleasesParamsSort defines parameters for ListGitReleases.
+type ListGitReleasesParamsSort string
+
 // CreateGitReleaseJSONBody defines parameters for CreateGitRelease.
 type CreateGitReleaseJSONBody struct {
+	union json.RawMessage
+}
+
+// CreateGitReleaseJSONBody1 defines parameters for CreateGitRelease.
+type CreateGitReleaseJSONBody1 = []struct {
 	// ApiVersion APIVersion defines the versioned schema of this representation of an object.
 	// Servers should convert recognized schemas to the latest internal value, and
 	// may reject unrecognized values.
@@ -888,7 +3696,7 @@ type CreateGitReleaseJSONBody struct {
 			// The provider used for authentication, can be 'aws', 'azure', 'gcp' or 'generic'.
 			// When not specified, defaults to 'generic'.
 			// -kubebuilder:default:=generic
-			Provider *CreateGitReleaseJSONBodySpecPackageProvider `json:"provider,omitempty"`
+			Provider *CreateGitReleaseJSONBody1SpecPackageProvider `json:"provider,omitempty"`
 
 			// ProxySecretRef ProxySecretRef specifies the Secret containing the proxy configuration
 			// to use while communicating with the container registry.
@@ -943,7 +3751,7 @@ type CreateGitReleaseJSONBody struct {
 				} `json:"matchOIDCIdentity,omitempty"`
 
 				// Provider Provider specifies the technology used to sign the OCI Artifact.
-				Provider CreateGitReleaseJSONBodySpecPackageVerifyProvider `json:"provider"`
+				Provider CreateGitReleaseJSONBody1SpecPackageVerifyProvider `json:"provider"`
 
 				// SecretRef SecretRef specifies the Kubernetes Secret containing the
 				// trusted public keys.
@@ -1033,11 +3841,11 @@ type CreateGitReleaseJSONBody struct {
 	} `json:"status,omitempty"`
 }
 
-// CreateGitReleaseJSONBodySpecPackageProvider defines parameters for CreateGitRelease.
-type CreateGitReleaseJSONBodySpecPackageProvider string
+// CreateGitReleaseJSONBody1SpecPackageProvider defines parameters for CreateGitRelease.
+type CreateGitReleaseJSONBody1SpecPackageProvider string
 
-// CreateGitReleaseJSONBodySpecPackageVerifyProvider defines parameters for CreateGitRelease.
-type CreateGitReleaseJSONBodySpecPackageVerifyProvider string
+// CreateGitReleaseJSONBody1SpecPackageVerifyProvider defines parameters for CreateGitRelease.
+type CreateGitReleaseJSONBody1SpecPackageVerifyProvider string
 
 // UpdateGitReleaseJSONBody defines parameters for UpdateGitRelease.
 type UpdateGitReleaseJSONBody struct {
@@ -1329,12 +4137,86 @@ type UpdateGitReleaseJSONBody struct {
 	} `json:"status,omitempty"`
 }
 
+// UpdateGitReleaseParams defines parameters for UpdateGitRelease.
+type UpdateGitReleaseParams struct {
+	// IfMatch ETag returned by the GET of the resource. The modification is rejected with 412 (Precondition Failed)
+	// if the resource changed in the meantime. '*' only requires the resource to exist. A list of ETags is
+	// rejected with 400 (Bad Request).
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
 // UpdateGitReleaseJSONBodySpecPackageProvider defines parameters for UpdateGitRelease.
 type UpdateGitReleaseJSONBodySpecPackageProvider string
 
 // UpdateGitReleaseJSONBodySpecPackageVerifyProvider defines parameters for UpdateGitRelease.
 type UpdateGitReleaseJSONBodySpecPackageVerifyProvider string
 
+// DeleteGitReleaseParams defines parameters for DeleteGitRelease.
+type DeleteGitReleaseParams struct {
+	// IfMatch ETag returned by the GET of the resource. The modification is rejected with 412 (Precondition Failed)
+	// if the resource changed in the meantime. '*' only requires the resource to exist. A list of ETags is
+	// rejected with 400 (Bad Request).
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// PatchGitReleaseApplicationJSONPatchPlusJSONBody defines parameters for PatchGitRelease.
+type PatchGitReleaseApplicationJSONPatchPlusJSONBody = []struct {
+	// From JSON Pointer to the source location of the move and copy operations
+	From *string `json:"from,omitempty"`
+
+	// Op The operation to perform
+	Op PatchGitReleaseApplicationJSONPatchPlusJSONBodyOp `json:"op"`
+
+	// Path JSON Pointer (RFC 6901) to the target location
+	Path string `json:"path"`
+
+	// Value The value of the add, replace and test operations
+	Value *interface{} `json:"value,omitempty"`
+}
+
+// PatchGitReleaseApplicationMergePatchPlusJSONBody defines parameters for PatchGitRelease.
+type PatchGitReleaseApplicationMergePatchPlusJSONBody = map[string]interface{}
+
+// PatchGitReleaseParams defines parameters for PatchGitRelease.
+type PatchGitReleaseParams struct {
+	// DryRun If true, returns the patched release without committing it
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+
+	// IfMatch ETag returned by the GET of the resource. The modification is rejected with 412 (Precondition Failed)
+	// if the resource changed in the meantime. '*' only requires the resource to exist. A list of ETags is
+	// rejected with 400 (Bad Request).
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// PatchGitReleaseApplicationJSONPatchPlusJSONBodyOp defines parameters for PatchGitRelease.
+type PatchGitReleaseApplicationJSONPatchPlusJSONBodyOp string
+
+// RollbackGitReleaseParams defines parameters for RollbackGitRelease.
+type RollbackGitReleaseParams struct {
+	// Revision Revision of the release to roll back to (see the history of the release)
+	Revision int `form:"revision" json:"revision"`
+
+	// DryRun If true, returns the release of the revision without committing it
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+
+	// IfMatch ETag returned by the GET of the resource. The modification is rejected with 412 (Precondition Failed)
+	// if the resource changed in the meantime. '*' only requires the resource to exist. A list of ETags is
+	// rejected with 400 (Bad Request).
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// RunGitSourceActionParams defines parameters for RunGitSourceAction.
+type RunGitSourceActionParams struct {
+	// Wait If true, wait for the resource to be reconciled (Ready or failed) and return the outcome
+	Wait *bool `form:"wait,omitempty" json:"wait,omitempty"`
+
+	// WaitTimeout Maximum time in seconds to wait for the reconciliation, when wait is true
+	WaitTimeout *int `form:"waitTimeout,omitempty" json:"waitTimeout,omitempty"`
+}
+
+// RunGitSourceActionParamsAction defines parameters for RunGitSourceAction.
+type RunGitSourceActionParamsAction string
+
 // GetLogsParams defines parameters for GetLogs.
 type GetLogsParams struct {
 	// Download If true, downloads logs as a plain text file instead of streaming.
@@ -1344,8 +4226,50 @@ type GetLogsParams struct {
 	TailLines *int `form:"tailLines,omitempty" json:"tailLines,omitempty"`
 }
 
+// ListK8sReleasesParams defines parameters for ListK8sReleases.
+type ListK8sReleasesParams struct {
+	// Limit Maximum number of items to return. All the items are returned when not set.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Continue Opaque cursor returned in the X-Continue header of the previous page
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+
+	// LabelSelector Kubernetes label selector (ex. app=podinfo,tier!=frontend)
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+
+	// Search Case insensitive text search on the name and description of the items
+	Search *string `form:"search,omitempty" json:"search,omitempty"`
+
+	// Status Filter by release phase (READY, ERROR, WAIT_OCI, WAIT_REPO, WAIT_HREL, WAIT_DEPS, SUSPENDED)
+	Status *string `form:"status,omitempty" json:"status,omitempty"`
+
+	// Package Filter by package repository (case insensitive, partial match)
+	Package *string `form:"package,omitempty" json:"package,omitempty"`
+
+	// Tag Filter by package tag (version): an exact tag, or a semantic version constraint (e.g. 1.x, ">=1.2, <2").
+	// The constraints are checked against the version without the package revision suffix (6.7.1 for 6.7.1-p01).
+	Tag *string `form:"tag,omitempty" json:"tag,omitempty"`
+
+	// Sort Sort key, prefixed by '-' for a descending order
+	Sort *ListK8sReleasesParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// ListK8sReleasesParamsSort defines parameters for ListK8sReleases.
+type ListK8sReleasesParamsSort string
+
 // CreateK8sReleaseJSONBody defines parameters for CreateK8sRelease.
 type CreateK8sReleaseJSONBody struct {
+	union json.RawMessage
+}
+
+// CreateK8sReleaseParams defines parameters for CreateK8sRelease.
+type CreateK8sReleaseParams struct {
+	// DryRun If true, performs a server-side dry run without persisting the resource
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+}
+
+// CreateK8sReleaseJSONBody1 defines parameters for CreateK8sRelease.
+type CreateK8sReleaseJSONBody1 = []struct {
 	// ApiVersion APIVersion defines the versioned schema of this representation of an object.
 	// Servers should convert recognized schemas to the latest internal value, and
 	// may reject unrecognized values.
@@ -1489,7 +4413,7 @@ type CreateK8sReleaseJSONBody struct {
 			// The provider used for authentication, can be 'aws', 'azure', 'gcp' or 'generic'.
 			// When not specified, defaults to 'generic'.
 			// -kubebuilder:default:=generic
-			Provider *CreateK8sReleaseJSONBodySpecPackageProvider `json:"provider,omitempty"`
+			Provider *CreateK8sReleaseJSONBody1SpecPackageProvider `json:"provider,omitempty"`
 
 			// ProxySecretRef ProxySecretRef specifies the Secret containing the proxy configuration
 			// to use while communicating with the container registry.
@@ -1544,7 +4468,7 @@ type CreateK8sReleaseJSONBody struct {
 				} `json:"matchOIDCIdentity,omitempty"`
 
 				// Provider Provider specifies the technology used to sign the OCI Artifact.
-				Provider CreateK8sReleaseJSONBodySpecPackageVerifyProvider `json:"provider"`
+				Provider CreateK8sReleaseJSONBody1SpecPackageVerifyProvider `json:"provider"`
 
 				// SecretRef SecretRef specifies the Kubernetes Secret containing the
 				// trusted public keys.
@@ -1634,17 +4558,11 @@ type CreateK8sReleaseJSONBody struct {
 	} `json:"status,omitempty"`
 }
 
-// CreateK8sReleaseParams defines parameters for CreateK8sRelease.
-type CreateK8sReleaseParams struct {
-	// DryRun If true, performs a server-side dry run without persisting the resource
-	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
-}
-
-// CreateK8sReleaseJSONBodySpecPackageProvider defines parameters for CreateK8sRelease.
-type CreateK8sReleaseJSONBodySpecPackageProvider string
+// CreateK8sReleaseJSONBody1SpecPackageProvider defines parameters for CreateK8sRelease.
+type CreateK8sReleaseJSONBody1SpecPackageProvider string
 
-// CreateK8sReleaseJSONBodySpecPackageVerifyProvider defines parameters for CreateK8sRelease.
-type CreateK8sReleaseJSONBodySpecPackageVerifyProvider string
+// CreateK8sReleaseJSONBody1SpecPackageVerifyProvider defines parameters for CreateK8sRelease.
+type CreateK8sReleaseJSONBody1SpecPackageVerifyProvider string
 
 // UpdateK8sReleaseJSONBody defines parameters for UpdateK8sRelease.
 type UpdateK8sReleaseJSONBody struct {
@@ -1940,6 +4858,11 @@ type UpdateK8sReleaseJSONBody struct {
 type UpdateK8sReleaseParams struct {
 	// DryRun If true, performs a server-side dry run without persisting the resource
 	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+
+	// IfMatch ETag returned by the GET of the resource. The modification is rejected with 412 (Precondition Failed)
+	// if the resource changed in the meantime. '*' only requires the resource to exist. A list of ETags is
+	// rejected with 400 (Bad Request).
+	IfMatch *string `json:"If-Match,omitempty"`
 }
 
 // UpdateK8sReleaseJSONBodySpecPackageProvider defines parameters for UpdateK8sRelease.
@@ -1948,6 +4871,168 @@ type UpdateK8sReleaseJSONBodySpecPackageProvider string
 // UpdateK8sReleaseJSONBodySpecPackageVerifyProvider defines parameters for UpdateK8sRelease.
 type UpdateK8sReleaseJSONBodySpecPackageVerifyProvider string
 
+// DeleteK8sReleaseParams defines parameters for DeleteK8sRelease.
+type DeleteK8sReleaseParams struct {
+	// IfMatch ETag returned by the GET of the resource. The modification is rejected with 412 (Precondition Failed)
+	// if the resource changed in the meantime. '*' only requires the resource to exist. A list of ETags is
+	// rejected with 400 (Bad Request).
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// PatchK8sReleaseApplicationJSONPatchPlusJSONBody defines parameters for PatchK8sRelease.
+type PatchK8sReleaseApplicationJSONPatchPlusJSONBody = []struct {
+	// From JSON Pointer to the source location of the move and copy operations
+	From *string `json:"from,omitempty"`
+
+	// Op The operation to perform
+	Op PatchK8sReleaseApplicationJSONPatchPlusJSONBodyOp `json:"op"`
+
+	// Path JSON Pointer (RFC 6901) to the target location
+	Path string `json:"path"`
+
+	// Value The value of the add, replace and test operations
+	Value *interface{} `json:"value,omitempty"`
+}
+
+// PatchK8sReleaseApplicationMergePatchPlusJSONBody defines parameters for PatchK8sRelease.
+type PatchK8sReleaseApplicationMergePatchPlusJSONBody = map[string]interface{}
+
+// PatchK8sReleaseParams defines parameters for PatchK8sRelease.
+type PatchK8sReleaseParams struct {
+	// DryRun If true, performs a server-side dry run without persisting the resource
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+
+	// IfMatch ETag returned by the GET of the resource. The modification is rejected with 412 (Precondition Failed)
+	// if the resource changed in the meantime. '*' only requires the resource to exist. A list of ETags is
+	// rejected with 400 (Bad Request).
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// PatchK8sReleaseApplicationJSONPatchPlusJSONBodyOp defines parameters for PatchK8sRelease.
+type PatchK8sReleaseApplicationJSONPatchPlusJSONBodyOp string
+
+// RunK8sReleaseActionParams defines parameters for RunK8sReleaseAction.
+type RunK8sReleaseActionParams struct {
+	// Wait If true, wait for the resource to be reconciled (Ready or failed) and return the outcome
+	Wait *bool `form:"wait,omitempty" json:"wait,omitempty"`
+
+	// WaitTimeout Maximum time in seconds to wait for the reconciliation, when wait is true
+	WaitTimeout *int `form:"waitTimeout,omitempty" json:"waitTimeout,omitempty"`
+}
+
+// RunK8sReleaseActionParamsAction defines parameters for RunK8sReleaseAction.
+type RunK8sReleaseActionParamsAction string
+
+// GetEventsReleaseParams defines parameters for GetEventsRelease.
+type GetEventsReleaseParams struct {
+	// Limit Maximum number of items to return. All the items are returned when not set.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Continue Opaque cursor returned in the X-Continue header of the previous page
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+
+	// Search Case insensitive text search on the name and description of the items
+	Search *string `form:"search,omitempty" json:"search,omitempty"`
+
+	// Type Filter by event type
+	Type *GetEventsReleaseParamsType `form:"type,omitempty" json:"type,omitempty"`
+
+	// Sort Sort key, prefixed by '-' for a descending order
+	Sort *GetEventsReleaseParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// GetEventsReleaseParamsType defines parameters for GetEventsRelease.
+type GetEventsReleaseParamsType string
+
+// GetEventsReleaseParamsSort defines parameters for GetEventsRelease.
+type GetEventsReleaseParamsSort string
+
+// ExecPodParams defines parameters for ExecPod.
+type ExecPodParams struct {
+	// Command The command to run and its arguments, one query parameter per argument.
+	Command *[]string `form:"command,omitempty" json:"command,omitempty"`
+
+	// Tty If true, allocates a TTY. The stderr is then merged into the stdout.
+	Tty *bool `form:"tty,omitempty" json:"tty,omitempty"`
+}
+
+// RollbackK8sReleaseParams defines parameters for RollbackK8sRelease.
+type RollbackK8sReleaseParams struct {
+	// Revision Revision of the release to roll back to (see the history of the release)
+	Revision int `form:"revision" json:"revision"`
+
+	// DryRun If true, performs a server-side dry run without persisting the resource
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+
+	// IfMatch ETag returned by the GET of the resource. The modification is rejected with 412 (Precondition Failed)
+	// if the resource changed in the meantime. '*' only requires the resource to exist. A list of ETags is
+	// rejected with 400 (Bad Request).
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// WatchK8sReleasesParams defines parameters for WatchK8sReleases.
+type WatchK8sReleasesParams struct {
+	// ResourceVersion Resource version to resume the watch from. When not set, the current objects are first notified as ADDED.
+	// The 'Last-Event-ID' header, sent by the SSE clients on reconnection, takes precedence.
+	ResourceVersion *string `form:"resourceVersion,omitempty" json:"resourceVersion,omitempty"`
+
+	// Heartbeat Interval in seconds between two heartbeats (SSE comments) keeping the connection alive
+	Heartbeat *int `form:"heartbeat,omitempty" json:"heartbeat,omitempty"`
+
+	// LastEventID Id (resource version) of the last received event, used to resume the watch on reconnection
+	LastEventID *string `json:"Last-Event-ID,omitempty"`
+}
+
+// WatchEventsReleaseParams defines parameters for WatchEventsRelease.
+type WatchEventsReleaseParams struct {
+	// ResourceVersion Resource version to resume the watch from. When not set, the current objects are first notified as ADDED.
+	// The 'Last-Event-ID' header, sent by the SSE clients on reconnection, takes precedence.
+	ResourceVersion *string `form:"resourceVersion,omitempty" json:"resourceVersion,omitempty"`
+
+	// Heartbeat Interval in seconds between two heartbeats (SSE comments) keeping the connection alive
+	Heartbeat *int `form:"heartbeat,omitempty" json:"heartbeat,omitempty"`
+
+	// LastEventID Id (resource version) of the last received event, used to resume the watch on reconnection
+	LastEventID *string `json:"Last-Event-ID,omitempty"`
+}
+
+// WatchPodsParams defines parameters for WatchPods.
+type WatchPodsParams struct {
+	// ResourceVersion Resource version to resume the watch from. When not set, the current objects are first notified as ADDED.
+	// The 'Last-Event-ID' header, sent by the SSE clients on reconnection, takes precedence.
+	ResourceVersion *string `form:"resourceVersion,omitempty" json:"resourceVersion,omitempty"`
+
+	// Heartbeat Interval in seconds between two heartbeats (SSE comments) keeping the connection alive
+	Heartbeat *int `form:"heartbeat,omitempty" json:"heartbeat,omitempty"`
+
+	// LastEventID Id (resource version) of the last received event, used to resume the watch on reconnection
+	LastEventID *string `json:"Last-Event-ID,omitempty"`
+}
+
+// ListProjectsParams defines parameters for ListProjects.
+type ListProjectsParams struct {
+	// Limit Maximum number of items to return. All the items are returned when not set.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Continue Opaque cursor returned in the X-Continue header of the previous page
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+
+	// LabelSelector Kubernetes label selector (ex. app=podinfo,tier!=frontend)
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+
+	// Search Case insensitive text search on the name and description of the items
+	Search *string `form:"search,omitempty" json:"search,omitempty"`
+
+	// Status Filter by namespace phase (Active, Terminating)
+	Status *string `form:"status,omitempty" json:"status,omitempty"`
+
+	// Sort Sort key, prefixed by '-' for a descending order
+	Sort *ListProjectsParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// ListProjectsParamsSort defines parameters for ListProjects.
+type ListProjectsParamsSort string
+
 // CreateProjectJSONBody defines parameters for CreateProject.
 type CreateProjectJSONBody struct {
 	CreationTimestamp *time.Time                   `json:"creationTimestamp,omitempty"`
@@ -1971,9 +5056,123 @@ type UpdateProjectJSONBody struct {
 	Status            *UpdateProjectJSONBodyStatus `json:"status,omitempty"`
 }
 
+// UpdateProjectParams defines parameters for UpdateProject.
+type UpdateProjectParams struct {
+	// IfMatch ETag returned by the GET of the resource. The modification is rejected with 412 (Precondition Failed)
+	// if the resource changed in the meantime. '*' only requires the resource to exist. A list of ETags is
+	// rejected with 400 (Bad Request).
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
 // UpdateProjectJSONBodyStatus defines parameters for UpdateProject.
 type UpdateProjectJSONBodyStatus string
 
+// DeleteProjectParams defines parameters for DeleteProject.
+type DeleteProjectParams struct {
+	// IfMatch ETag returned by the GET of the resource. The modification is rejected with 412 (Precondition Failed)
+	// if the resource changed in the meantime. '*' only requires the resource to exist. A list of ETags is
+	// rejected with 400 (Bad Request).
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// PatchProjectApplicationJSONPatchPlusJSONBody defines parameters for PatchProject.
+type PatchProjectApplicationJSONPatchPlusJSONBody = []struct {
+	// From JSON Pointer to the source location of the move and copy operations
+	From *string `json:"from,omitempty"`
+
+	// Op The operation to perform
+	Op PatchProjectApplicationJSONPatchPlusJSONBodyOp `json:"op"`
+
+	// Path JSON Pointer (RFC 6901) to the target location
+	Path string `json:"path"`
+
+	// Value The value of the add, replace and test operations
+	Value *interface{} `json:"value,omitempty"`
+}
+
+// PatchProjectApplicationMergePatchPlusJSONBody defines parameters for PatchProject.
+type PatchProjectApplicationMergePatchPlusJSONBody = map[string]interface{}
+
+// PatchProjectParams defines parameters for PatchProject.
+type PatchProjectParams struct {
+	// DryRun If true, returns the patched project without persisting it
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+
+	// IfMatch ETag returned by the GET of the resource. The modification is rejected with 412 (Precondition Failed)
+	// if the resource changed in the meantime. '*' only requires the resource to exist. A list of ETags is
+	// rejected with 400 (Bad Request).
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// PatchProjectApplicationJSONPatchPlusJSONBodyOp defines parameters for PatchProject.
+type PatchProjectApplicationJSONPatchPlusJSONBodyOp string
+
+// GetProjectsInventoryParams defines parameters for GetProjectsInventory.
+type GetProjectsInventoryParams struct {
+	// Env Restrict the result to the clusters of an environment
+	Env *string `form:"env,omitempty" json:"env,omitempty"`
+
+	// Package Filter by package repository (case insensitive, partial match)
+	Package *string `form:"package,omitempty" json:"package,omitempty"`
+
+	// Tag Filter by package tag (version): an exact tag, or a semantic version constraint (e.g. 1.x, ">=1.2, <2").
+	// The constraints are checked against the version without the package revision suffix (6.7.1 for 6.7.1-p01).
+	Tag *string `form:"tag,omitempty" json:"tag,omitempty"`
+
+	// LabelSelector Kubernetes label selector (ex. app=podinfo,tier!=frontend)
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+
+	// Search Case insensitive text search on the name and description of the items
+	Search *string `form:"search,omitempty" json:"search,omitempty"`
+
+	// Timeout Timeout in seconds of the requests to each cluster, the clusters that do not answer in time are reported as failed
+	Timeout *int `form:"timeout,omitempty" json:"timeout,omitempty"`
+}
+
+// GetReleasesInventoryParams defines parameters for GetReleasesInventory.
+type GetReleasesInventoryParams struct {
+	// Env Restrict the result to the clusters of an environment
+	Env *string `form:"env,omitempty" json:"env,omitempty"`
+
+	// Package Filter by package repository (case insensitive, partial match)
+	Package *string `form:"package,omitempty" json:"package,omitempty"`
+
+	// Tag Filter by package tag (version): an exact tag, or a semantic version constraint (e.g. 1.x, ">=1.2, <2").
+	// The constraints are checked against the version without the package revision suffix (6.7.1 for 6.7.1-p01).
+	Tag *string `form:"tag,omitempty" json:"tag,omitempty"`
+
+	// LabelSelector Kubernetes label selector (ex. app=podinfo,tier!=frontend)
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+
+	// Search Case insensitive text search on the name and description of the items
+	Search *string `form:"search,omitempty" json:"search,omitempty"`
+
+	// Status Filter by release phase (READY, ERROR, WAIT_OCI, WAIT_REPO, WAIT_HREL, WAIT_DEPS, SUSPENDED)
+	Status *string `form:"status,omitempty" json:"status,omitempty"`
+
+	// Timeout Timeout in seconds of the requests to each cluster, the clusters that do not answer in time are reported as failed
+	Timeout *int `form:"timeout,omitempty" json:"timeout,omitempty"`
+}
+
+// GetVersionsInventoryParams defines parameters for GetVersionsInventory.
+type GetVersionsInventoryParams struct {
+	// Env Restrict the result to the clusters of an environment
+	Env *string `form:"env,omitempty" json:"env,omitempty"`
+
+	// Package Filter by package repository (case insensitive, partial match)
+	Package *string `form:"package,omitempty" json:"package,omitempty"`
+
+	// Tag Filter by package tag (version): an exact tag, or a semantic version constraint (e.g. 1.x, ">=1.2, <2").
+	// The constraints are checked against the version without the package revision suffix (6.7.1 for 6.7.1-p01).
+	Tag *string `form:"tag,omitempty" json:"tag,omitempty"`
+
+	// Timeout Timeout in seconds of the requests to each cluster, the clusters that do not answer in time are reported as failed
+	Timeout *int `form:"timeout,omitempty" json:"timeout,omitempty"`
+}
+
+// AdminUpgradeReleasesJSONRequestBody defines body for AdminUpgradeReleases for application/json ContentType.
+type AdminUpgradeReleasesJSONRequestBody AdminUpgradeReleasesJSONBody
+
 // CreateNamespaceJSONRequestBody defines body for CreateNamespace for application/json ContentType.
 type CreateNamespaceJSONRequestBody CreateNamespaceJSONBody
 
@@ -1986,18 +5185,36 @@ type CreateGitReleaseJSONRequestBody CreateGitReleaseJSONBody
 // UpdateGitReleaseJSONRequestBody defines body for UpdateGitRelease for application/json ContentType.
 type UpdateGitReleaseJSONRequestBody UpdateGitReleaseJSONBody
 
+// PatchGitReleaseApplicationJSONPatchPlusJSONRequestBody defines body for PatchGitRelease for application/json-patch+json ContentType.
+type PatchGitReleaseApplicationJSONPatchPlusJSONRequestBody = PatchGitReleaseApplicationJSONPatchPlusJSONBody
+
+// PatchGitReleaseApplicationMergePatchPlusJSONRequestBody defines body for PatchGitRelease for application/merge-patch+json ContentType.
+type PatchGitReleaseApplicationMergePatchPlusJSONRequestBody = PatchGitReleaseApplicationMergePatchPlusJSONBody
+
 // CreateK8sReleaseJSONRequestBody defines body for CreateK8sRelease for application/json ContentType.
 type CreateK8sReleaseJSONRequestBody CreateK8sReleaseJSONBody
 
 // UpdateK8sReleaseJSONRequestBody defines body for UpdateK8sRelease for application/json ContentType.
 type UpdateK8sReleaseJSONRequestBody UpdateK8sReleaseJSONBody
 
+// PatchK8sReleaseApplicationJSONPatchPlusJSONRequestBody defines body for PatchK8sRelease for application/json-patch+json ContentType.
+type PatchK8sReleaseApplicationJSONPatchPlusJSONRequestBody = PatchK8sReleaseApplicationJSONPatchPlusJSONBody
+
+// PatchK8sReleaseApplicationMergePatchPlusJSONRequestBody defines body for PatchK8sRelease for application/merge-patch+json ContentType.
+type PatchK8sReleaseApplicationMergePatchPlusJSONRequestBody = PatchK8sReleaseApplicationMergePatchPlusJSONBody
+
 // CreateProjectJSONRequestBody defines body for CreateProject for application/json ContentType.
 type CreateProjectJSONRequestBody CreateProjectJSONBody
 
 // UpdateProjectJSONRequestBody defines body for UpdateProject for application/json ContentType.
 type UpdateProjectJSONRequestBody UpdateProjectJSONBody
 
+// PatchProjectApplicationJSONPatchPlusJSONRequestBody defines body for PatchProject for application/json-patch+json ContentType.
+type PatchProjectApplicationJSONPatchPlusJSONRequestBody = PatchProjectApplicationJSONPatchPlusJSONBody
+
+// PatchProjectApplicationMergePatchPlusJSONRequestBody defines body for PatchProject for application/merge-patch+json ContentType.
+type PatchProjectApplicationMergePatchPlusJSONRequestBody = PatchProjectApplicationMergePatchPlusJSONBody
+
 // AsClusterAuth0 returns the union data inside the Cluster_Auth as a ClusterAuth0
 func (t Cluster_Auth) AsClusterAuth0() (ClusterAuth0, error) {
 	var body ClusterAuth0
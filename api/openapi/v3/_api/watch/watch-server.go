@@ -0,0 +1,342 @@
+// Package _watch provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.4.1 DO NOT EDIT.
+package _watch
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/oapi-codegen/runtime"
+)
+
+// WatchK8sReleasesParams defines parameters for WatchK8sReleases.
+type WatchK8sReleasesParams struct {
+	// ResourceVersion Resource version to resume the watch from. When not set, the current objects are first notified as ADDED.
+	// The 'Last-Event-ID' header, sent by the SSE clients on reconnection, takes precedence.
+	ResourceVersion *string `form:"resourceVersion,omitempty" json:"resourceVersion,omitempty"`
+
+	// Heartbeat Interval in seconds between two heartbeats (SSE comments) keeping the connection alive
+	Heartbeat *int `form:"heartbeat,omitempty" json:"heartbeat,omitempty"`
+
+	// LastEventID Id (resource version) of the last received event, used to resume the watch on reconnection
+	LastEventID *string `json:"Last-Event-ID,omitempty"`
+}
+
+// WatchEventsReleaseParams defines parameters for WatchEventsRelease.
+type WatchEventsReleaseParams struct {
+	// ResourceVersion Resource version to resume the watch from. When not set, the current objects are first notified as ADDED.
+	// The 'Last-Event-ID' header, sent by the SSE clients on reconnection, takes precedence.
+	ResourceVersion *string `form:"resourceVersion,omitempty" json:"resourceVersion,omitempty"`
+
+	// Heartbeat Interval in seconds between two heartbeats (SSE comments) keeping the connection alive
+	Heartbeat *int `form:"heartbeat,omitempty" json:"heartbeat,omitempty"`
+
+	// LastEventID Id (resource version) of the last received event, used to resume the watch on reconnection
+	LastEventID *string `json:"Last-Event-ID,omitempty"`
+}
+
+// WatchPodsParams defines parameters for WatchPods.
+type WatchPodsParams struct {
+	// ResourceVersion Resource version to resume the watch from. When not set, the current objects are first notified as ADDED.
+	// The 'Last-Event-ID' header, sent by the SSE clients on reconnection, takes precedence.
+	ResourceVersion *string `form:"resourceVersion,omitempty" json:"resourceVersion,omitempty"`
+
+	// Heartbeat Interval in seconds between two heartbeats (SSE comments) keeping the connection alive
+	Heartbeat *int `form:"heartbeat,omitempty" json:"heartbeat,omitempty"`
+
+	// LastEventID Id (resource version) of the last received event, used to resume the watch on reconnection
+	LastEventID *string `json:"Last-Event-ID,omitempty"`
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Watch the KuboCD releases of a namespace
+	// (GET /clusters/{clusterId}/namespaces/{namespace}/watch/releases)
+	WatchK8sReleases(c *gin.Context, clusterId string, namespace string, params WatchK8sReleasesParams)
+	// Watch the events of a release
+	// (GET /clusters/{clusterId}/namespaces/{namespace}/watch/releases/{releaseName}/events)
+	WatchEventsRelease(c *gin.Context, clusterId string, namespace string, releaseName string, params WatchEventsReleaseParams)
+	// Watch the pods of a release
+	// (GET /clusters/{clusterId}/namespaces/{namespace}/watch/releases/{releaseName}/pods)
+	WatchPods(c *gin.Context, clusterId string, namespace string, releaseName string, params WatchPodsParams)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandler       func(*gin.Context, error, int)
+}
+
+type MiddlewareFunc func(c *gin.Context)
+
+// WatchK8sReleases operation middleware
+func (siw *ServerInterfaceWrapper) WatchK8sReleases(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "clusterId" -------------
+	var clusterId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clusterId", c.Param("clusterId"), &clusterId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter clusterId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", c.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter namespace: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WatchK8sReleasesParams
+
+	// ------------- Optional query parameter "resourceVersion" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "resourceVersion", c.Request.URL.Query(), &params.ResourceVersion)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter resourceVersion: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "heartbeat" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "heartbeat", c.Request.URL.Query(), &params.Heartbeat)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter heartbeat: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Last-Event-ID, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Last-Event-ID", valueList[0], &LastEventID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Last-Event-ID: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.LastEventID = &LastEventID
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.WatchK8sReleases(c, clusterId, namespace, params)
+}
+
+// WatchEventsRelease operation middleware
+func (siw *ServerInterfaceWrapper) WatchEventsRelease(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "clusterId" -------------
+	var clusterId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clusterId", c.Param("clusterId"), &clusterId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter clusterId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", c.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter namespace: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "releaseName" -------------
+	var releaseName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "releaseName", c.Param("releaseName"), &releaseName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter releaseName: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WatchEventsReleaseParams
+
+	// ------------- Optional query parameter "resourceVersion" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "resourceVersion", c.Request.URL.Query(), &params.ResourceVersion)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter resourceVersion: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "heartbeat" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "heartbeat", c.Request.URL.Query(), &params.Heartbeat)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter heartbeat: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Last-Event-ID, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Last-Event-ID", valueList[0], &LastEventID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Last-Event-ID: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.LastEventID = &LastEventID
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.WatchEventsRelease(c, clusterId, namespace, releaseName, params)
+}
+
+// WatchPods operation middleware
+func (siw *ServerInterfaceWrapper) WatchPods(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "clusterId" -------------
+	var clusterId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clusterId", c.Param("clusterId"), &clusterId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter clusterId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", c.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter namespace: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "releaseName" -------------
+	var releaseName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "releaseName", c.Param("releaseName"), &releaseName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter releaseName: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WatchPodsParams
+
+	// ------------- Optional query parameter "resourceVersion" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "resourceVersion", c.Request.URL.Query(), &params.ResourceVersion)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter resourceVersion: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "heartbeat" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "heartbeat", c.Request.URL.Query(), &params.Heartbeat)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter heartbeat: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Last-Event-ID, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Last-Event-ID", valueList[0], &LastEventID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Last-Event-ID: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.LastEventID = &LastEventID
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.WatchPods(c, clusterId, namespace, releaseName, params)
+}
+
+// GinServerOptions provides options for the Gin server.
+type GinServerOptions struct {
+	BaseURL      string
+	Middlewares  []MiddlewareFunc
+	ErrorHandler func(*gin.Context, error, int)
+}
+
+// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
+func RegisterHandlers(router gin.IRouter, si ServerInterface) {
+	RegisterHandlersWithOptions(router, si, GinServerOptions{})
+}
+
+// RegisterHandlersWithOptions creates http.Handler with additional options
+func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
+	errorHandler := options.ErrorHandler
+	if errorHandler == nil {
+		errorHandler = func(c *gin.Context, err error, statusCode int) {
+			c.JSON(statusCode, gin.H{"msg": err.Error()})
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandler:       errorHandler,
+	}
+
+	router.GET(options.BaseURL+"/clusters/:clusterId/namespaces/:namespace/watch/releases", wrapper.WatchK8sReleases)
+	router.GET(options.BaseURL+"/clusters/:clusterId/namespaces/:namespace/watch/releases/:releaseName/events", wrapper.WatchEventsRelease)
+	router.GET(options.BaseURL+"/clusters/:clusterId/namespaces/:namespace/watch/releases/:releaseName/pods", wrapper.WatchPods)
+}
@@ -17,7 +17,13 @@
 package cmd
 
 import (
+	"context"
+	"errors"
+	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/okdp/okdp-server/internal/common/constants"
 	log "github.com/okdp/okdp-server/internal/common/logging"
@@ -51,13 +57,36 @@ func Execute() {
 	}
 }
 
+// shutdownTimeout is the time given to the running requests to complete on shutdown
+const shutdownTimeout = 30 * time.Second
+
 func runOkdpServer(_ *cobra.Command, _ []string) {
 	config := config.GetAppConfig()
 	log.SetupGlobalLogger(config.Logging)
 
-	server := server.NewOKDPServer(config)
+	// the server lives until it is interrupted or terminated
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	server := server.NewOKDPServer(ctx, config)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		<-ctx.Done()
+		log.Info("okdp server shutting down")
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			log.Warn("okdp server shutdown, details: %v", err)
+		}
+	}()
+
 	log.Info("ListenAddress %s: ", config.Server.ListenAddress)
 	log.Info("Port %d: ", config.Server.Port)
 	log.Info("okdp server started on port %d, requests api on %s", config.Server.Port, constants.OkdpServerBaseURL)
-	log.Fatal(server.ListenAndServe())
+	if err := server.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+		log.Fatal("okdp server failed, details: %v", err)
+	}
+	// ListenAndServe returns as soon as the shutdown starts, wait for the running requests
+	<-done
 }
@@ -0,0 +1,403 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/okdp/okdp-server/api/openapi/v3/_api"
+
+	"github.com/okdp/okdp-server/internal/common/constants"
+	log "github.com/okdp/okdp-server/internal/common/logging"
+	"github.com/okdp/okdp-server/internal/config"
+	"github.com/okdp/okdp-server/internal/model"
+	authc "github.com/okdp/okdp-server/internal/security/authc/model"
+	"github.com/okdp/okdp-server/internal/utils"
+)
+
+const (
+	// previousObjectKey is the gin context key holding the object state before a mutating operation
+	previousObjectKey = "auditPreviousObject"
+	// maxCapturedBody limits the size of the request/response bodies kept in the audit events
+	maxCapturedBody  = 1 << 20
+	eventsBufferSize = 1024
+)
+
+var (
+	instance *Auditor
+	once     sync.Once
+)
+
+// Fields which are not relevant when comparing the previous and the requested objects
+var ignoredDiffFields = []string{
+	"status",
+	"metadata.managedFields",
+	"metadata.resourceVersion",
+	"metadata.creationTimestamp",
+	"metadata.generation",
+	"metadata.uid",
+}
+
+// Maps the API collections to the kind and the path parameter of the targeted objects
+var targets = map[string]struct {
+	kind  string
+	param string
+}{
+	"releases":          {"Release", "releaseName"},
+	"projects":          {"Project", "projectName"},
+	"namespaces":        {"Namespace", "namespace"},
+	"gitkustomizations": {"Kustomization", "kustomizationName"},
+	"pods":              {"Pod", "pod"},
+}
+
+type Auditor struct {
+	sinks  []Sink
+	events chan *model.AuditEvent
+}
+
+// GetAuditor returns a singleton instance of the auditor configured with the enabled sinks.
+func GetAuditor() *Auditor {
+	once.Do(func() {
+		instance = newAuditor(config.GetAppConfig().Audit)
+	})
+	return instance
+}
+
+func newAuditor(auditConf config.Audit) *Auditor {
+	auditor := &Auditor{
+		sinks:  []Sink{},
+		events: make(chan *model.AuditEvent, eventsBufferSize),
+	}
+
+	if !auditConf.Enabled {
+		log.Info("Audit log is disabled")
+		return auditor
+	}
+
+	if auditConf.File.Enabled {
+		sink, err := NewFileSink(auditConf.File)
+		if err != nil {
+			log.Fatal("Unable to initialize the audit file sink: %v", err)
+		}
+		auditor.sinks = append(auditor.sinks, sink)
+	}
+	if auditConf.Webhook.Enabled {
+		auditor.sinks = append(auditor.sinks, NewWebhookSink(auditConf.Webhook))
+	}
+	if auditConf.Database.Enabled {
+		sink, err := NewDatabaseSink(auditConf.Database)
+		if err != nil {
+			log.Fatal("Unable to initialize the audit database sink: %v", err)
+		}
+		auditor.sinks = append(auditor.sinks, sink)
+	}
+
+	log.Info("Audit log enabled with sinks: %+v", utils.Map(auditor.sinks, func(s Sink) string { return s.Name() }))
+	go auditor.run()
+
+	return auditor
+}
+
+// Recorder returns a middleware that records an audit event for every mutating operation (POST, PUT, PATCH and DELETE).
+func Recorder() gin.HandlerFunc {
+	auditor := GetAuditor()
+	return func(c *gin.Context) {
+		action, mutating := toAction(c.Request.Method)
+		// the requests proxied to the applications of the releases do not change the okdp resources
+		if !mutating || !auditor.Enabled() || strings.HasSuffix(c.FullPath(), constants.ReleaseProxyRoute) {
+			c.Next()
+			return
+		}
+
+		timestamp := time.Now()
+		payload := readPayload(c)
+		recorder := &responseRecorder{ResponseWriter: c.Writer, body: &bytes.Buffer{}}
+		c.Writer = recorder
+
+		c.Next()
+
+		auditor.Record(newEvent(c, action, timestamp, payload, recorder))
+	}
+}
+
+// SetPrevious loads and stores the state of the targeted object before the mutating operation,
+// it is used to compute the changes recorded in the audit event.
+// The object is loaded only when the audit is enabled.
+func SetPrevious[T any](c *gin.Context, load func() (T, *model.ServerResponse)) {
+	if !GetAuditor().Enabled() {
+		return
+	}
+	previous, err := load()
+	if err != nil {
+		log.Ctx(c).Debug("Unable to load the previous state of %s %s for auditing: %s", c.Request.Method, c.Request.URL.Path, err.Message)
+		return
+	}
+	c.Set(previousObjectKey, previous)
+}
+
+// Enabled returns true if at least one sink is enabled
+func (a *Auditor) Enabled() bool {
+	return len(a.sinks) > 0
+}
+
+// Record sends the event to the sinks asynchronously, or synchronously if the events buffer is full.
+func (a *Auditor) Record(event *model.AuditEvent) {
+	select {
+	case a.events <- event:
+	default:
+		log.Warn("Audit events buffer is full, writing the event '%s' synchronously", event.Id)
+		a.dispatch(event)
+	}
+}
+
+// Query returns the events matching the filter from the first queryable sink, most recent first.
+func (a *Auditor) Query(filter *model.AuditFilter) ([]*model.AuditEvent, *model.ServerResponse) {
+	for _, sink := range a.sinks {
+		if reader, ok := sink.(Reader); ok {
+			events, err := reader.Query(filter)
+			if err != nil {
+				return nil, model.
+					NewServerResponse(model.OkdpServerResponse).
+					UnprocessableEntity("Failed to query the audit events from the %s sink, details: %s", sink.Name(), err.Error())
+			}
+			return events, nil
+		}
+	}
+	return nil, model.AuditNotEnabledError()
+}
+
+func (a *Auditor) run() {
+	for event := range a.events {
+		a.dispatch(event)
+	}
+}
+
+func (a *Auditor) dispatch(event *model.AuditEvent) {
+	for _, sink := range a.sinks {
+		if err := sink.Write(event); err != nil {
+			log.Error("Unable to write the audit event '%s' (%s %s) into the %s sink: %v", event.Id, event.Method, event.Path, sink.Name(), err)
+		}
+	}
+}
+
+// RecordSession records the start or the end of an interactive session (pod exec) opened by the request.
+// The sessions are not mutating operations, they are recorded by the controllers rather than by the Recorder middleware.
+func RecordSession(c *gin.Context, action model.AuditEventAction, outcome model.AuditEventOutcome, message string) {
+	auditor := GetAuditor()
+	if !auditor.Enabled() {
+		return
+	}
+	event := newRequestEvent(c, action, time.Now(), http.StatusSwitchingProtocols, nil)
+	event.Outcome = outcome
+	event.Message = &message
+	auditor.Record(event)
+}
+
+func newEvent(c *gin.Context, action model.AuditEventAction, timestamp time.Time, payload map[string]interface{}, recorder *responseRecorder) *model.AuditEvent {
+	event := newRequestEvent(c, action, timestamp, c.Writer.Status(), payload)
+
+	if payload != nil {
+		event.Payload = &payload
+	}
+
+	if previous, found := c.Get(previousObjectKey); found && payload != nil {
+		diffs, err := utils.DiffObjects(previous, payload, ignoredDiffFields...)
+		if err != nil {
+			log.Ctx(c).Warn("Unable to compute the audit diff for %s %s: %v", event.Method, event.Path, err)
+		} else {
+			event.Diff = toAuditDiff(diffs)
+		}
+	}
+
+	var response model.ServerResponse
+	if err := json.Unmarshal(recorder.body.Bytes(), &response); err == nil && response.Message != "" {
+		event.Message = &response.Message
+	}
+
+	return event
+}
+
+// newRequestEvent returns the event of the request with the user identity and the targeted object
+func newRequestEvent(c *gin.Context, action model.AuditEventAction, timestamp time.Time, status int, payload map[string]interface{}) *model.AuditEvent {
+	event := &model.AuditEvent{
+		Id:        uuid.NewString(),
+		RequestId: utils.EmptyToNil(c.GetString(constants.RequestID)),
+		Timestamp: timestamp.UTC(),
+		Action:    action,
+		Method:    c.Request.Method,
+		Path:      c.Request.URL.Path,
+		Status:    status,
+		Outcome:   model.AuditSuccess,
+		ClusterId: utils.EmptyToNil(c.Param("clusterId")),
+		Namespace: utils.EmptyToNil(c.Param("namespace")),
+	}
+
+	if event.Status >= http.StatusBadRequest {
+		event.Outcome = model.AuditFailure
+	}
+
+	if maybeUserInfo, found := c.Get(constants.OAuth2UserInfo); found {
+		if userInfo, ok := maybeUserInfo.(*authc.UserInfo); ok {
+			event.User.Subject = userInfo.Subject
+			event.User.Email = userInfo.Email
+			event.User.Login = utils.EmptyToNil(userInfo.Login)
+			event.User.Name = utils.EmptyToNil(userInfo.Name)
+			event.User.Roles = userInfo.Roles
+		}
+	}
+	event.User.Roles = utils.NilToEmptySlice(event.User.Roles)
+
+	kind, name := target(c, payload)
+	event.Kind = utils.EmptyToNil(kind)
+	event.Name = utils.EmptyToNil(name)
+	if event.Namespace == nil && (kind == "Project" || kind == "Namespace") {
+		event.Namespace = event.Name
+	}
+
+	return event
+}
+
+// target returns the kind and the name of the object targeted by the request.
+// The kind is derived from the last collection of the route (/releases/:releaseName => Release)
+// and the name from the path parameter or the payload (metadata.name or name) on creation.
+func target(c *gin.Context, payload map[string]interface{}) (string, string) {
+	segments := strings.Split(c.FullPath(), "/")
+	for i := len(segments) - 1; i >= 0; i-- {
+		t, found := targets[segments[i]]
+		if !found {
+			continue
+		}
+		if name := c.Param(t.param); name != "" && i < len(segments)-1 {
+			return t.kind, name
+		}
+		return t.kind, nameFromPayload(payload)
+	}
+	return "", nameFromPayload(payload)
+}
+
+func nameFromPayload(payload map[string]interface{}) string {
+	if payload == nil {
+		return ""
+	}
+	if metadata, ok := payload["metadata"].(map[string]interface{}); ok {
+		if name, ok := metadata["name"].(string); ok {
+			return name
+		}
+	}
+	if name, ok := payload["name"].(string); ok {
+		return name
+	}
+	return ""
+}
+
+func toAction(method string) (model.AuditEventAction, bool) {
+	switch method {
+	case http.MethodPost:
+		return model.AuditCreate, true
+	case http.MethodPut:
+		return model.AuditUpdate, true
+	case http.MethodPatch:
+		return model.AuditPatch, true
+	case http.MethodDelete:
+		return model.AuditDelete, true
+	default:
+		return "", false
+	}
+}
+
+// readPayload reads the JSON request body and restores it for the next handlers
+func readPayload(c *gin.Context) map[string]interface{} {
+	if c.Request.Body == nil {
+		return nil
+	}
+	body, err := io.ReadAll(io.LimitReader(c.Request.Body, maxCapturedBody))
+	if err != nil {
+		log.Ctx(c).Warn("Unable to read the request body for audit: %v", err)
+		return nil
+	}
+	c.Request.Body = io.NopCloser(io.MultiReader(bytes.NewReader(body), c.Request.Body))
+
+	var payload map[string]interface{}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil
+	}
+	return payload
+}
+
+func toAuditDiff(diffs []utils.FieldDiff) *[]struct {
+	From *interface{}          `json:"from,omitempty"`
+	Op   _api.AuditEventDiffOp `json:"op"`
+	Path string                `json:"path"`
+	To   *interface{}          `json:"to,omitempty"`
+} {
+	result := make([]struct {
+		From *interface{}          `json:"from,omitempty"`
+		Op   _api.AuditEventDiffOp `json:"op"`
+		Path string                `json:"path"`
+		To   *interface{}          `json:"to,omitempty"`
+	}, 0, len(diffs))
+
+	for _, d := range diffs {
+		from, to := d.From, d.To
+		change := struct {
+			From *interface{}          `json:"from,omitempty"`
+			Op   _api.AuditEventDiffOp `json:"op"`
+			Path string                `json:"path"`
+			To   *interface{}          `json:"to,omitempty"`
+		}{
+			Op:   _api.AuditEventDiffOp(d.Op),
+			Path: d.Path,
+		}
+		if from != nil {
+			change.From = &from
+		}
+		if to != nil {
+			change.To = &to
+		}
+		result = append(result, change)
+	}
+	return &result
+}
+
+// responseRecorder captures the response body in order to extract the server response message
+type responseRecorder struct {
+	gin.ResponseWriter
+	body *bytes.Buffer
+}
+
+func (w *responseRecorder) Write(data []byte) (int, error) {
+	if w.body.Len() < maxCapturedBody {
+		w.body.Write(data)
+	}
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *responseRecorder) WriteString(s string) (int, error) {
+	if w.body.Len() < maxCapturedBody {
+		w.body.WriteString(s)
+	}
+	return w.ResponseWriter.WriteString(s)
+}
@@ -0,0 +1,173 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package audit
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	// PostgreSQL driver
+	_ "github.com/lib/pq"
+
+	"github.com/okdp/okdp-server/internal/config"
+	"github.com/okdp/okdp-server/internal/model"
+	"github.com/okdp/okdp-server/internal/utils"
+)
+
+const defaultAuditTable = "okdp_audit_events"
+
+var validTableName = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// DatabaseSink stores the audit events into a PostgreSQL table.
+// The filterable attributes are stored as columns and the whole event as a JSON document.
+type DatabaseSink struct {
+	db    *sql.DB
+	table string
+}
+
+func NewDatabaseSink(dbConf config.DBAudit) (*DatabaseSink, error) {
+	table := utils.DefaultIfEmpty(dbConf.Table, defaultAuditTable)
+	if !validTableName.MatchString(table) {
+		return nil, fmt.Errorf("invalid audit table name '%s'", table)
+	}
+
+	dsn := fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=%s",
+		dbConf.Host, dbConf.Port,
+		utils.ResolveEnv(dbConf.Username), utils.ResolveEnv(dbConf.Password),
+		dbConf.Name, utils.DefaultIfEmpty(dbConf.SSLMode, "require"))
+
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		return nil, err
+	}
+	sink := &DatabaseSink{db: db, table: table}
+	if err := sink.createTable(); err != nil {
+		return nil, err
+	}
+	return sink, nil
+}
+
+func (s *DatabaseSink) Name() string {
+	return "database"
+}
+
+func (s *DatabaseSink) createTable() error {
+	_, err := s.db.Exec(fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+		id           VARCHAR(64) PRIMARY KEY,
+		timestamp    TIMESTAMPTZ NOT NULL,
+		user_subject TEXT NOT NULL,
+		user_login   TEXT,
+		user_email   TEXT,
+		action       VARCHAR(16) NOT NULL,
+		cluster_id   TEXT,
+		namespace    TEXT,
+		outcome      VARCHAR(16) NOT NULL,
+		status       INTEGER NOT NULL,
+		event        TEXT NOT NULL
+	)`, s.table))
+	if err != nil {
+		return err
+	}
+	_, err = s.db.Exec(fmt.Sprintf(`CREATE INDEX IF NOT EXISTS %s_timestamp_idx ON %s (timestamp DESC)`, s.table, s.table))
+	return err
+}
+
+func (s *DatabaseSink) Write(event *model.AuditEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	_, err = s.db.Exec(
+		fmt.Sprintf(`INSERT INTO %s (id, timestamp, user_subject, user_login, user_email, action, cluster_id, namespace, outcome, status, event)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`, s.table),
+		event.Id, event.Timestamp, event.User.Subject, event.User.Login, event.User.Email,
+		string(event.Action), event.ClusterId, event.Namespace, string(event.Outcome), event.Status, string(data))
+	return err
+}
+
+// Query returns the events matching the filter, most recent first
+func (s *DatabaseSink) Query(filter *model.AuditFilter) ([]*model.AuditEvent, error) {
+	query, args := s.buildQuery(filter)
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	events := []*model.AuditEvent{}
+	for rows.Next() {
+		var data string
+		if err := rows.Scan(&data); err != nil {
+			return nil, err
+		}
+		var event model.AuditEvent
+		if err := json.Unmarshal([]byte(data), &event); err != nil {
+			return nil, err
+		}
+		events = append(events, &event)
+	}
+	return events, rows.Err()
+}
+
+func (s *DatabaseSink) buildQuery(filter *model.AuditFilter) (string, []interface{}) {
+	var conditions []string
+	var args []interface{}
+
+	where := func(condition string, value interface{}) {
+		args = append(args, value)
+		conditions = append(conditions, strings.ReplaceAll(condition, "?", fmt.Sprintf("$%d", len(args))))
+	}
+
+	limit := 0
+	if filter != nil {
+		if filter.User != "" {
+			where("(LOWER(user_subject) = LOWER(?) OR LOWER(user_login) = LOWER(?) OR LOWER(user_email) = LOWER(?))", filter.User)
+		}
+		if filter.ClusterID != "" {
+			where("LOWER(cluster_id) = LOWER(?)", filter.ClusterID)
+		}
+		if filter.Namespace != "" {
+			where("namespace = ?", filter.Namespace)
+		}
+		if filter.Action != "" {
+			where("action = ?", filter.Action)
+		}
+		if filter.Outcome != "" {
+			where("outcome = ?", filter.Outcome)
+		}
+		if filter.From != nil {
+			where("timestamp >= ?", *filter.From)
+		}
+		if filter.To != nil {
+			where("timestamp <= ?", *filter.To)
+		}
+		limit = filter.Limit
+	}
+
+	query := fmt.Sprintf("SELECT event FROM %s", s.table)
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY timestamp DESC"
+	if limit > 0 {
+		query += fmt.Sprintf(" LIMIT %d", limit)
+	}
+	return query, args
+}
@@ -0,0 +1,110 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+
+	log "github.com/okdp/okdp-server/internal/common/logging"
+	"github.com/okdp/okdp-server/internal/config"
+	"github.com/okdp/okdp-server/internal/model"
+)
+
+// FileSink appends the audit events to a file, one JSON document per line
+type FileSink struct {
+	path string
+	mu   sync.Mutex
+}
+
+func NewFileSink(fileConf config.FileAudit) (*FileSink, error) {
+	if err := os.MkdirAll(filepath.Dir(fileConf.Path), 0750); err != nil {
+		return nil, err
+	}
+	file, err := os.OpenFile(fileConf.Path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0640)
+	if err != nil {
+		return nil, err
+	}
+	if err := file.Close(); err != nil {
+		return nil, err
+	}
+	return &FileSink{path: fileConf.Path}, nil
+}
+
+func (s *FileSink) Name() string {
+	return "file"
+}
+
+func (s *FileSink) Write(event *model.AuditEvent) error {
+	line, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	file, err := os.OpenFile(s.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0640)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = file.Write(append(line, '\n'))
+	return err
+}
+
+// Query scans the file and returns the events matching the filter, most recent first
+func (s *FileSink) Query(filter *model.AuditFilter) ([]*model.AuditEvent, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	file, err := os.Open(s.path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	events := []*model.AuditEvent{}
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 4*maxCapturedBody)
+	for scanner.Scan() {
+		var event model.AuditEvent
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			log.Warn("Ignoring malformed audit event in %s: %v", s.path, err)
+			continue
+		}
+		if event.Matches(filter) {
+			events = append(events, &event)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	// Most recent first
+	for i, j := 0, len(events)-1; i < j; i, j = i+1, j-1 {
+		events[i], events[j] = events[j], events[i]
+	}
+	if filter != nil && filter.Limit > 0 && len(events) > filter.Limit {
+		events = events[:filter.Limit]
+	}
+	return events, nil
+}
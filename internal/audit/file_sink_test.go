@@ -0,0 +1,81 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package audit
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/okdp/okdp-server/internal/config"
+	"github.com/okdp/okdp-server/internal/model"
+)
+
+func newTestEvent(id, subject, clusterID string, action model.AuditEventAction, outcome model.AuditEventOutcome, timestamp time.Time) *model.AuditEvent {
+	event := &model.AuditEvent{
+		Id:        id,
+		Timestamp: timestamp,
+		Action:    action,
+		Method:    "PUT",
+		Path:      "/api/v1/clusters/" + clusterID + "/namespaces",
+		ClusterId: &clusterID,
+		Outcome:   outcome,
+		Status:    200,
+	}
+	event.User.Subject = subject
+	event.User.Email = subject + "@example.org"
+	event.User.Roles = []string{"developers"}
+	return event
+}
+
+func TestFileSink_WriteAndQuery(t *testing.T) {
+	// Given
+	sink, err := NewFileSink(config.FileAudit{Enabled: true, Path: filepath.Join(t.TempDir(), "audit", "audit.log")})
+	require.NoError(t, err)
+	now := time.Now().UTC()
+	require.NoError(t, sink.Write(newTestEvent("1", "dev1", "sandbox", model.AuditCreate, model.AuditSuccess, now.Add(-2*time.Hour))))
+	require.NoError(t, sink.Write(newTestEvent("2", "dev2", "sandbox", model.AuditUpdate, model.AuditFailure, now.Add(-time.Hour))))
+	require.NoError(t, sink.Write(newTestEvent("3", "dev1", "prod", model.AuditDelete, model.AuditSuccess, now)))
+
+	// When
+	all, err := sink.Query(&model.AuditFilter{})
+	require.NoError(t, err)
+	byUser, err := sink.Query(&model.AuditFilter{User: "DEV1"})
+	require.NoError(t, err)
+	byCluster, err := sink.Query(&model.AuditFilter{ClusterID: "sandbox", Outcome: string(model.AuditFailure)})
+	require.NoError(t, err)
+	from := now.Add(-90 * time.Minute)
+	byTime, err := sink.Query(&model.AuditFilter{From: &from, Limit: 1})
+	require.NoError(t, err)
+
+	// Then
+	assert.Equal(t, []string{"3", "2", "1"}, ids(all), "most recent first")
+	assert.Equal(t, []string{"3", "1"}, ids(byUser))
+	assert.Equal(t, []string{"2"}, ids(byCluster))
+	assert.Equal(t, []string{"3"}, ids(byTime))
+}
+
+func ids(events []*model.AuditEvent) []string {
+	result := []string{}
+	for _, event := range events {
+		result = append(result, event.Id)
+	}
+	return result
+}
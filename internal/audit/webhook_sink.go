@@ -0,0 +1,85 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/okdp/okdp-server/internal/config"
+	"github.com/okdp/okdp-server/internal/model"
+	"github.com/okdp/okdp-server/internal/utils"
+)
+
+const defaultWebhookTimeout = 10
+
+// WebhookSink posts every audit event as a JSON document to a remote endpoint
+type WebhookSink struct {
+	url     string
+	headers map[string]string
+	client  *http.Client
+}
+
+func NewWebhookSink(webhookConf config.WebhookAudit) *WebhookSink {
+	timeout := webhookConf.Timeout
+	if timeout <= 0 {
+		timeout = defaultWebhookTimeout
+	}
+	headers := make(map[string]string, len(webhookConf.Headers))
+	for header, value := range webhookConf.Headers {
+		headers[header] = utils.ResolveEnv(value)
+	}
+	return &WebhookSink{
+		url:     webhookConf.URL,
+		headers: headers,
+		client:  &http.Client{Timeout: time.Duration(timeout) * time.Second},
+	}
+}
+
+func (s *WebhookSink) Name() string {
+	return "webhook"
+}
+
+func (s *WebhookSink) Write(event *model.AuditEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for header, value := range s.headers {
+		req.Header.Set(header, value)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("webhook %s responded with status %d", s.url, resp.StatusCode)
+	}
+	return nil
+}
@@ -26,18 +26,42 @@ const (
 	OAuth2State       = "state"
 	OAuth2Nonce       = "nonce"
 	OAuth2UserInfo    = "userInfo"
+	// OAuth2Expiry is the context key of the expiry of the user credentials, when known (bearer token)
+	OAuth2Expiry = "userInfoExpiry"
+	// RequestIDHeader is the HTTP header carrying the request correlation ID
+	RequestIDHeader = "X-Request-ID"
+	// TotalCountHeader is the HTTP header carrying the total number of the items of a list
+	TotalCountHeader = "X-Total-Count"
+	// ContinueHeader is the HTTP header carrying the cursor of the next page of a list
+	ContinueHeader = "X-Continue"
+	// ETagHeader is the HTTP header carrying the version of a resource
+	ETagHeader = "ETag"
+	// RequestID is the context key and log field name of the request correlation ID
+	RequestID = "requestId"
 	// CasbinRolePrefix is used to prefix the roles/groups in the casbin policy (p, role:viewers, /api/v1/users/myprofile, *)
 	CasbinRolePrefix = "role:"
+	// CasbinExecAction is the dedicated casbin action of the pod exec sessions, the wildcard (*) action does not grant it
+	CasbinExecAction = "EXEC"
+	// PodExecRouteSuffix is the suffix of the route of the pod exec sessions
+	PodExecRouteSuffix = "/exec"
+	// CasbinProxyAction is the dedicated casbin action of the release proxy, the wildcard (*) action does not grant it
+	CasbinProxyAction = "PROXY"
+	// ReleaseProxyRoute is the route of the proxy to the services and the pods of a release, it is not part of the
+	// OpenAPI specification as the proxied path is a wildcard
+	ReleaseProxyRoute = "/clusters/:clusterId/namespaces/:namespace/releases/:releaseName/proxy/:kind/:name/:port/*path"
 	// SwaggerAPIDocsURI is the swagger API Docs public URI
-	SwaggerAPIDocsURI  = OkdpServerBaseURL + "/api-docs"
-	HealthzURI         = "/healthz"
-	ReadinessURI       = "/readiness"
-	All                = "All"
-	GitRepository      = "GitRepository"
-	K8SAuthKubeConfig  = "AuthKubeconfig"
-	K8SAuthCertificate = "AuthCertificate"
-	K8SAuthBeaer       = "AuthBearer"
-	K8SInCluster       = "InCluster"
+	SwaggerAPIDocsURI = OkdpServerBaseURL + "/api-docs"
+	HealthzURI        = "/healthz"
+	ReadinessURI      = "/readiness"
+	All               = "All"
+	GitRepository     = "GitRepository"
+	// KustomizeNameLabel and KustomizeNamespaceLabel are set by fluxcd on the objects applied by a Kustomization
+	KustomizeNameLabel      = "kustomize.toolkit.fluxcd.io/name"
+	KustomizeNamespaceLabel = "kustomize.toolkit.fluxcd.io/namespace"
+	K8SAuthKubeConfig       = "AuthKubeconfig"
+	K8SAuthCertificate      = "AuthCertificate"
+	K8SAuthBeaer            = "AuthBearer"
+	K8SInCluster            = "InCluster"
 
 	StateRunning    = "Running"
 	StateWaiting    = "Waiting"
@@ -17,17 +17,24 @@
 package logging
 
 import (
+	"context"
 	"fmt"
 	"sync"
 	"time"
 
 	ginzap "github.com/gin-contrib/zap"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/okdp/okdp-server/internal/common/constants"
+	"github.com/okdp/okdp-server/internal/common/requestid"
 	"github.com/okdp/okdp-server/internal/config"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 )
 
+// maxRequestIDLength limits the size of the request IDs provided by the clients
+const maxRequestIDLength = 128
+
 var (
 	instance *zap.SugaredLogger
 	once     sync.Once
@@ -96,6 +103,39 @@ func Panic(args ...interface{}) {
 	instance.Panicf(args[0].(string), args[1:]...)
 }
 
+// ContextLogger writes the logs enriched with the ID of the request carried by a context
+type ContextLogger struct {
+	logger *zap.SugaredLogger
+}
+
+// Ctx returns a logger enriched with the ID of the request carried by the context (request or gin context), if any
+func Ctx(ctx context.Context) ContextLogger {
+	if requestID := requestid.FromContext(ctx); requestID != "" {
+		return ContextLogger{logger: instance.With(constants.RequestID, requestID)}
+	}
+	return ContextLogger{logger: instance}
+}
+
+// Logs in 'DEBUG' level according to a format specifier and writes to standard output.
+func (l ContextLogger) Debug(args ...interface{}) {
+	l.logger.Debugf(args[0].(string), args[1:]...)
+}
+
+// Logs in 'INFO' level according to a format specifier and writes to standard output.
+func (l ContextLogger) Info(args ...interface{}) {
+	l.logger.Infof(args[0].(string), args[1:]...)
+}
+
+// Logs in 'WARN' level according to a format specifier and writes to standard output.
+func (l ContextLogger) Warn(args ...interface{}) {
+	l.logger.Warnf(args[0].(string), args[1:]...)
+}
+
+// Logs in 'ERROR' level according to a format specifier and writes to standard output.
+func (l ContextLogger) Error(args ...interface{}) {
+	l.logger.Errorf(args[0].(string), args[1:]...)
+}
+
 // Logger returns a middleware that will write the logs to gin.DefaultWriter.
 // By default, gin.DefaultWriter = os.Stdout.
 func Logger() []gin.HandlerFunc {
@@ -105,6 +145,44 @@ func Logger() []gin.HandlerFunc {
 		logger = instance.Desugar()
 	}
 
-	return []gin.HandlerFunc{ginzap.Ginzap(logger, time.RFC3339, true),
+	return []gin.HandlerFunc{RequestID(),
+		ginzap.GinzapWithConfig(logger, &ginzap.Config{
+			TimeFormat: time.RFC3339,
+			UTC:        true,
+			Context: func(c *gin.Context) []zapcore.Field {
+				return []zapcore.Field{zap.String(constants.RequestID, c.GetString(constants.RequestID))}
+			},
+		}),
 		ginzap.RecoveryWithZap(logger, true)}
 }
+
+// RequestID returns a middleware that accepts the request ID provided by the client in the X-Request-ID header
+// or generates a new one. The ID is returned in the response headers and carried by the context of the request
+// so that it is added to the logs and server responses emitted with that context.
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := c.GetHeader(constants.RequestIDHeader)
+		if !isValidRequestID(requestID) {
+			requestID = uuid.NewString()
+		}
+
+		c.Set(constants.RequestID, requestID)
+		c.Header(constants.RequestIDHeader, requestID)
+
+		c.Request = c.Request.WithContext(requestid.NewContext(c.Request.Context(), requestID))
+
+		c.Next()
+	}
+}
+
+func isValidRequestID(requestID string) bool {
+	if requestID == "" || len(requestID) > maxRequestIDLength {
+		return false
+	}
+	for _, r := range requestID {
+		if r < '!' || r > '~' {
+			return false
+		}
+	}
+	return true
+}
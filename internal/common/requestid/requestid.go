@@ -0,0 +1,50 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+// Package requestid carries the correlation ID of a request in its context.
+//
+// The ID is stored in the context of the HTTP request by the logging middleware, the handlers pass that context
+// (or the gin context) to the logs and the server responses, and to the goroutines they spawn.
+package requestid
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+)
+
+type contextKey struct{}
+
+// NewContext returns a copy of the context carrying the request ID
+func NewContext(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, contextKey{}, requestID)
+}
+
+// FromContext returns the request ID carried by the context, or an empty string.
+// The ID of a gin context is the one of its HTTP request.
+func FromContext(ctx context.Context) string {
+	if c, ok := ctx.(*gin.Context); ok {
+		if c.Request == nil {
+			return ""
+		}
+		ctx = c.Request.Context()
+	}
+	if ctx == nil {
+		return ""
+	}
+	requestID, _ := ctx.Value(contextKey{}).(string)
+	return requestID
+}
@@ -0,0 +1,65 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package requestid
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestID_Context(t *testing.T) {
+	// Given
+	ctx := NewContext(context.Background(), "req-1")
+
+	// When
+	derived, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Then
+	assert.Equal(t, "req-1", FromContext(ctx))
+	assert.Equal(t, "req-1", FromContext(derived), "the derived contexts, passed to the spawned goroutines, carry the ID")
+	assert.Empty(t, FromContext(context.Background()))
+}
+
+func TestRequestID_GinContext(t *testing.T) {
+	// Given
+	gin.SetMode(gin.TestMode)
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest("GET", "/", nil)
+	c.Request = c.Request.WithContext(NewContext(c.Request.Context(), "req-2"))
+
+	// When
+	requestID := FromContext(c)
+
+	// Then
+	assert.Equal(t, "req-2", requestID)
+}
+
+func TestRequestID_GinContextWithoutRequest(t *testing.T) {
+	// Given
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+
+	// When
+	requestID := FromContext(c)
+
+	// Then
+	assert.Empty(t, requestID)
+}
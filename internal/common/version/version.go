@@ -0,0 +1,56 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package version
+
+import (
+	"runtime"
+	"runtime/debug"
+	"time"
+)
+
+// Version of the okdp-server, overridden at build time with:
+// -ldflags "-X github.com/okdp/okdp-server/internal/common/version.Version=x.y.z"
+var Version = "dev"
+
+var startTime = time.Now()
+
+// Get returns the okdp-server version, falling back to the module version
+// recorded in the binary build info when not set at build time.
+func Get() string {
+	if Version != "dev" {
+		return Version
+	}
+	if info, ok := debug.ReadBuildInfo(); ok && info.Main.Version != "" && info.Main.Version != "(devel)" {
+		return info.Main.Version
+	}
+	return Version
+}
+
+// GoVersion returns the Go version used to build the okdp-server
+func GoVersion() string {
+	return runtime.Version()
+}
+
+// StartTime returns the start time of the okdp-server instance
+func StartTime() time.Time {
+	return startTime
+}
+
+// Uptime returns the uptime of the okdp-server instance, rounded to the second
+func Uptime() time.Duration {
+	return time.Since(startTime).Round(time.Second)
+}
@@ -28,12 +28,15 @@ import (
 
 // Application configuration
 type ApplicationConfig struct {
-	Server   Server           `mapstructure:"server"`
-	Security Security         `mapstructure:"security"`
-	Logging  Logging          `mapstructure:"logging"`
-	Swagger  Swagger          `mapstructure:"swagger"`
-	Catalogs []*model.Catalog `mapstructure:"catalog"`
-	Clusters []*model.Cluster `yaml:"clusters"`
+	Server     Server           `mapstructure:"server"`
+	Security   Security         `mapstructure:"security"`
+	Logging    Logging          `mapstructure:"logging"`
+	Swagger    Swagger          `mapstructure:"swagger"`
+	Audit      Audit            `mapstructure:"audit"`
+	Validation Validation       `mapstructure:"validation"`
+	Informers  Informers        `mapstructure:"informers"`
+	Catalogs   []*model.Catalog `mapstructure:"catalog"`
+	Clusters   []*model.Cluster `yaml:"clusters"`
 }
 
 // Server configuration
@@ -43,6 +46,27 @@ type Server struct {
 	Mode          string `mapstructure:"mode"`
 }
 
+// OpenAPI validation configuration
+type Validation struct {
+	// Requests enables the validation of the requests against the OpenAPI specification
+	Requests bool `yaml:"requests"`
+	// Responses enables the validation of the responses against the OpenAPI specification (tests and staging)
+	Responses bool `yaml:"responses"`
+	// FailOnInvalidResponse replaces the invalid responses with an internal server error instead of only logging them
+	FailOnInvalidResponse bool `yaml:"failOnInvalidResponse"`
+}
+
+// Kubernetes informers configuration
+type Informers struct {
+	// Enabled serves the Kubernetes reads from shared informer caches, the reads go directly to the API servers otherwise
+	Enabled bool `yaml:"enabled"`
+	// ResyncPeriod is the period (in seconds) of the full resync of the informers, controller-runtime default when 0
+	ResyncPeriod int64 `yaml:"resyncPeriod"`
+	// SyncTimeout is the time (in seconds) given to the informers of a cluster to sync, the reads of the cluster go to
+	// its API server afterwards and it no longer blocks the readiness. 120 seconds when 0.
+	SyncTimeout int64 `yaml:"syncTimeout"`
+}
+
 // Logging configuration
 type Logging struct {
 	Level  string `yaml:"provider"`
@@ -136,6 +160,40 @@ type InLineAuthZ struct {
 	Model  string `yaml:"model"`
 }
 
+// Audit configuration
+type Audit struct {
+	Enabled  bool         `yaml:"enabled"`
+	File     FileAudit    `yaml:"file"`
+	Webhook  WebhookAudit `yaml:"webhook"`
+	Database DBAudit      `yaml:"database"`
+}
+
+// File-based audit sink (JSON lines)
+type FileAudit struct {
+	Enabled bool   `yaml:"enabled"`
+	Path    string `yaml:"path"`
+}
+
+// Webhook-based audit sink
+type WebhookAudit struct {
+	Enabled bool              `yaml:"enabled"`
+	URL     string            `yaml:"url"`
+	Headers map[string]string `yaml:"headers"`
+	Timeout int64             `yaml:"timeout"`
+}
+
+// Database-based audit sink (PostgreSQL)
+type DBAudit struct {
+	Enabled  bool   `yaml:"enabled"`
+	Host     string `yaml:"host"`
+	Port     int    `yaml:"port"`
+	Username string `yaml:"username"`
+	Password string `yaml:"password"`
+	Name     string `yaml:"name"`
+	SSLMode  string `yaml:"sslMode"`
+	Table    string `yaml:"table"`
+}
+
 type Swagger struct {
 	SecuritySchemes map[string]*openapi3.SecurityScheme `yaml:"securitySchemes,omitempty"`
 	Security        openapi3.SecurityRequirements       `yaml:"security,omitempty"`
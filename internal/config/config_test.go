@@ -138,6 +138,49 @@ func Test_LoadConfig_AuthZProvider_Database(t *testing.T) {
 	assert.Equal(t, "passDB!", autz.Database.Password, "Password")
 }
 
+func Test_LoadConfig_Audit(t *testing.T) {
+	// Given
+	viper.Set("config", "testdata/application.yaml")
+	// When
+	audit := GetAppConfig().Audit
+	// Then
+	assert.True(t, audit.Enabled, "Enabled")
+	assert.True(t, audit.File.Enabled, "File.Enabled")
+	assert.Equal(t, "/var/log/okdp/audit.log", audit.File.Path, "File.Path")
+	assert.False(t, audit.Webhook.Enabled, "Webhook.Enabled")
+	assert.Equal(t, "https://audit.example.org/events", audit.Webhook.URL, "Webhook.URL")
+	assert.Equal(t, map[string]string{"authorization": "$(AUDIT_WEBHOOK_AUTHORIZATION)"}, audit.Webhook.Headers, "Webhook.Headers")
+	assert.Equal(t, int64(5), audit.Webhook.Timeout, "Webhook.Timeout")
+	assert.False(t, audit.Database.Enabled, "Database.Enabled")
+	assert.Equal(t, "localhost", audit.Database.Host, "Database.Host")
+	assert.Equal(t, 5432, audit.Database.Port, "Database.Port")
+	assert.Equal(t, "okdp", audit.Database.Name, "Database.Name")
+	assert.Equal(t, "disable", audit.Database.SSLMode, "Database.SSLMode")
+	assert.Equal(t, "okdp_audit_events", audit.Database.Table, "Database.Table")
+}
+
+func Test_LoadConfig_Validation(t *testing.T) {
+	// Given
+	viper.Set("config", "testdata/application.yaml")
+	// When
+	validation := GetAppConfig().Validation
+	// Then
+	assert.True(t, validation.Requests, "Requests")
+	assert.True(t, validation.Responses, "Responses")
+	assert.False(t, validation.FailOnInvalidResponse, "FailOnInvalidResponse")
+}
+
+func Test_LoadConfig_Informers(t *testing.T) {
+	// Given
+	viper.Set("config", "testdata/application.yaml")
+	// When
+	informers := GetAppConfig().Informers
+	// Then
+	assert.True(t, informers.Enabled, "Enabled")
+	assert.Equal(t, int64(600), informers.ResyncPeriod, "ResyncPeriod")
+	assert.Equal(t, int64(60), informers.SyncTimeout, "SyncTimeout")
+}
+
 func Test_LoadConfig_Swagger(t *testing.T) {
 	// Given
 	viper.Set("config", "testdata/application.yaml")
@@ -0,0 +1,36 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package controllers
+
+import (
+	"time"
+
+	"github.com/okdp/okdp-server/internal/utils"
+)
+
+const defaultActionTimeout = 60
+
+// actionTimeout returns how long to wait for the reconciliation of the resource, zero when not waiting
+func actionTimeout(wait *bool, waitTimeout *int) time.Duration {
+	if !utils.OrFalse(wait) {
+		return 0
+	}
+	if waitTimeout == nil {
+		return defaultActionTimeout * time.Second
+	}
+	return time.Duration(*waitTimeout) * time.Second
+}
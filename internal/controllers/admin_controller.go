@@ -0,0 +1,140 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	_api "github.com/okdp/okdp-server/api/openapi/v3/_api/admin"
+	log "github.com/okdp/okdp-server/internal/common/logging"
+	"github.com/okdp/okdp-server/internal/model"
+	"github.com/okdp/okdp-server/internal/services"
+	"github.com/okdp/okdp-server/internal/utils"
+)
+
+type IAdminController struct {
+	adminService   *services.AdminService
+	upgradeService *services.UpgradeService
+}
+
+func AdminController() *IAdminController {
+	return &IAdminController{
+		adminService:   services.NewAdminService(),
+		upgradeService: services.NewUpgradeService(),
+	}
+}
+
+func (r IAdminController) AdminListK8sReleases(c *gin.Context, clusterID string, params _api.AdminListK8sReleasesParams) {
+	options, err := model.NewListOptions(params.Limit, utils.OrEmpty(params.Continue), utils.OrEmpty(params.Sort), utils.OrEmpty(params.Search), utils.OrEmpty(params.LabelSelector))
+	if err != nil {
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
+		return
+	}
+	options.Status = utils.OrEmpty(params.Status)
+	options.Package = utils.OrEmpty(params.Package)
+	options.Tag = utils.OrEmpty(params.Tag)
+
+	releases, err := r.adminService.ListClusterReleases(c.Request.Context(), clusterID, options)
+	if err != nil {
+		log.Ctx(c).Error("Unable to get releases from Kubernetes cluster '%s' on all namespaces, details: %+v", clusterID, err)
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
+		return
+	}
+	listOK(c, releases)
+}
+
+func (r IAdminController) AdminListReleases(c *gin.Context, params _api.AdminListReleasesParams) {
+	options, err := model.NewListOptions(params.Limit, utils.OrEmpty(params.Continue), utils.OrEmpty(params.Sort), utils.OrEmpty(params.Search), utils.OrEmpty(params.LabelSelector))
+	if err != nil {
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
+		return
+	}
+	options.Status = utils.OrEmpty(params.Status)
+	options.Package = utils.OrEmpty(params.Package)
+	options.Tag = utils.OrEmpty(params.Tag)
+
+	releases, err := r.adminService.ListReleases(c.Request.Context(), params.ClusterId, options)
+	if err != nil {
+		log.Ctx(c).Error("Unable to list releases, details: %+v", err)
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
+		return
+	}
+	listOK(c, releases)
+}
+
+func (r IAdminController) AdminListGitSources(c *gin.Context, params _api.AdminListGitSourcesParams) {
+	options, err := model.NewListOptions(params.Limit, utils.OrEmpty(params.Continue), utils.OrEmpty(params.Sort), utils.OrEmpty(params.Search), "")
+	if err != nil {
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
+		return
+	}
+	options.Status = utils.OrEmpty(params.Status)
+
+	sources, err := r.adminService.ListGitSources(c.Request.Context(), params.ClusterId, options)
+	if err != nil {
+		log.Ctx(c).Error("Unable to list git sources, details: %+v", err)
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
+		return
+	}
+	listOK(c, sources)
+}
+
+func (r IAdminController) AdminListProjects(c *gin.Context, params _api.AdminListProjectsParams) {
+	options, err := model.NewListOptions(params.Limit, utils.OrEmpty(params.Continue), utils.OrEmpty(params.Sort), utils.OrEmpty(params.Search), utils.OrEmpty(params.LabelSelector))
+	if err != nil {
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
+		return
+	}
+	options.Status = utils.OrEmpty(params.Status)
+
+	projects, err := r.adminService.ListProjects(c.Request.Context(), params.ClusterId, options)
+	if err != nil {
+		log.Ctx(c).Error("Unable to list projects, details: %+v", err)
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
+		return
+	}
+	listOK(c, projects)
+}
+
+func (r IAdminController) AdminGetSystemInfo(c *gin.Context) {
+	c.JSON(http.StatusOK, r.adminService.GetSystemInfo())
+}
+
+func (r IAdminController) AdminUpgradeReleases(c *gin.Context, params _api.AdminUpgradeReleasesParams) {
+	var request model.UpgradeRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		resp := model.BindingError(err)
+		c.AbortWithStatusJSON(resp.Status, resp.ForRequest(c))
+		return
+	}
+
+	author, email := userIdentity(c)
+	upgrade, err := r.upgradeService.UpgradeReleases(c.Request.Context(), &request, utils.OrFalse(params.DryRun), author, email)
+	if err != nil {
+		log.Ctx(c).Error("Unable to upgrade the releases of package '%s' to version '%s', details: %+v", request.Package, request.Version, err)
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
+		return
+	}
+
+	status := http.StatusOK
+	if upgrade.HasErrors() {
+		status = http.StatusMultiStatus
+	}
+	c.JSON(status, upgrade)
+}
@@ -0,0 +1,72 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	_api "github.com/okdp/okdp-server/api/openapi/v3/_api/audit"
+	"github.com/okdp/okdp-server/internal/model"
+	"github.com/okdp/okdp-server/internal/services"
+)
+
+const defaultAuditEventsLimit = 100
+
+type IAuditController struct {
+	auditService *services.AuditService
+}
+
+func AuditController() *IAuditController {
+	return &IAuditController{
+		auditService: services.NewAuditService(),
+	}
+}
+
+func (r IAuditController) ListAuditEvents(c *gin.Context, params _api.ListAuditEventsParams) {
+	filter := &model.AuditFilter{
+		From:  params.From,
+		To:    params.To,
+		Limit: defaultAuditEventsLimit,
+	}
+	if params.User != nil {
+		filter.User = *params.User
+	}
+	if params.ClusterId != nil {
+		filter.ClusterID = *params.ClusterId
+	}
+	if params.Namespace != nil {
+		filter.Namespace = *params.Namespace
+	}
+	if params.Action != nil {
+		filter.Action = string(*params.Action)
+	}
+	if params.Outcome != nil {
+		filter.Outcome = string(*params.Outcome)
+	}
+	if params.Limit != nil {
+		filter.Limit = *params.Limit
+	}
+
+	events, err := r.auditService.ListEvents(filter)
+	if err != nil {
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
+		return
+	}
+	c.JSON(http.StatusOK, events)
+}
@@ -0,0 +1,62 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package controllers
+
+import (
+	"bytes"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+
+	"github.com/okdp/okdp-server/internal/model"
+)
+
+// bindReleases binds the release of the request body, or the list of releases of a bulk creation
+// (JSON array or multi-document YAML). The returned flag is true for a bulk creation.
+func bindReleases(c *gin.Context) ([]model.Release, bool, *model.ServerResponse) {
+	data, err := c.GetRawData()
+	if err != nil {
+		return nil, false, model.BindingError(err)
+	}
+
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("[")) {
+		var releases []model.Release
+		if err := binding.JSON.BindBody(data, &releases); err != nil {
+			return nil, true, model.BindingError(err)
+		}
+		return releases, true, nil
+	}
+
+	var release model.Release
+	if err := binding.JSON.BindBody(data, &release); err != nil {
+		return nil, false, model.BindingError(err)
+	}
+	return []model.Release{release}, false, nil
+}
+
+// bulkCreated writes the results of a bulk creation, the status is 201 when all the items were created, 207 otherwise
+func bulkCreated(c *gin.Context, responses []*model.ServerResponse) {
+	status := http.StatusCreated
+	for _, response := range responses {
+		response.ForRequest(c)
+		if response.IsError() {
+			status = http.StatusMultiStatus
+		}
+	}
+	c.JSON(status, responses)
+}
@@ -20,8 +20,11 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	_api "github.com/okdp/okdp-server/api/openapi/v3/_api/catalogs"
 	log "github.com/okdp/okdp-server/internal/common/logging"
+	"github.com/okdp/okdp-server/internal/model"
 	"github.com/okdp/okdp-server/internal/services"
+	"github.com/okdp/okdp-server/internal/utils"
 )
 
 type ICatalogController struct {
@@ -42,28 +45,35 @@ func (r ICatalogController) ListCatalogs(c *gin.Context) {
 func (r ICatalogController) GetCatalog(c *gin.Context, catalogID string) {
 	catalog, err := r.catalogService.GetCatalog(catalogID)
 	if err != nil {
-		log.Error("Unable to find the Catalog with ID '%s', details: %+v", catalogID, err)
-		c.AbortWithStatusJSON(err.Status, err)
+		log.Ctx(c).Error("Unable to find the Catalog with ID '%s', details: %+v", catalogID, err)
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
 		return
 	}
 	c.JSON(http.StatusOK, catalog)
 }
 
-func (r ICatalogController) ListPackages(c *gin.Context, catalogID string) {
-	packages, err := r.catalogService.GetPackages(catalogID)
+func (r ICatalogController) ListPackages(c *gin.Context, catalogID string, params _api.ListPackagesParams) {
+	options, err := model.NewListOptions(params.Limit, utils.OrEmpty(params.Continue), utils.OrEmpty(params.Sort), utils.OrEmpty(params.Search), "")
 	if err != nil {
-		log.Error("Unable to find the packages with Catalog ID '%s', details: %+v", catalogID, err)
-		c.AbortWithStatusJSON(err.Status, err)
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
 		return
 	}
-	c.JSON(http.StatusOK, packages)
+	options.Tag = utils.OrEmpty(params.Version)
+
+	packages, err := r.catalogService.ListPackages(catalogID, options)
+	if err != nil {
+		log.Ctx(c).Error("Unable to find the packages with Catalog ID '%s', details: %+v", catalogID, err)
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
+		return
+	}
+	listOK(c, packages)
 }
 
 func (r ICatalogController) GetPackage(c *gin.Context, catalogID string, name string) {
 	result, err := r.catalogService.GetPackage(catalogID, name)
 	if err != nil {
-		log.Error("Unable to find the package '%s' with Catalog ID '%s', details: %+v", name, catalogID, err)
-		c.AbortWithStatusJSON(err.Status, err)
+		log.Ctx(c).Error("Unable to find the package '%s' with Catalog ID '%s', details: %+v", name, catalogID, err)
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
 		return
 	}
 	c.JSON(http.StatusOK, result)
@@ -74,20 +84,20 @@ func (r ICatalogController) GetPackageVersions(c *gin.Context, catalogID string,
 }
 
 func (r ICatalogController) GetPackageDefinition(c *gin.Context, catalogID string, name string, version string) {
-	definition, err := r.catalogService.GetPackageDefinition(catalogID, name, version)
+	definition, err := r.catalogService.GetPackageDefinition(c.Request.Context(), catalogID, name, version)
 	if err != nil {
-		log.Error("Unable to find the package definition for package '%s:%s' with Catalog ID '%s', details: %+v", name, version, catalogID, err)
-		c.AbortWithStatusJSON(err.Status, err)
+		log.Ctx(c).Error("Unable to find the package definition for package '%s:%s' with Catalog ID '%s', details: %+v", name, version, catalogID, err)
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
 		return
 	}
 	c.JSON(http.StatusOK, definition)
 }
 
 func (r ICatalogController) GetPackageSchema(c *gin.Context, catalogID string, name string, version string) {
-	definition, err := r.catalogService.GetPackageDefinition(catalogID, name, version)
+	definition, err := r.catalogService.GetPackageDefinition(c.Request.Context(), catalogID, name, version)
 	if err != nil {
-		log.Error("Unable to find the package definition for package '%s:%s' with Catalog ID '%s', details: %+v", name, version, catalogID, err)
-		c.AbortWithStatusJSON(err.Status, err)
+		log.Ctx(c).Error("Unable to find the package definition for package '%s:%s' with Catalog ID '%s', details: %+v", name, version, catalogID, err)
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
 		return
 	}
 	schema, ok := definition["schema"]
@@ -97,3 +107,30 @@ func (r ICatalogController) GetPackageSchema(c *gin.Context, catalogID string, n
 	}
 	c.JSON(http.StatusOK, schema)
 }
+
+func (r ICatalogController) GetReleaseSkeleton(c *gin.Context, catalogID string, name string, version string, params _api.GetReleaseSkeletonParams) {
+	skeleton, err := r.catalogService.GetReleaseSkeleton(c.Request.Context(), catalogID, name, version, utils.OrEmpty(params.ReleaseName), utils.OrEmpty(params.Namespace), utils.OrEmpty(params.TargetNamespace))
+	if err != nil {
+		log.Ctx(c).Error("Unable to generate a release for package '%s:%s' with Catalog ID '%s', details: %+v", name, version, catalogID, err)
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
+		return
+	}
+	c.JSON(http.StatusOK, skeleton)
+}
+
+func (r ICatalogController) GetPackageJSONSchema(c *gin.Context, catalogID string, name string, version string, params _api.GetPackageJSONSchemaParams) {
+	jsonSchema, err := r.catalogService.GetPackageJSONSchema(c.Request.Context(), catalogID, name, version)
+	if err != nil {
+		log.Ctx(c).Error("Unable to convert the schema of package '%s:%s' with Catalog ID '%s', details: %+v", name, version, catalogID, err)
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
+		return
+	}
+	switch _api.GetPackageJSONSchemaParamsSection(utils.OrEmpty(params.Section)) {
+	case _api.Parameters:
+		c.JSON(http.StatusOK, jsonSchema.Parameters)
+	case _api.Context:
+		c.JSON(http.StatusOK, jsonSchema.Context)
+	default:
+		c.JSON(http.StatusOK, jsonSchema)
+	}
+}
@@ -20,9 +20,12 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	_api "github.com/okdp/okdp-server/api/openapi/v3/_api/clusters"
+	"github.com/okdp/okdp-server/internal/audit"
 	log "github.com/okdp/okdp-server/internal/common/logging"
 	"github.com/okdp/okdp-server/internal/model"
 	"github.com/okdp/okdp-server/internal/services"
+	"github.com/okdp/okdp-server/internal/utils"
 )
 
 type IClusterController struct {
@@ -43,28 +46,35 @@ func (r IClusterController) ListClusters(c *gin.Context) {
 func (r IClusterController) GetCluster(c *gin.Context, clusterID string) {
 	cluster, err := r.clusterService.GetCluster(clusterID)
 	if err != nil {
-		log.Error("%+v", clusterID, err)
-		c.AbortWithStatusJSON(err.Status, err)
+		log.Ctx(c).Error("%+v", clusterID, err)
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
 		return
 	}
 	c.JSON(http.StatusOK, cluster)
 }
 
-func (r IClusterController) ListNamespaces(c *gin.Context, clusterID string) {
-	namespaces, err := r.clusterService.ListNamespaces(clusterID)
+func (r IClusterController) ListNamespaces(c *gin.Context, clusterID string, params _api.ListNamespacesParams) {
+	options, err := model.NewListOptions(params.Limit, utils.OrEmpty(params.Continue), utils.OrEmpty(params.Sort), utils.OrEmpty(params.Search), utils.OrEmpty(params.LabelSelector))
 	if err != nil {
-		log.Error("%+v", clusterID, err)
-		c.AbortWithStatusJSON(err.Status, err)
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
 		return
 	}
-	c.JSON(http.StatusOK, namespaces)
+	options.Status = utils.OrEmpty(params.Status)
+
+	namespaces, err := r.clusterService.ListNamespacesPage(c.Request.Context(), clusterID, options)
+	if err != nil {
+		log.Ctx(c).Error("%+v", clusterID, err)
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
+		return
+	}
+	listOK(c, namespaces)
 }
 
 func (r IClusterController) GetNamespace(c *gin.Context, clusterID string, namespace string) {
-	ns, err := r.clusterService.GetNamespaceByName(clusterID, namespace)
+	ns, err := r.clusterService.GetNamespaceByName(c.Request.Context(), clusterID, namespace)
 	if err != nil {
-		log.Error("%+v", clusterID, err)
-		c.AbortWithStatusJSON(err.Status, err)
+		log.Ctx(c).Error("%+v", clusterID, err)
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
 		return
 	}
 	c.JSON(http.StatusOK, ns)
@@ -74,14 +84,14 @@ func (r IClusterController) CreateNamespace(c *gin.Context, clusterID string) {
 	var namespace model.Namespace
 
 	if err := c.ShouldBindJSON(&namespace); err != nil {
-		resp := model.NewServerResponse(model.OkdpServerResponse).BadRequest("%+v", err.Error())
-		c.AbortWithStatusJSON(resp.Status, resp)
+		resp := model.BindingError(err)
+		c.AbortWithStatusJSON(resp.Status, resp.ForRequest(c))
 		return
 	}
 
-	response := r.clusterService.CreateNamespace(clusterID, &namespace)
+	response := r.clusterService.CreateNamespace(c.Request.Context(), clusterID, &namespace)
 
-	c.JSON(response.Status, response)
+	c.JSON(response.Status, response.ForRequest(c))
 
 }
 
@@ -89,17 +99,20 @@ func (r IClusterController) UpdateNamespace(c *gin.Context, clusterID string) {
 	var namespace model.Namespace
 
 	if err := c.ShouldBindJSON(&namespace); err != nil {
-		resp := model.NewServerResponse(model.OkdpServerResponse).BadRequest("%+v", err.Error())
-		c.AbortWithStatusJSON(resp.Status, resp)
+		resp := model.BindingError(err)
+		c.AbortWithStatusJSON(resp.Status, resp.ForRequest(c))
 		return
 	}
 
-	response := r.clusterService.UpdateNamespace(clusterID, &namespace)
+	audit.SetPrevious(c, func() (*model.Namespace, *model.ServerResponse) {
+		return r.clusterService.GetNamespaceByName(c.Request.Context(), clusterID, namespace.Metadata.Name)
+	})
+	response := r.clusterService.UpdateNamespace(c.Request.Context(), clusterID, &namespace, "")
 
-	c.JSON(response.Status, response)
+	c.JSON(response.Status, response.ForRequest(c))
 }
 
 func (r IClusterController) DeleteNamespace(c *gin.Context, clusterID string, namespace string) {
-	response := r.clusterService.DeleteNamespace(clusterID, namespace)
-	c.JSON(response.Status, response)
+	response := r.clusterService.DeleteNamespace(c.Request.Context(), clusterID, namespace, "")
+	c.JSON(response.Status, response.ForRequest(c))
 }
@@ -0,0 +1,35 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package controllers
+
+import (
+	"strings"
+
+	"github.com/okdp/okdp-server/internal/model"
+	"github.com/okdp/okdp-server/internal/utils"
+)
+
+// parseIfMatch returns the resource version of the If-Match header. The updates are conditioned by a single
+// resource version, so the lists of entity tags are rejected
+func parseIfMatch(header *string) (string, *model.ServerResponse) {
+	ifMatch := utils.OrEmpty(header)
+	if strings.Contains(ifMatch, ",") {
+		return "", model.NewServerResponse(model.OkdpServerResponse).
+			BadRequest("The If-Match header must contain a single entity tag, got '%s'", ifMatch)
+	}
+	return utils.ParseETag(ifMatch), nil
+}
@@ -0,0 +1,277 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+
+	"github.com/okdp/okdp-server/internal/audit"
+	log "github.com/okdp/okdp-server/internal/common/logging"
+	"github.com/okdp/okdp-server/internal/config"
+	"github.com/okdp/okdp-server/internal/model"
+	"github.com/okdp/okdp-server/internal/security/authz"
+)
+
+const (
+	execWriteTimeout   = 10 * time.Second
+	execMaxMessageSize = 1 << 20
+)
+
+var execUpgrader = websocket.Upgrader{
+	CheckOrigin: allowedOrigin,
+}
+
+// allowedOrigin accepts the WebSocket handshakes without origin (non browser clients), from the same host
+// or from one of the CORS allowed origins listed explicitly. The browsers do not apply CORS to the WebSockets,
+// the wildcard (*) origin would let any page visited by the user open an exec session with its session cookie.
+func allowedOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	if u, err := url.Parse(origin); err == nil && strings.EqualFold(u.Host, r.Host) {
+		return true
+	}
+	for _, allowed := range config.GetAppConfig().Security.Cors.AllowedOrigins {
+		if allowed != "*" && strings.EqualFold(allowed, origin) {
+			return true
+		}
+	}
+	return false
+}
+
+// execSession bridges the WebSocket of a client with an exec session into a container
+type execSession struct {
+	conn       *websocket.Conn
+	writeMutex sync.Mutex
+	endMutex   sync.Mutex
+	cancel     context.CancelFunc
+	reason     string
+	outcome    model.AuditEventOutcome
+}
+
+// execFunc runs the command with the streams of the session until the command exits or the context is done
+type execFunc func(ctx context.Context, options *model.ExecOptions) (int, *model.ServerResponse)
+
+// runExecSession runs the command over the WebSocket until the command exits, the client closes the session,
+// or the user is no longer allowed. The start and the end of the session are recorded in the audit log.
+func runExecSession(c *gin.Context, conn *websocket.Conn, command []string, tty bool, exec execFunc) {
+	ctx, cancel := context.WithCancel(c.Request.Context())
+	defer cancel()
+	session := &execSession{conn: conn, cancel: cancel}
+	defer session.close()
+
+	stdin, stdinWriter := io.Pipe()
+	defer stdin.Close()
+	resize := make(chan model.TerminalSize, 1)
+	go session.read(ctx, stdinWriter, resize, tty)
+	go session.keepAlive(ctx, c)
+
+	stdout := &execWriter{session: session, messageType: model.ExecStdout}
+	stderr := &execWriter{session: session, messageType: model.ExecStderr}
+	options := &model.ExecOptions{
+		Command: command,
+		TTY:     tty,
+		Stdin:   stdin,
+		Stdout:  stdout,
+		Stderr:  stderr,
+	}
+	if tty {
+		options.Resize = resize
+	}
+
+	log.Ctx(c).Info("Starting the exec session %s: %s", c.Request.URL.Path, strings.Join(command, " "))
+	audit.RecordSession(c, model.AuditExecStart, model.AuditSuccess, fmt.Sprintf("Exec session started: %s", strings.Join(command, " ")))
+	start := time.Now()
+
+	code, err := exec(ctx, options)
+	stdout.flush()
+	stderr.flush()
+	duration := time.Since(start).Round(time.Second)
+
+	if reason, outcome, ended := session.ended(); ended {
+		log.Ctx(c).Info("The exec session %s ended after %s: %s", c.Request.URL.Path, duration, reason)
+		audit.RecordSession(c, model.AuditExecEnd, outcome, fmt.Sprintf("Exec session ended after %s: %s", duration, reason))
+		return
+	}
+	if err != nil {
+		log.Ctx(c).Error("The exec session %s failed after %s, details: %+v", c.Request.URL.Path, duration, err)
+		_ = session.send(&model.ExecMessage{Type: model.ExecError, Message: err.Message})
+		audit.RecordSession(c, model.AuditExecEnd, model.AuditFailure, fmt.Sprintf("Exec session failed after %s: %s", duration, err.Message))
+		return
+	}
+	log.Ctx(c).Info("The exec session %s ended after %s with exit code %d", c.Request.URL.Path, duration, code)
+	_ = session.send(&model.ExecMessage{Type: model.ExecExit, Code: &code})
+	audit.RecordSession(c, model.AuditExecEnd, model.AuditSuccess, fmt.Sprintf("Exec session ended after %s with exit code %d", duration, code))
+}
+
+// read forwards the stdin and the terminal sizes sent by the client until the WebSocket is closed
+func (s *execSession) read(ctx context.Context, stdin *io.PipeWriter, resize chan<- model.TerminalSize, tty bool) {
+	defer close(resize)
+	s.conn.SetReadLimit(execMaxMessageSize)
+	s.extendReadDeadline()
+	s.conn.SetPongHandler(func(string) error {
+		s.extendReadDeadline()
+		return nil
+	})
+
+	for {
+		_, data, err := s.conn.ReadMessage()
+		if err != nil {
+			stdin.CloseWithError(err)
+			s.end("the client closed the session", model.AuditSuccess)
+			return
+		}
+		var message model.ExecMessage
+		if err := json.Unmarshal(data, &message); err != nil {
+			log.Ctx(ctx).Warn("Ignoring the malformed message of the exec session: %v", err)
+			continue
+		}
+		switch message.Type {
+		case model.ExecStdin:
+			// the write fails once the command no longer reads its stdin
+			_, _ = stdin.Write([]byte(message.Data))
+		case model.ExecResize:
+			if !tty || message.Cols == 0 || message.Rows == 0 {
+				continue
+			}
+			select {
+			case resize <- model.TerminalSize{Cols: message.Cols, Rows: message.Rows}:
+			case <-ctx.Done():
+				return
+			}
+		default:
+			log.Ctx(ctx).Warn("Ignoring the message of the exec session with the unsupported type '%s'", message.Type)
+		}
+	}
+}
+
+// keepAlive pings the client periodically and ends the session when the user is no longer allowed
+func (s *execSession) keepAlive(ctx context.Context, c *gin.Context) {
+	ticker := time.NewTicker(defaultHeartbeat * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if !authz.StillAllowed(c) {
+				log.Ctx(ctx).Warn("Ending the exec session %s: the user is no longer allowed", c.Request.URL.Path)
+				_ = s.send(&model.ExecMessage{Type: model.ExecError, Message: "The user is no longer allowed to exec into the container"})
+				s.end("the user is no longer allowed", model.AuditFailure)
+				return
+			}
+			if err := s.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(execWriteTimeout)); err != nil {
+				s.end("the client is no longer reachable", model.AuditSuccess)
+				return
+			}
+		}
+	}
+}
+
+// send writes the message to the client, the messages are written by the stdout, the stderr and the heartbeat
+func (s *execSession) send(message *model.ExecMessage) error {
+	s.writeMutex.Lock()
+	defer s.writeMutex.Unlock()
+	_ = s.conn.SetWriteDeadline(time.Now().Add(execWriteTimeout))
+	return s.conn.WriteJSON(message)
+}
+
+// end ends the session for the reason, only the first reason is kept
+func (s *execSession) end(reason string, outcome model.AuditEventOutcome) {
+	s.endMutex.Lock()
+	if s.reason == "" {
+		s.reason = reason
+		s.outcome = outcome
+	}
+	s.endMutex.Unlock()
+	s.cancel()
+}
+
+// ended returns the reason the session was ended for, if it was ended before the command exited
+func (s *execSession) ended() (string, model.AuditEventOutcome, bool) {
+	s.endMutex.Lock()
+	defer s.endMutex.Unlock()
+	return s.reason, s.outcome, s.reason != ""
+}
+
+func (s *execSession) extendReadDeadline() {
+	_ = s.conn.SetReadDeadline(time.Now().Add(2 * defaultHeartbeat * time.Second))
+}
+
+func (s *execSession) close() {
+	_ = s.conn.WriteControl(websocket.CloseMessage,
+		websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(execWriteTimeout))
+	_ = s.conn.Close()
+}
+
+// execWriter sends the output of the command as messages of its type. The bytes of an incomplete UTF-8 character
+// are held until the next write, as the JSON messages only carry valid UTF-8 text.
+type execWriter struct {
+	session     *execSession
+	messageType string
+	pending     []byte
+}
+
+func (w *execWriter) Write(data []byte) (int, error) {
+	w.pending = append(w.pending, data...)
+	complete := len(w.pending) - incompleteRuneSuffix(w.pending)
+	if complete == 0 {
+		return len(data), nil
+	}
+	if err := w.session.send(&model.ExecMessage{Type: w.messageType, Data: string(w.pending[:complete])}); err != nil {
+		return 0, err
+	}
+	w.pending = w.pending[complete:]
+	return len(data), nil
+}
+
+// flush sends the remaining bytes at the end of the session
+func (w *execWriter) flush() {
+	if len(w.pending) > 0 {
+		_ = w.session.send(&model.ExecMessage{Type: w.messageType, Data: string(w.pending)})
+		w.pending = nil
+	}
+}
+
+// incompleteRuneSuffix returns the number of bytes at the end of the data which start a UTF-8 character
+// without completing it
+func incompleteRuneSuffix(data []byte) int {
+	for i := 1; i < utf8.UTFMax && i <= len(data); i++ {
+		b := data[len(data)-i]
+		if utf8.RuneStart(b) {
+			if utf8.FullRune(data[len(data)-i:]) {
+				return 0
+			}
+			return i
+		}
+	}
+	return 0
+}
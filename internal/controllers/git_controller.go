@@ -21,9 +21,13 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	_api "github.com/okdp/okdp-server/api/openapi/v3/_api/repositories"
+	"github.com/okdp/okdp-server/internal/audit"
+	"github.com/okdp/okdp-server/internal/common/constants"
 	log "github.com/okdp/okdp-server/internal/common/logging"
 	"github.com/okdp/okdp-server/internal/model"
 	"github.com/okdp/okdp-server/internal/services"
+	"github.com/okdp/okdp-server/internal/utils"
 )
 
 type IGitRepoController struct {
@@ -37,105 +41,246 @@ func GitRepoController() *IGitRepoController {
 }
 
 func (r IGitRepoController) ListGitRepos(c *gin.Context, clusterID string, namespace string) {
-	gitRepos, err := r.gitRepoService.ListGitRepos(clusterID, namespace)
+	gitRepos, err := r.gitRepoService.ListGitRepos(c.Request.Context(), clusterID, namespace)
 	if err != nil {
-		log.Error("Unable to list Git repos on namespace '%s' with cluster ID '%s', details: %+v", namespace, clusterID, err)
-		c.AbortWithStatusJSON(err.Status, err)
+		log.Ctx(c).Error("Unable to list Git repos on namespace '%s' with cluster ID '%s', details: %+v", namespace, clusterID, err)
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
 		return
 	}
 	c.JSON(http.StatusOK, gitRepos)
 }
 
 func (r IGitRepoController) GetGitRepo(c *gin.Context, clusterID string, namespace string, kustomizationName string) {
-	gitRepo, err := r.gitRepoService.GetGitRepo(clusterID, namespace, kustomizationName)
+	gitRepo, err := r.gitRepoService.GetGitRepo(c.Request.Context(), clusterID, namespace, kustomizationName)
 	if err != nil {
-		log.Error("Unable to find Git repo '%s' on namespace '%s' with cluster id '%s', details: %+v", kustomizationName, namespace, clusterID, err)
-		c.AbortWithStatusJSON(err.Status, err)
+		log.Ctx(c).Error("Unable to find Git repo '%s' on namespace '%s' with cluster id '%s', details: %+v", kustomizationName, namespace, clusterID, err)
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
 		return
 	}
 	c.JSON(http.StatusOK, gitRepo)
 }
 
-func (r IGitRepoController) ListGitReleases(c *gin.Context, clusterID string, namespace string, kustomizationName string) {
-	releasesInfo, err := r.gitRepoService.ListReleases(clusterID, namespace, kustomizationName)
+func (r IGitRepoController) ListGitReleases(c *gin.Context, clusterID string, namespace string, kustomizationName string, params _api.ListGitReleasesParams) {
+	options, err := model.NewListOptions(params.Limit, utils.OrEmpty(params.Continue), utils.OrEmpty(params.Sort), utils.OrEmpty(params.Search), "")
 	if err != nil {
-		log.Error("Unable to get releases from Git repo '%s/%s' on cluster ID '%s', details: %+v", namespace, kustomizationName, clusterID, err)
-		c.AbortWithStatusJSON(err.Status, err)
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
 		return
 	}
-	c.JSON(http.StatusOK, releasesInfo)
+	options.Package = utils.OrEmpty(params.Package)
+	options.Tag = utils.OrEmpty(params.Tag)
+
+	releasesInfo, err := r.gitRepoService.ListReleasesPage(c.Request.Context(), clusterID, namespace, kustomizationName, options)
+	if err != nil {
+		log.Ctx(c).Error("Unable to get releases from Git repo '%s/%s' on cluster ID '%s', details: %+v", namespace, kustomizationName, clusterID, err)
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
+		return
+	}
+	listOK(c, releasesInfo)
 }
 
 func (r IGitRepoController) GetGitRelease(c *gin.Context, clusterID string, namespace string, kustomizationName string, releaseName string) {
-	release, err := r.gitRepoService.GetRelease(clusterID, namespace, kustomizationName, releaseName)
+	release, version, err := r.gitRepoService.GetReleaseVersion(c.Request.Context(), clusterID, namespace, kustomizationName, releaseName)
 	if err != nil {
-		log.Error("Unable to get release from Git repo '%s/%s' on cluster ID '%s', details: %+v", namespace, kustomizationName, clusterID, err)
-		c.AbortWithStatusJSON(err.Status, err)
+		log.Ctx(c).Error("Unable to get release from Git repo '%s/%s' on cluster ID '%s', details: %+v", namespace, kustomizationName, clusterID, err)
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
 		return
 	}
+	c.Header(constants.ETagHeader, utils.ETag(version))
 	c.JSON(http.StatusOK, release)
 }
 
 func (r IGitRepoController) CreateGitRelease(c *gin.Context, clusterID string, namespace string, kustomizationName string) {
+	userInfo, err := GetUserInfo(c)
+	if err != nil {
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
+		return
+	}
+
+	releases, bulk, err := bindReleases(c)
+	if err != nil {
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
+		return
+	}
+
+	create := func(release *model.Release) (*model.Release, *model.ServerResponse) {
+		msg := fmt.Sprintf("Create new KuboCD release %s/%s on cluster id %s", namespace, release.Name, clusterID)
+		commitOpts := model.NewGitCommitOptions(msg).
+			Author(userInfo.Name).
+			Email(userInfo.Email)
+		return r.gitRepoService.CreateGitRelease(c.Request.Context(), clusterID, namespace, kustomizationName, release, commitOpts)
+	}
+
+	if !bulk {
+		resp, err := create(&releases[0])
+		if err != nil {
+			c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
+			return
+		}
+		c.JSON(http.StatusCreated, resp)
+		return
+	}
+
+	responses := []*model.ServerResponse{}
+	for i := range releases {
+		if _, err := create(&releases[i]); err != nil {
+			responses = append(responses, err)
+			continue
+		}
+		responses = append(responses, model.NewServerResponse(model.GitRepoResponse).
+			Created("Release %s/%s successfully created in the git repo", releases[i].Namespace, releases[i].Name))
+	}
+	bulkCreated(c, responses)
+
+}
+
+func (r IGitRepoController) UpdateGitRelease(c *gin.Context, clusterID string, namespace string, kustomizationName string, params _api.UpdateGitReleaseParams) {
 	var release model.Release
 	userInfo, err := GetUserInfo(c)
 	if err != nil {
-		c.AbortWithStatusJSON(err.Status, err)
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
 	}
 
 	if err := c.ShouldBindJSON(&release); err != nil {
-		resp := model.NewServerResponse(model.OkdpServerResponse).BadRequest("%+v", err.Error())
-		c.AbortWithStatusJSON(resp.Status, resp)
+		resp := model.BindingError(err)
+		c.AbortWithStatusJSON(resp.Status, resp.ForRequest(c))
 		return
 	}
 
-	msg := fmt.Sprintf("Create new KuboCD release %s/%s on cluster id %s", namespace, release.Name, clusterID)
+	audit.SetPrevious(c, func() (*model.Release, *model.ServerResponse) {
+		return r.gitRepoService.GetRelease(c.Request.Context(), clusterID, namespace, kustomizationName, release.Name)
+	})
+	msg := fmt.Sprintf("Update KuboCD release %s/%s on cluster id %s", namespace, release.Name, clusterID)
 	commitOpts := model.NewGitCommitOptions(msg).
 		Author(userInfo.Name).
 		Email(userInfo.Email)
-	resp, err := r.gitRepoService.CreateGitRelease(clusterID, namespace, kustomizationName, &release, commitOpts)
-
+	ifMatch, err := parseIfMatch(params.IfMatch)
 	if err != nil {
-		c.AbortWithStatusJSON(err.Status, err)
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
 		return
 	}
+	resp, err := r.gitRepoService.UpdateGitRelease(c.Request.Context(), clusterID, namespace, kustomizationName, &release, commitOpts, ifMatch)
 
-	c.JSON(http.StatusCreated, resp)
+	if err != nil {
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
+		return
+	}
 
+	c.JSON(http.StatusOK, resp)
 }
 
-func (r IGitRepoController) UpdateGitRelease(c *gin.Context, clusterID string, namespace string, kustomizationName string) {
-	var release model.Release
+func (r IGitRepoController) PatchGitRelease(c *gin.Context, clusterID string, namespace string, kustomizationName string, releaseName string, params _api.PatchGitReleaseParams) {
 	userInfo, err := GetUserInfo(c)
 	if err != nil {
-		c.AbortWithStatusJSON(err.Status, err)
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
+		return
 	}
 
-	if err := c.ShouldBindJSON(&release); err != nil {
-		resp := model.NewServerResponse(model.OkdpServerResponse).BadRequest("%+v", err.Error())
-		c.AbortWithStatusJSON(resp.Status, resp)
+	patch, err := bindPatch(c)
+	if err != nil {
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
 		return
 	}
 
-	msg := fmt.Sprintf("Update KuboCD release %s/%s on cluster id %s", namespace, release.Name, clusterID)
+	audit.SetPrevious(c, func() (*model.Release, *model.ServerResponse) {
+		return r.gitRepoService.GetRelease(c.Request.Context(), clusterID, namespace, kustomizationName, releaseName)
+	})
+	msg := fmt.Sprintf("Patch KuboCD release %s/%s on cluster id %s", namespace, releaseName, clusterID)
 	commitOpts := model.NewGitCommitOptions(msg).
 		Author(userInfo.Name).
 		Email(userInfo.Email)
-	resp, err := r.gitRepoService.UpdateGitRelease(clusterID, namespace, kustomizationName, &release, commitOpts)
+	ifMatch, err := parseIfMatch(params.IfMatch)
+	if err != nil {
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
+		return
+	}
+	release, version, err := r.gitRepoService.PatchGitRelease(c.Request.Context(), clusterID, namespace, kustomizationName, releaseName, patch, commitOpts, utils.OrFalse(params.DryRun), ifMatch)
+	if err != nil {
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
+		return
+	}
 
+	if version != "" {
+		c.Header(constants.ETagHeader, utils.ETag(version))
+	}
+	c.JSON(http.StatusOK, release)
+}
+
+func (r IGitRepoController) GetGitReleasesDrift(c *gin.Context, clusterID string, namespace string, kustomizationName string) {
+	drift, err := r.gitRepoService.GetDrift(c.Request.Context(), clusterID, namespace, kustomizationName)
 	if err != nil {
-		c.AbortWithStatusJSON(err.Status, err)
+		log.Ctx(c).Error("Unable to compare Git repo '%s/%s' with the cluster ID '%s', details: %+v", namespace, kustomizationName, clusterID, err)
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
 		return
 	}
+	c.JSON(http.StatusOK, drift)
+}
 
-	c.JSON(http.StatusOK, resp)
+func (r IGitRepoController) RunKustomizationAction(c *gin.Context, clusterID string, namespace string, kustomizationName string, action _api.RunKustomizationActionParamsAction, params _api.RunKustomizationActionParams) {
+	result, err := r.gitRepoService.RunKustomizationAction(c.Request.Context(), clusterID, namespace, kustomizationName, model.Action(action), actionTimeout(params.Wait, params.WaitTimeout))
+	if err != nil {
+		log.Ctx(c).Error("Unable to %s kustomization '%s/%s' on cluster ID '%s', details: %+v", action, namespace, kustomizationName, clusterID, err)
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+func (r IGitRepoController) RunGitSourceAction(c *gin.Context, clusterID string, namespace string, kustomizationName string, action _api.RunGitSourceActionParamsAction, params _api.RunGitSourceActionParams) {
+	result, err := r.gitRepoService.RunGitSourceAction(c.Request.Context(), clusterID, namespace, kustomizationName, model.Action(action), actionTimeout(params.Wait, params.WaitTimeout))
+	if err != nil {
+		log.Ctx(c).Error("Unable to %s the git repository of kustomization '%s/%s' on cluster ID '%s', details: %+v", action, namespace, kustomizationName, clusterID, err)
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+func (r IGitRepoController) GetGitReleaseHistory(c *gin.Context, clusterID string, namespace string, kustomizationName string, releaseName string) {
+	revisions, err := r.gitRepoService.GetGitReleaseHistory(c.Request.Context(), clusterID, namespace, kustomizationName, releaseName)
+	if err != nil {
+		log.Ctx(c).Error("Unable to get the history of release '%s' from Git repo '%s/%s' on cluster ID '%s', details: %+v", releaseName, namespace, kustomizationName, clusterID, err)
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
+		return
+	}
+	c.JSON(http.StatusOK, revisions)
+}
+
+func (r IGitRepoController) RollbackGitRelease(c *gin.Context, clusterID string, namespace string, kustomizationName string, releaseName string, params _api.RollbackGitReleaseParams) {
+	userInfo, err := GetUserInfo(c)
+	if err != nil {
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
+		return
+	}
+
+	audit.SetPrevious(c, func() (*model.Release, *model.ServerResponse) {
+		return r.gitRepoService.GetRelease(c.Request.Context(), clusterID, namespace, kustomizationName, releaseName)
+	})
+	msg := fmt.Sprintf("Rollback KuboCD release %s/%s on cluster id %s to revision %d", namespace, releaseName, clusterID, params.Revision)
+	commitOpts := model.NewGitCommitOptions(msg).
+		Author(userInfo.Name).
+		Email(userInfo.Email)
+	ifMatch, err := parseIfMatch(params.IfMatch)
+	if err != nil {
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
+		return
+	}
+	release, version, err := r.gitRepoService.RollbackGitRelease(c.Request.Context(), clusterID, namespace, kustomizationName, releaseName, params.Revision, commitOpts, utils.OrFalse(params.DryRun), ifMatch)
+	if err != nil {
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
+		return
+	}
+
+	if version != "" {
+		c.Header(constants.ETagHeader, utils.ETag(version))
+	}
+	c.JSON(http.StatusOK, release)
 }
 
-func (r IGitRepoController) DeleteGitRelease(c *gin.Context, clusterID string, namespace string, kustomizationName string, releaseName string) {
+func (r IGitRepoController) DeleteGitRelease(c *gin.Context, clusterID string, namespace string, kustomizationName string, releaseName string, params _api.DeleteGitReleaseParams) {
 
 	userInfo, err := GetUserInfo(c)
 	if err != nil {
-		c.AbortWithStatusJSON(err.Status, err)
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
 		return
 	}
 
@@ -144,8 +289,13 @@ func (r IGitRepoController) DeleteGitRelease(c *gin.Context, clusterID string, n
 		Author(userInfo.Name).
 		Email(userInfo.Email)
 
-	resp := r.gitRepoService.DeleteGitRelease(clusterID, namespace, kustomizationName, releaseName, commitOpts)
+	ifMatch, err := parseIfMatch(params.IfMatch)
+	if err != nil {
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
+		return
+	}
+	resp := r.gitRepoService.DeleteGitRelease(c.Request.Context(), clusterID, namespace, kustomizationName, releaseName, commitOpts, ifMatch)
 
-	c.JSON(http.StatusOK, resp)
+	c.JSON(resp.Status, resp.ForRequest(c))
 
 }
@@ -4,6 +4,8 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+
+	"github.com/okdp/okdp-server/internal/services"
 )
 
 func Healthz(c *gin.Context) {
@@ -12,8 +14,20 @@ func Healthz(c *gin.Context) {
 	})
 }
 
-func Readiness(c *gin.Context) {
-	c.JSON(http.StatusOK, gin.H{
-		"status": "ready",
-	})
+// Readiness reports the server as not ready while the informers of a cluster are syncing, the clusters whose
+// informers did not sync within the sync timeout are read from their API server and no longer count
+func Readiness() gin.HandlerFunc {
+	healthService := services.NewHealthService()
+	return func(c *gin.Context) {
+		if unsynced := healthService.UnsyncedClusters(); len(unsynced) > 0 {
+			c.JSON(http.StatusServiceUnavailable, gin.H{
+				"status":         "not ready",
+				"unsyncedCaches": unsynced,
+			})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{
+			"status": "ready",
+		})
+	}
 }
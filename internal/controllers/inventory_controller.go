@@ -0,0 +1,89 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package controllers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	_api "github.com/okdp/okdp-server/api/openapi/v3/_api/inventory"
+	"github.com/okdp/okdp-server/internal/model"
+	"github.com/okdp/okdp-server/internal/services"
+	"github.com/okdp/okdp-server/internal/utils"
+)
+
+const defaultInventoryTimeout = 10
+
+type IInventoryController struct {
+	inventoryService *services.InventoryService
+}
+
+func InventoryController() *IInventoryController {
+	return &IInventoryController{
+		inventoryService: services.NewInventoryService(),
+	}
+}
+
+func (r IInventoryController) GetReleasesInventory(c *gin.Context, params _api.GetReleasesInventoryParams) {
+	options, err := model.NewListOptions(nil, "", "", utils.OrEmpty(params.Search), utils.OrEmpty(params.LabelSelector))
+	if err != nil {
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
+		return
+	}
+	options.Status = utils.OrEmpty(params.Status)
+	options.Package = utils.OrEmpty(params.Package)
+	options.Tag = utils.OrEmpty(params.Tag)
+
+	inventory := r.inventoryService.Releases(c.Request.Context(), utils.OrEmpty(params.Env), inventoryTimeout(params.Timeout), options)
+	c.JSON(http.StatusOK, inventory)
+}
+
+func (r IInventoryController) GetProjectsInventory(c *gin.Context, params _api.GetProjectsInventoryParams) {
+	options, err := model.NewListOptions(nil, "", "", utils.OrEmpty(params.Search), utils.OrEmpty(params.LabelSelector))
+	if err != nil {
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
+		return
+	}
+	options.Package = utils.OrEmpty(params.Package)
+	options.Tag = utils.OrEmpty(params.Tag)
+
+	inventory := r.inventoryService.Projects(c.Request.Context(), utils.OrEmpty(params.Env), inventoryTimeout(params.Timeout), options)
+	c.JSON(http.StatusOK, inventory)
+}
+
+func (r IInventoryController) GetVersionsInventory(c *gin.Context, params _api.GetVersionsInventoryParams) {
+	options, err := model.NewListOptions(nil, "", "", "", "")
+	if err != nil {
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
+		return
+	}
+	options.Package = utils.OrEmpty(params.Package)
+	options.Tag = utils.OrEmpty(params.Tag)
+
+	inventory := r.inventoryService.Versions(c.Request.Context(), utils.OrEmpty(params.Env), inventoryTimeout(params.Timeout), options)
+	c.JSON(http.StatusOK, inventory)
+}
+
+// inventoryTimeout returns the timeout of the requests to each cluster
+func inventoryTimeout(timeout *int) time.Duration {
+	if timeout == nil || *timeout <= 0 {
+		return defaultInventoryTimeout * time.Second
+	}
+	return time.Duration(*timeout) * time.Second
+}
@@ -6,6 +6,8 @@ import (
 	"github.com/gin-gonic/gin"
 
 	_api "github.com/okdp/okdp-server/api/openapi/v3/_api/k8s"
+	"github.com/okdp/okdp-server/internal/audit"
+	"github.com/okdp/okdp-server/internal/common/constants"
 	log "github.com/okdp/okdp-server/internal/common/logging"
 	"github.com/okdp/okdp-server/internal/model"
 	"github.com/okdp/okdp-server/internal/services"
@@ -13,99 +15,231 @@ import (
 )
 
 type IKuboCDController struct {
-	k8sService *services.KuboCDService
-	podService *services.PodService
+	k8sService     *services.KuboCDService
+	podService     *services.PodService
+	upgradeService *services.UpgradeService
 }
 
 func KuboCDController() *IKuboCDController {
 	return &IKuboCDController{
-		k8sService: services.NewKuboCDService(),
-		podService: services.NewPodService(),
+		k8sService:     services.NewKuboCDService(),
+		podService:     services.NewPodService(),
+		upgradeService: services.NewUpgradeService(),
 	}
 }
 
-func (r IKuboCDController) ListK8sReleases(c *gin.Context, clusterID string, namespace string) {
-	releasesInfo, err := r.k8sService.ListReleases(clusterID, namespace)
+func (r IKuboCDController) ListK8sReleases(c *gin.Context, clusterID string, namespace string, params _api.ListK8sReleasesParams) {
+	options, err := model.NewListOptions(params.Limit, utils.OrEmpty(params.Continue), utils.OrEmpty(params.Sort), utils.OrEmpty(params.Search), utils.OrEmpty(params.LabelSelector))
 	if err != nil {
-		log.Error("Unable to get releases from Kubernetes cluster '%s' on namespace '%s', details: %+v", clusterID, namespace, err)
-		c.AbortWithStatusJSON(err.Status, err)
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
 		return
 	}
-	c.JSON(http.StatusOK, releasesInfo)
+	options.Status = utils.OrEmpty(params.Status)
+	options.Package = utils.OrEmpty(params.Package)
+	options.Tag = utils.OrEmpty(params.Tag)
+
+	releases, err := r.k8sService.ListReleases(c.Request.Context(), clusterID, namespace, options)
+	if err != nil {
+		log.Ctx(c).Error("Unable to get releases from Kubernetes cluster '%s' on namespace '%s', details: %+v", clusterID, namespace, err)
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
+		return
+	}
+	listOK(c, releases)
 }
 
 func (r IKuboCDController) GetK8sRelease(c *gin.Context, clusterID string, namespace string, releaseName string) {
-	release, err := r.k8sService.GetRelease(clusterID, namespace, releaseName)
+	release, err := r.k8sService.GetRelease(c.Request.Context(), clusterID, namespace, releaseName)
 	if err != nil {
-		log.Error("Unable to get release from Kubernetes cluster  '%s' on namespace '%s', details: %+v", clusterID, namespace, err)
-		c.AbortWithStatusJSON(err.Status, err)
+		log.Ctx(c).Error("Unable to get release from Kubernetes cluster  '%s' on namespace '%s', details: %+v", clusterID, namespace, err)
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
 		return
 	}
+	c.Header(constants.ETagHeader, utils.ETag(release.ResourceVersion))
 	c.JSON(http.StatusOK, release)
 }
 
 func (r IKuboCDController) GetK8sReleaseStatus(c *gin.Context, clusterID string, namespace string, releaseName string) {
-	release, err := r.k8sService.GetReleaseStatus(clusterID, namespace, releaseName)
+	release, err := r.k8sService.GetReleaseStatus(c.Request.Context(), clusterID, namespace, releaseName)
 	if err != nil {
-		log.Error("Unable to get release status from Kubernetes cluster  '%s' on namespace '%s', details: %+v", clusterID, namespace, err)
-		c.AbortWithStatusJSON(err.Status, err)
+		log.Ctx(c).Error("Unable to get release status from Kubernetes cluster  '%s' on namespace '%s', details: %+v", clusterID, namespace, err)
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
 		return
 	}
 	c.JSON(http.StatusOK, release)
 }
 
-func (r IKuboCDController) CreateK8sRelease(c *gin.Context, clusterID string, namespace string, params _api.CreateK8sReleaseParams) {
-	var release model.Release
+func (r IKuboCDController) GetK8sReleaseHealth(c *gin.Context, clusterID string, namespace string, releaseName string) {
+	health, err := r.k8sService.GetReleaseHealth(c.Request.Context(), clusterID, namespace, releaseName)
+	if err != nil {
+		log.Ctx(c).Error("Unable to get release health from Kubernetes cluster '%s' on namespace '%s', details: %+v", clusterID, namespace, err)
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
+		return
+	}
+	c.JSON(http.StatusOK, health)
+}
 
-	if err := c.ShouldBindJSON(&release); err != nil {
-		resp := model.NewServerResponse(model.OkdpServerResponse).BadRequest("%+v", err.Error())
-		c.AbortWithStatusJSON(resp.Status, resp)
+func (r IKuboCDController) GetK8sReleaseResourceTree(c *gin.Context, clusterID string, namespace string, releaseName string) {
+	tree, err := r.k8sService.GetReleaseResourceTree(c.Request.Context(), clusterID, namespace, releaseName)
+	if err != nil {
+		log.Ctx(c).Error("Unable to get release resource tree from Kubernetes cluster '%s' on namespace '%s', details: %+v", clusterID, namespace, err)
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
 		return
 	}
+	c.JSON(http.StatusOK, tree)
+}
 
-	response := r.k8sService.CreateRelease(clusterID, namespace, &release, utils.OrFalse(params.DryRun))
+func (r IKuboCDController) CreateK8sRelease(c *gin.Context, clusterID string, namespace string, params _api.CreateK8sReleaseParams) {
+	releases, bulk, err := bindReleases(c)
+	if err != nil {
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
+		return
+	}
 
-	c.JSON(response.Status, response)
+	author, email := userIdentity(c)
+	if !bulk {
+		response := r.k8sService.CreateRelease(c.Request.Context(), clusterID, namespace, &releases[0], utils.OrFalse(params.DryRun), author, email)
+		c.JSON(response.Status, response.ForRequest(c))
+		return
+	}
 
+	responses := []*model.ServerResponse{}
+	for i := range releases {
+		responses = append(responses, r.k8sService.CreateRelease(c.Request.Context(), clusterID, namespace, &releases[i], utils.OrFalse(params.DryRun), author, email))
+	}
+	bulkCreated(c, responses)
 }
 
 func (r IKuboCDController) UpdateK8sRelease(c *gin.Context, clusterID string, namespace string, params _api.UpdateK8sReleaseParams) {
 	var release model.Release
 
 	if err := c.ShouldBindJSON(&release); err != nil {
-		resp := model.NewServerResponse(model.OkdpServerResponse).BadRequest("%+v", err.Error())
-		c.AbortWithStatusJSON(resp.Status, resp)
+		resp := model.BindingError(err)
+		c.AbortWithStatusJSON(resp.Status, resp.ForRequest(c))
+		return
+	}
+
+	audit.SetPrevious(c, func() (*model.Release, *model.ServerResponse) {
+		return r.k8sService.GetRelease(c.Request.Context(), clusterID, namespace, release.Name)
+	})
+	author, email := userIdentity(c)
+	ifMatch, err := parseIfMatch(params.IfMatch)
+	if err != nil {
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
+		return
+	}
+	response := r.k8sService.UpdateRelease(c.Request.Context(), clusterID, namespace, &release, utils.OrFalse(params.DryRun), ifMatch, author, email)
+	c.JSON(response.Status, response.ForRequest(c))
+}
+
+func (r IKuboCDController) PatchK8sRelease(c *gin.Context, clusterID string, namespace string, releaseName string, params _api.PatchK8sReleaseParams) {
+	patch, err := bindPatch(c)
+	if err != nil {
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
+		return
+	}
+
+	audit.SetPrevious(c, func() (*model.Release, *model.ServerResponse) {
+		return r.k8sService.GetRelease(c.Request.Context(), clusterID, namespace, releaseName)
+	})
+	author, email := userIdentity(c)
+	ifMatch, err := parseIfMatch(params.IfMatch)
+	if err != nil {
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
 		return
 	}
+	release, err := r.k8sService.PatchRelease(c.Request.Context(), clusterID, namespace, releaseName, patch, utils.OrFalse(params.DryRun), ifMatch, author, email)
+	if err != nil {
+		log.Ctx(c).Error("Unable to patch release '%s/%s' on Kubernetes cluster '%s', details: %+v", namespace, releaseName, clusterID, err)
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
+		return
+	}
+	c.Header(constants.ETagHeader, utils.ETag(release.ResourceVersion))
+	c.JSON(http.StatusOK, release)
+}
 
-	response := r.k8sService.UpdateRelease(clusterID, namespace, &release, utils.OrFalse(params.DryRun))
-	c.JSON(response.Status, response)
+func (r IKuboCDController) GetK8sReleaseHistory(c *gin.Context, clusterID string, namespace string, releaseName string) {
+	revisions, err := r.k8sService.GetReleaseHistory(c.Request.Context(), clusterID, namespace, releaseName)
+	if err != nil {
+		log.Ctx(c).Error("Unable to get the history of release '%s/%s' on Kubernetes cluster '%s', details: %+v", namespace, releaseName, clusterID, err)
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
+		return
+	}
+	c.JSON(http.StatusOK, revisions)
 }
 
-func (r IKuboCDController) DeleteK8sRelease(c *gin.Context, clusterID string, namespace string, releaseName string) {
-	response := r.k8sService.DeleteRelease(clusterID, namespace, releaseName)
-	c.JSON(response.Status, response)
+func (r IKuboCDController) RollbackK8sRelease(c *gin.Context, clusterID string, namespace string, releaseName string, params _api.RollbackK8sReleaseParams) {
+	audit.SetPrevious(c, func() (*model.Release, *model.ServerResponse) {
+		return r.k8sService.GetRelease(c.Request.Context(), clusterID, namespace, releaseName)
+	})
+	author, email := userIdentity(c)
+	ifMatch, err := parseIfMatch(params.IfMatch)
+	if err != nil {
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
+		return
+	}
+	release, err := r.k8sService.RollbackRelease(c.Request.Context(), clusterID, namespace, releaseName, params.Revision, utils.OrFalse(params.DryRun), ifMatch, author, email)
+	if err != nil {
+		log.Ctx(c).Error("Unable to rollback release '%s/%s' on Kubernetes cluster '%s' to revision %d, details: %+v", namespace, releaseName, clusterID, params.Revision, err)
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
+		return
+	}
+	c.Header(constants.ETagHeader, utils.ETag(release.ResourceVersion))
+	c.JSON(http.StatusOK, release)
+}
+
+func (r IKuboCDController) RunK8sReleaseAction(c *gin.Context, clusterID string, namespace string, releaseName string, action _api.RunK8sReleaseActionParamsAction, params _api.RunK8sReleaseActionParams) {
+	result, err := r.k8sService.RunReleaseAction(c.Request.Context(), clusterID, namespace, releaseName, model.Action(action), actionTimeout(params.Wait, params.WaitTimeout))
+	if err != nil {
+		log.Ctx(c).Error("Unable to %s release '%s/%s' on Kubernetes cluster '%s', details: %+v", action, namespace, releaseName, clusterID, err)
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+func (r IKuboCDController) DeleteK8sRelease(c *gin.Context, clusterID string, namespace string, releaseName string, params _api.DeleteK8sReleaseParams) {
+	ifMatch, err := parseIfMatch(params.IfMatch)
+	if err != nil {
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
+		return
+	}
+	response := r.k8sService.DeleteRelease(c.Request.Context(), clusterID, namespace, releaseName, ifMatch)
+	c.JSON(response.Status, response.ForRequest(c))
 }
 
 func (r IKuboCDController) GetPods(c *gin.Context, clusterID string, namespace string, releaseName string) {
-	podInfos, err := r.podService.GetPods(clusterID, namespace, releaseName)
+	podInfos, err := r.podService.GetPods(c.Request.Context(), clusterID, namespace, releaseName)
 	if err != nil {
-		log.Error("Unable to get pods from Kubernetes cluster '%s' for release '%s/%s', details: %+v", clusterID, releaseName, namespace, err)
-		c.AbortWithStatusJSON(err.Status, err)
+		log.Ctx(c).Error("Unable to get pods from Kubernetes cluster '%s' for release '%s/%s', details: %+v", clusterID, releaseName, namespace, err)
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
 		return
 	}
 	c.JSON(http.StatusOK, podInfos)
 }
 
-func (r IKuboCDController) GetEventsRelease(c *gin.Context, clusterID string, namespace string, releaseName string) {
+func (r IKuboCDController) GetEventsRelease(c *gin.Context, clusterID string, namespace string, releaseName string, params _api.GetEventsReleaseParams) {
+	options, err := model.NewListOptions(params.Limit, utils.OrEmpty(params.Continue), utils.OrEmpty(params.Sort), utils.OrEmpty(params.Search), "")
+	if err != nil {
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
+		return
+	}
+	options.Status = utils.OrEmpty(params.Type)
+
+	events, err := r.k8sService.ListEventsRelease(c.Request.Context(), clusterID, namespace, releaseName, options)
+	if err != nil {
+		log.Ctx(c).Error("Unable to list the events of release '%s/%s' on Kubernetes cluster '%s', details: %+v", namespace, releaseName, clusterID, err)
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
+		return
+	}
+	listOK(c, events)
+}
 
-	events, err := r.k8sService.ListEventsRelease(clusterID, namespace, releaseName)
+func (r IKuboCDController) ListOutdatedReleases(c *gin.Context, clusterID string) {
+	releases, err := r.upgradeService.ListOutdatedReleases(c.Request.Context(), clusterID, "")
 	if err != nil {
-		c.AbortWithStatusJSON(err.Status, gin.H{
-			"error":   "failed to list events",
-			"details": err,
-		})
+		log.Ctx(c).Error("Unable to get the outdated releases of Kubernetes cluster '%s', details: %+v", clusterID, err)
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
 		return
 	}
-	c.JSON(200, events)
+	c.JSON(http.StatusOK, releases)
 }
@@ -0,0 +1,39 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/okdp/okdp-server/internal/common/constants"
+	"github.com/okdp/okdp-server/internal/model"
+	"github.com/okdp/okdp-server/internal/utils"
+)
+
+// listOK writes a page of a list, the total count and the next page cursor are returned in the headers
+func listOK[T any](c *gin.Context, result *model.ListResult[T]) {
+	if result.Total != nil {
+		c.Header(constants.TotalCountHeader, strconv.Itoa(*result.Total))
+	}
+	if result.Continue != "" {
+		c.Header(constants.ContinueHeader, result.Continue)
+	}
+	c.JSON(http.StatusOK, utils.NilToEmptySlice(result.Items))
+}
@@ -0,0 +1,33 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/okdp/okdp-server/internal/model"
+)
+
+// bindPatch reads the JSON Patch or JSON Merge Patch document of the request, according to its content type
+func bindPatch(c *gin.Context) (*model.Patch, *model.ServerResponse) {
+	data, err := c.GetRawData()
+	if err != nil {
+		return nil, model.NewServerResponse(model.OkdpServerResponse).
+			BadRequest("Unable to read the patch document: %v", err)
+	}
+	return model.NewPatch(c.ContentType(), data)
+}
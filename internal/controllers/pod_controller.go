@@ -18,15 +18,18 @@ package controllers
 
 import (
 	"bufio"
+	"context"
 	"fmt"
 	"io"
 	"net/http"
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
 
 	_api "github.com/okdp/okdp-server/api/openapi/v3/_api/pods"
 	log "github.com/okdp/okdp-server/internal/common/logging"
+	"github.com/okdp/okdp-server/internal/model"
 	"github.com/okdp/okdp-server/internal/services"
 )
 
@@ -53,9 +56,9 @@ func (r IPodController) GetLogs(c *gin.Context, clusterID, namespace, pod, conta
 		v := int64(*params.TailLines)
 		tailLines = &v
 	}
-	stream, err := r.podService.StreamLogs(clusterID, namespace, pod, container, tailLines, isSSE)
+	stream, err := r.podService.StreamLogs(c.Request.Context(), clusterID, namespace, pod, container, tailLines, isSSE)
 	if err != nil {
-		c.AbortWithStatusJSON(err.Status, err)
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
 		return
 	}
 	defer stream.Close()
@@ -70,17 +73,48 @@ func (r IPodController) GetLogs(c *gin.Context, clusterID, namespace, pod, conta
 	}
 }
 
+// ExecPod opens an exec session into the container of a pod of the release over a WebSocket.
+// The pod and the container are checked before upgrading the connection so that the errors are plain HTTP responses.
+func (r IPodController) ExecPod(c *gin.Context, clusterID, namespace, releaseName, pod, container string, params _api.ExecPodParams) {
+	if !websocket.IsWebSocketUpgrade(c.Request) {
+		c.AbortWithStatusJSON(http.StatusBadRequest, model.NewServerResponse(model.OkdpServerResponse).ForRequest(c).
+			BadRequest("The exec sessions require a WebSocket upgrade request"))
+		return
+	}
+	podInfo, err := r.podService.GetReleasePod(c.Request.Context(), clusterID, namespace, releaseName, pod, container)
+	if err != nil {
+		log.Ctx(c).Error("Unable to exec into the container '%s' of the pod '%s' of the release '%s/%s' on Kubernetes cluster '%s', details: %+v", container, pod, namespace, releaseName, clusterID, err)
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
+		return
+	}
+
+	command := model.DefaultExecCommand
+	if params.Command != nil && len(*params.Command) > 0 {
+		command = *params.Command
+	}
+	tty := params.Tty == nil || *params.Tty
+
+	conn, upgradeErr := execUpgrader.Upgrade(c.Writer, c.Request, nil)
+	if upgradeErr != nil {
+		// the upgrader already replied with the error
+		log.Ctx(c).Error("Unable to upgrade the exec session %s to a WebSocket, details: %v", c.Request.URL.Path, upgradeErr)
+		return
+	}
+	runExecSession(c, conn, command, tty, func(ctx context.Context, options *model.ExecOptions) (int, *model.ServerResponse) {
+		return r.podService.Exec(ctx, clusterID, podInfo, container, options)
+	})
+}
+
 func (r IPodController) streamPodLogs(c *gin.Context, stream io.ReadCloser) {
-	log.Debug("Streaming pod logs using Server-Sent Events (SSE) ...")
+	log.Ctx(c).Debug("Streaming pod logs using Server-Sent Events (SSE) ...")
 	c.Header("Content-Type", "text/event-stream")
 	c.Header("Cache-Control", "no-cache")
 	c.Header("Connection", "keep-alive")
 
 	flusher, ok := c.Writer.(http.Flusher)
 	if !ok {
-		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
-			"error": "streaming not supported",
-		})
+		c.AbortWithStatusJSON(http.StatusInternalServerError, model.NewServerResponse(model.OkdpServerResponse).ForRequest(c).
+			GenericError(http.StatusInternalServerError, "Streaming not supported"))
 		return
 	}
 
@@ -91,39 +125,33 @@ func (r IPodController) streamPodLogs(c *gin.Context, stream io.ReadCloser) {
 		flusher.Flush()
 	}
 	if err := scanner.Err(); err != nil {
-		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
-			"error":   "failed to stream logs",
-			"details": err.Error(),
-		})
+		c.AbortWithStatusJSON(http.StatusInternalServerError, model.NewServerResponse(model.OkdpServerResponse).ForRequest(c).
+			GenericError(http.StatusInternalServerError, "Failed to stream the pod logs, details: %v", err))
 	}
 }
 
 func (r IPodController) downloadPodLogs(c *gin.Context, pod, container string, stream io.ReadCloser) {
-	log.Debug("Downloading pod logs as a plain text file ...")
+	log.Ctx(c).Debug("Downloading pod logs as a plain text file ...")
 	filename := fmt.Sprintf("%s-%s-logs.log", pod, container)
 	c.Header("Content-Type", "text/plain; charset=utf-8")
 	c.Header("Content-Disposition", "attachment; filename="+filename)
 	c.Status(http.StatusOK)
 	if _, copyErr := io.Copy(c.Writer, stream); copyErr != nil {
-		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
-			"error":   "failed to write logs",
-			"details": copyErr.Error(),
-		})
+		c.AbortWithStatusJSON(http.StatusInternalServerError, model.NewServerResponse(model.OkdpServerResponse).ForRequest(c).
+			GenericError(http.StatusInternalServerError, "Failed to write the pod logs, details: %v", copyErr))
 	}
 }
 
 func (r IPodController) readPodLogs(c *gin.Context, stream io.ReadCloser) {
-	log.Debug("Returning logs as JSON array ...")
+	log.Ctx(c).Debug("Returning logs as JSON array ...")
 	var logs []string
 	scanner := bufio.NewScanner(stream)
 	for scanner.Scan() {
 		logs = append(logs, scanner.Text())
 	}
 	if err := scanner.Err(); err != nil {
-		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
-			"error":   "failed to read logs",
-			"details": err.Error(),
-		})
+		c.AbortWithStatusJSON(http.StatusInternalServerError, model.NewServerResponse(model.OkdpServerResponse).ForRequest(c).
+			GenericError(http.StatusInternalServerError, "Failed to read the pod logs, details: %v", err))
 		return
 	}
 	c.JSON(http.StatusOK, logs)
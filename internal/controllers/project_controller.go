@@ -20,6 +20,9 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	_api "github.com/okdp/okdp-server/api/openapi/v3/_api/projects"
+	"github.com/okdp/okdp-server/internal/audit"
+	"github.com/okdp/okdp-server/internal/common/constants"
 	log "github.com/okdp/okdp-server/internal/common/logging"
 	"github.com/okdp/okdp-server/internal/model"
 	"github.com/okdp/okdp-server/internal/services"
@@ -28,64 +31,146 @@ import (
 
 type IProjectController struct {
 	clusterService *services.ClusterService
+	upgradeService *services.UpgradeService
 }
 
 func ProjectController() *IProjectController {
 	return &IProjectController{
 		clusterService: services.NewClusterService(),
+		upgradeService: services.NewUpgradeService(),
 	}
 }
 
-func (r IProjectController) ListProjects(c *gin.Context, clusterID string) {
-	namespaces, err := r.clusterService.ListNamespaces(clusterID)
+func (r IProjectController) ListProjects(c *gin.Context, clusterID string, params _api.ListProjectsParams) {
+	options, err := model.NewListOptions(params.Limit, utils.OrEmpty(params.Continue), utils.OrEmpty(params.Sort), utils.OrEmpty(params.Search), utils.OrEmpty(params.LabelSelector))
 	if err != nil {
-		log.Error("%+v", clusterID, err)
-		c.AbortWithStatusJSON(err.Status, err)
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
 		return
 	}
+	options.Status = utils.OrEmpty(params.Status)
 
-	projects := utils.Map(namespaces, func(ns *model.Namespace) *model.Project {
-		return ns.ToProject()
-	})
+	namespaces, err := r.clusterService.ListNamespacesPage(c.Request.Context(), clusterID, options)
+	if err != nil {
+		log.Ctx(c).Error("%+v", clusterID, err)
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
+		return
+	}
 
-	c.JSON(http.StatusOK, projects)
+	listOK(c, &model.ListResult[*model.Project]{
+		Items: utils.Map(namespaces.Items, func(ns *model.Namespace) *model.Project {
+			return ns.ToProject()
+		}),
+		Total:    namespaces.Total,
+		Continue: namespaces.Continue,
+	})
 }
 
 func (r IProjectController) GetProject(c *gin.Context, clusterID string, projectName string) {
-	ns, err := r.clusterService.GetNamespaceByName(clusterID, projectName)
+	ns, err := r.clusterService.GetNamespaceByName(c.Request.Context(), clusterID, projectName)
 	if err != nil {
-		log.Error("%+v", clusterID, err)
-		c.AbortWithStatusJSON(err.Status, err)
+		log.Ctx(c).Error("%+v", clusterID, err)
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
 		return
 	}
+	c.Header(constants.ETagHeader, utils.ETag(utils.OrEmpty(ns.Metadata.ResourceVersion)))
 	c.JSON(http.StatusOK, ns.ToProject())
 }
 
 func (r IProjectController) CreateProject(c *gin.Context, clusterID string) {
 	var project model.Project
 	if err := c.ShouldBindJSON(&project); err != nil {
-		resp := model.NewServerResponse(model.OkdpServerResponse).BadRequest("%+v", err.Error())
-		c.AbortWithStatusJSON(resp.Status, resp)
+		resp := model.BindingError(err)
+		c.AbortWithStatusJSON(resp.Status, resp.ForRequest(c))
 		return
 	}
-	response := r.clusterService.CreateNamespace(clusterID, project.ToNamespace())
-	c.JSON(response.Status, response)
+	namespace := project.ToNamespace()
+	if userInfo, err := GetUserInfo(c); err == nil {
+		namespace.WithOwner(utils.DefaultIfEmpty(userInfo.Email, userInfo.Login))
+	}
+	response := r.clusterService.CreateNamespace(c.Request.Context(), clusterID, namespace)
+	c.JSON(response.Status, response.ForRequest(c))
 
 }
 
-func (r IProjectController) UpdateProject(c *gin.Context, clusterID string) {
+func (r IProjectController) UpdateProject(c *gin.Context, clusterID string, params _api.UpdateProjectParams) {
 	var project model.Project
 	if err := c.ShouldBindJSON(&project); err != nil {
-		resp := model.NewServerResponse(model.OkdpServerResponse).BadRequest("%+v", err.Error())
-		c.AbortWithStatusJSON(resp.Status, resp)
+		resp := model.BindingError(err)
+		c.AbortWithStatusJSON(resp.Status, resp.ForRequest(c))
+		return
+	}
+	audit.SetPrevious(c, func() (*model.Project, *model.ServerResponse) {
+		ns, err := r.clusterService.GetNamespaceByName(c.Request.Context(), clusterID, project.Name)
+		if err != nil {
+			return nil, err
+		}
+		return ns.ToProject(), nil
+	})
+	ifMatch, err := parseIfMatch(params.IfMatch)
+	if err != nil {
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
 		return
 	}
-	response := r.clusterService.UpdateNamespace(clusterID, project.ToNamespace())
-	c.JSON(response.Status, response)
+	response := r.clusterService.UpdateNamespace(c.Request.Context(), clusterID, project.ToNamespace(), ifMatch)
+	c.JSON(response.Status, response.ForRequest(c))
 
 }
 
-func (r IProjectController) DeleteProject(c *gin.Context, clusterID string, projectName string) {
-	response := r.clusterService.DeleteNamespace(clusterID, projectName)
-	c.JSON(response.Status, response)
+func (r IProjectController) PatchProject(c *gin.Context, clusterID string, projectName string, params _api.PatchProjectParams) {
+	patch, err := bindPatch(c)
+	if err != nil {
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
+		return
+	}
+
+	audit.SetPrevious(c, func() (*model.Project, *model.ServerResponse) {
+		ns, err := r.clusterService.GetNamespaceByName(c.Request.Context(), clusterID, projectName)
+		if err != nil {
+			return nil, err
+		}
+		return ns.ToProject(), nil
+	})
+	ifMatch, err := parseIfMatch(params.IfMatch)
+	if err != nil {
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
+		return
+	}
+	ns, err := r.clusterService.PatchProject(c.Request.Context(), clusterID, projectName, patch, utils.OrFalse(params.DryRun), ifMatch)
+	if err != nil {
+		log.Ctx(c).Error("Unable to patch project '%s' on cluster '%s', details: %+v", projectName, clusterID, err)
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
+		return
+	}
+	c.Header(constants.ETagHeader, utils.ETag(utils.OrEmpty(ns.Metadata.ResourceVersion)))
+	c.JSON(http.StatusOK, ns.ToProject())
+}
+
+func (r IProjectController) DeleteProject(c *gin.Context, clusterID string, projectName string, params _api.DeleteProjectParams) {
+	ifMatch, err := parseIfMatch(params.IfMatch)
+	if err != nil {
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
+		return
+	}
+	response := r.clusterService.DeleteNamespace(c.Request.Context(), clusterID, projectName, ifMatch)
+	c.JSON(response.Status, response.ForRequest(c))
+}
+
+func (r IProjectController) ListProjectOutdatedReleases(c *gin.Context, clusterID string, projectName string) {
+	releases, err := r.upgradeService.ListOutdatedReleases(c.Request.Context(), clusterID, projectName)
+	if err != nil {
+		log.Ctx(c).Error("Unable to get the outdated releases of project '%s' on cluster '%s', details: %+v", projectName, clusterID, err)
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
+		return
+	}
+	c.JSON(http.StatusOK, releases)
+}
+
+func (r IProjectController) GetProjectHealth(c *gin.Context, clusterID string, projectName string) {
+	health, err := r.clusterService.GetProjectHealth(c.Request.Context(), clusterID, projectName)
+	if err != nil {
+		log.Ctx(c).Error("Unable to get the health of project '%s' on cluster '%s', details: %+v", projectName, clusterID, err)
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
+		return
+	}
+	c.JSON(http.StatusOK, health)
 }
@@ -0,0 +1,75 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package controllers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/okdp/okdp-server/internal/common/constants"
+	log "github.com/okdp/okdp-server/internal/common/logging"
+	"github.com/okdp/okdp-server/internal/model"
+	"github.com/okdp/okdp-server/internal/services"
+)
+
+type IProxyController struct {
+	proxyService *services.ProxyService
+}
+
+func ProxyController() *IProxyController {
+	return &IProxyController{
+		proxyService: services.NewProxyService(),
+	}
+}
+
+// Proxy forwards the request to the port of a service or a pod of the release through the API server proxy.
+// The proxied path is appended to the prefix of the route so that the relative links of the proxied pages keep working.
+func (r IProxyController) Proxy(c *gin.Context) {
+	clusterID, namespace, releaseName := c.Param("clusterId"), c.Param("namespace"), c.Param("releaseName")
+	kind, name, port := c.Param("kind"), c.Param("name"), c.Param("port")
+	prefix := strings.TrimSuffix(c.Request.URL.Path, c.Param("path"))
+
+	proxy, err := r.proxyService.NewReleaseProxy(c.Request.Context(), clusterID, namespace, releaseName, kind, name, port, prefix)
+	if err != nil {
+		log.Ctx(c).Error("Unable to proxy to the %s '%s' port '%s' of the release '%s/%s' on Kubernetes cluster '%s', details: %+v", kind, name, port, namespace, releaseName, clusterID, err)
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
+		return
+	}
+	proxy.ErrorHandler = func(_ http.ResponseWriter, req *http.Request, e error) {
+		log.Ctx(c).Error("Unable to proxy %s %s to the %s '%s' port '%s' of the release '%s/%s' on Kubernetes cluster '%s', details: %v", req.Method, req.URL.Path, kind, name, port, namespace, releaseName, clusterID, e)
+		c.AbortWithStatusJSON(http.StatusBadGateway, model.NewServerResponse(model.OkdpServerResponse).ForRequest(c).
+			GenericError(http.StatusBadGateway, "Unable to reach the %s '%s' port '%s' of the release '%s/%s'", kind, name, port, namespace, releaseName))
+	}
+
+	withoutCredentials(c.Request)
+	proxy.ServeHTTP(c.Writer, c.Request)
+}
+
+// withoutCredentials removes the credentials of the user (bearer token and session cookie) from the proxied request,
+// the cookies of the proxied application are kept
+func withoutCredentials(req *http.Request) {
+	req.Header.Del("Authorization")
+	cookies := req.Cookies()
+	req.Header.Del("Cookie")
+	for _, cookie := range cookies {
+		if cookie.Name != constants.OAuth2SessionName {
+			req.AddCookie(cookie)
+		}
+	}
+}
@@ -18,13 +18,17 @@ package controllers
 
 import (
 	"github.com/gin-gonic/gin"
+	_admin "github.com/okdp/okdp-server/api/openapi/v3/_api/admin"
+	_audit "github.com/okdp/okdp-server/api/openapi/v3/_api/audit"
 	_catalog "github.com/okdp/okdp-server/api/openapi/v3/_api/catalogs"
 	_cluster "github.com/okdp/okdp-server/api/openapi/v3/_api/clusters"
+	_inventory "github.com/okdp/okdp-server/api/openapi/v3/_api/inventory"
 	_k8s "github.com/okdp/okdp-server/api/openapi/v3/_api/k8s"
 	_pods "github.com/okdp/okdp-server/api/openapi/v3/_api/pods"
 	_project "github.com/okdp/okdp-server/api/openapi/v3/_api/projects"
 	_repositories "github.com/okdp/okdp-server/api/openapi/v3/_api/repositories"
 	_user "github.com/okdp/okdp-server/api/openapi/v3/_api/users"
+	_watch "github.com/okdp/okdp-server/api/openapi/v3/_api/watch"
 	"github.com/okdp/okdp-server/internal/common/constants"
 	"github.com/okdp/okdp-server/internal/config"
 )
@@ -45,6 +49,12 @@ func (g *Group) RegisterControllers() {
 	_repositories.RegisterHandlers(g, GitRepoController())
 	_k8s.RegisterHandlers(g, KuboCDController())
 	_pods.RegisterHandlers(g, PodController())
+	_audit.RegisterHandlers(g, AuditController())
+	_admin.RegisterHandlers(g, AdminController())
+	_watch.RegisterHandlers(g, WatchController())
+	_inventory.RegisterHandlers(g, InventoryController())
+	// the proxied path is a wildcard which cannot be described by the OpenAPI specification
+	g.Any(constants.ReleaseProxyRoute, ProxyController().Proxy)
 }
 
 func (r *Router) RegisterSwaggerAPIDoc(swaggerConf config.Swagger) {
@@ -53,5 +63,5 @@ func (r *Router) RegisterSwaggerAPIDoc(swaggerConf config.Swagger) {
 
 func (r *Router) RegisterHealth() {
 	r.GET(constants.HealthzURI, Healthz)
-	r.GET(constants.ReadinessURI, Readiness)
+	r.GET(constants.ReadinessURI, Readiness())
 }
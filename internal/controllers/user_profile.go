@@ -56,3 +56,12 @@ func GetUserInfo(c *gin.Context) (*authc.UserInfo, *model.ServerResponse) {
 
 	return userInfo, nil
 }
+
+// userIdentity returns the name and the email of the authenticated user, empty when unknown
+func userIdentity(c *gin.Context) (string, string) {
+	userInfo, err := GetUserInfo(c)
+	if err != nil {
+		return "", ""
+	}
+	return userInfo.Name, userInfo.Email
+}
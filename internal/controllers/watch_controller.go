@@ -0,0 +1,141 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package controllers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	_api "github.com/okdp/okdp-server/api/openapi/v3/_api/watch"
+	log "github.com/okdp/okdp-server/internal/common/logging"
+	"github.com/okdp/okdp-server/internal/model"
+	"github.com/okdp/okdp-server/internal/security/authz"
+	"github.com/okdp/okdp-server/internal/services"
+	"github.com/okdp/okdp-server/internal/utils"
+)
+
+const defaultHeartbeat = 15
+
+type IWatchController struct {
+	watchService *services.WatchService
+}
+
+func WatchController() *IWatchController {
+	return &IWatchController{
+		watchService: services.NewWatchService(),
+	}
+}
+
+func (r IWatchController) WatchK8sReleases(c *gin.Context, clusterID string, namespace string, params _api.WatchK8sReleasesParams) {
+	events, err := r.watchService.WatchReleases(c.Request.Context(), clusterID, namespace, resumeFrom(params.LastEventID, params.ResourceVersion))
+	if err != nil {
+		log.Ctx(c).Error("Unable to watch the releases of the Kubernetes cluster '%s' on namespace '%s', details: %+v", clusterID, namespace, err)
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
+		return
+	}
+	streamWatchEvents(c, events, params.Heartbeat)
+}
+
+func (r IWatchController) WatchPods(c *gin.Context, clusterID string, namespace string, releaseName string, params _api.WatchPodsParams) {
+	events, err := r.watchService.WatchPods(c.Request.Context(), clusterID, namespace, releaseName, resumeFrom(params.LastEventID, params.ResourceVersion))
+	if err != nil {
+		log.Ctx(c).Error("Unable to watch the pods of the release '%s/%s' on Kubernetes cluster '%s', details: %+v", namespace, releaseName, clusterID, err)
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
+		return
+	}
+	streamWatchEvents(c, events, params.Heartbeat)
+}
+
+func (r IWatchController) WatchEventsRelease(c *gin.Context, clusterID string, namespace string, releaseName string, params _api.WatchEventsReleaseParams) {
+	events, err := r.watchService.WatchEventsRelease(c.Request.Context(), clusterID, namespace, releaseName, resumeFrom(params.LastEventID, params.ResourceVersion))
+	if err != nil {
+		log.Ctx(c).Error("Unable to watch the events of the release '%s/%s' on Kubernetes cluster '%s', details: %+v", namespace, releaseName, clusterID, err)
+		c.AbortWithStatusJSON(err.Status, err.ForRequest(c))
+		return
+	}
+	streamWatchEvents(c, events, params.Heartbeat)
+}
+
+// resumeFrom returns the resource version to resume the watch from, the Last-Event-ID header sent by the SSE clients
+// on reconnection takes precedence over the resourceVersion query parameter
+func resumeFrom(lastEventID *string, resourceVersion *string) string {
+	return utils.FirstNonEmpty(utils.OrEmpty(lastEventID), utils.OrEmpty(resourceVersion))
+}
+
+// streamWatchEvents writes the watch notifications as Server-Sent Events until the client disconnects or the watch ends.
+// A heartbeat (SSE comment) is sent periodically, the authorization of the user is checked again on each heartbeat.
+func streamWatchEvents(c *gin.Context, events <-chan *model.WatchEvent, heartbeat *int) {
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+
+	flusher, ok := c.Writer.(http.Flusher)
+	if !ok {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, model.NewServerResponse(model.OkdpServerResponse).ForRequest(c).
+			GenericError(http.StatusInternalServerError, "Streaming not supported"))
+		return
+	}
+	flusher.Flush()
+
+	interval := defaultHeartbeat
+	if heartbeat != nil && *heartbeat > 0 {
+		interval = *heartbeat
+	}
+	ticker := time.NewTicker(time.Duration(interval) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.Request.Context().Done():
+			return
+		case <-ticker.C:
+			if !authz.StillAllowed(c) {
+				log.Ctx(c).Warn("Ending the stream %s: the user is no longer allowed", c.Request.URL.Path)
+				writeWatchEvent(c, model.WatchErrorEvent(model.NewServerResponse(model.OkdpServerResponse).ForRequest(c).
+					Forbidden("The user is no longer allowed to watch the resources")))
+				flusher.Flush()
+				return
+			}
+			fmt.Fprint(c.Writer, ": heartbeat\n\n")
+			flusher.Flush()
+		case event, open := <-events:
+			if !open {
+				return
+			}
+			writeWatchEvent(c, event)
+			flusher.Flush()
+		}
+	}
+}
+
+func writeWatchEvent(c *gin.Context, event *model.WatchEvent) {
+	data, err := json.Marshal(event)
+	if err != nil {
+		log.Ctx(c).Error("Unable to serialize the watch event %s (%s): %v", event.Type, event.ResourceVersion, err)
+		return
+	}
+	if event.ResourceVersion != "" {
+		fmt.Fprintf(c.Writer, "id: %s\n", event.ResourceVersion)
+	}
+	fmt.Fprintf(c.Writer, "event: %s\ndata: %s\n\n", event.Type, data)
+}
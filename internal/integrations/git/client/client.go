@@ -17,9 +17,15 @@
 package client
 
 import (
+	"context"
+	"path/filepath"
+	"strings"
+
 	"github.com/go-git/go-billy/v5/memfs"
 	git "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
 	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
 	"github.com/go-git/go-git/v5/plumbing/transport"
 	"github.com/go-git/go-git/v5/storage/memory"
 
@@ -34,21 +40,28 @@ type LocalRepo struct {
 }
 
 func NewLocalRepo(url string, ref string, auth transport.AuthMethod) (*LocalRepo, *model.ServerResponse) {
+	return newLocalRepo(url, ref, auth, 1)
+}
+
+// NewLocalRepoWithHistory clones the whole history of the branch, as required to read the log of the files
+func NewLocalRepoWithHistory(url string, ref string, auth transport.AuthMethod) (*LocalRepo, *model.ServerResponse) {
+	return newLocalRepo(url, ref, auth, 0)
+}
+
+func newLocalRepo(url string, ref string, auth transport.AuthMethod, depth int) (*LocalRepo, *model.ServerResponse) {
 
 	fs := memfs.New()
 	repo, er := git.Clone(memory.NewStorage(), fs, &git.CloneOptions{
 		URL:           url,
 		Auth:          auth,
-		Depth:         1,
+		Depth:         depth,
 		Progress:      nil,
 		SingleBranch:  true,
 		ReferenceName: plumbing.ReferenceName(ref),
 	})
 
 	if er != nil {
-		return nil, model.
-			NewServerResponse(model.GitRepoResponse).
-			UnprocessableEntity(er.Error())
+		return nil, gitError(er, "Unable to clone git repo %s (%s), details: %s", url, ref, er.Error())
 	}
 	worktree, err := repo.Worktree()
 	if err != nil {
@@ -62,7 +75,7 @@ func NewLocalRepo(url string, ref string, auth transport.AuthMethod) (*LocalRepo
 	}, nil
 }
 
-func DoReadContent(repo *model.GitRepository, auth transport.AuthMethod) ([]*model.GitContent, *model.ServerResponse) {
+func DoReadContent(ctx context.Context, repo *model.GitRepository, auth transport.AuthMethod) ([]*model.GitContent, *model.ServerResponse) {
 	contents := []*model.GitContent{}
 
 	localrepo, err := NewLocalRepo(repo.RepoURL, repo.Ref, auth)
@@ -78,7 +91,7 @@ func DoReadContent(repo *model.GitRepository, auth transport.AuthMethod) ([]*mod
 	}
 	for _, filePath := range filePaths {
 		if !utils.IsYaml(filePath) {
-			log.Warn("Ignoring file '%s' in folder '%s': not a YAML file", filePath, repo.Path)
+			log.Ctx(ctx).Warn("Ignoring file '%s' in folder '%s': not a YAML file", filePath, repo.Path)
 			continue
 		}
 		content, err := ReadContent(fs, filePath)
@@ -93,12 +106,93 @@ func DoReadContent(repo *model.GitRepository, auth transport.AuthMethod) ([]*mod
 
 }
 
-func DoPushContent(repo *model.GitRepository, auth transport.AuthMethod, content string, commitOpts *model.GitCommit, path string) *model.ServerResponse {
+// DoReadHistory returns the successive contents of the file, from the oldest commit to the latest one.
+// The commits where the file does not exist (deleted) are skipped.
+func DoReadHistory(repo *model.GitRepository, auth transport.AuthMethod, path string) ([]*model.GitFileRevision, *model.ServerResponse) {
+	localrepo, err := NewLocalRepoWithHistory(repo.RepoURL, repo.Ref, auth)
+	if err != nil {
+		return nil, err
+	}
+
+	path = strings.TrimPrefix(filepath.Clean(path), "/")
+	commits, er := localrepo.Log(&git.LogOptions{FileName: &path})
+	if er != nil {
+		return nil, gitError(er, "Unable to read the log of %s (%s/%s) => %s (%s), details: %s", path, repo.Namespace, repo.Name, repo.RepoURL, repo.Ref, er.Error())
+	}
+
+	revisions := []*model.GitFileRevision{}
+	er = commits.ForEach(func(commit *object.Commit) error {
+		file, err := commit.File(path)
+		if err == object.ErrFileNotFound {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		contents, err := file.Contents()
+		if err != nil {
+			return err
+		}
+		content := []byte(contents)
+		revisions = append([]*model.GitFileRevision{{
+			GitContent: model.GitContent{
+				Content: &content,
+				Path:    path,
+				URL:     repo.RepoURL,
+				SHA:     utils.GitBlobSHA(content),
+			},
+			Commit:    commit.Hash.String(),
+			Author:    commit.Author.Name,
+			Email:     commit.Author.Email,
+			Message:   strings.TrimSpace(commit.Message),
+			Timestamp: commit.Author.When.UTC(),
+		}}, revisions...)
+		return nil
+	})
+	if er != nil {
+		return nil, gitError(er, "Unable to read the history of %s (%s/%s) => %s (%s), details: %s", path, repo.Namespace, repo.Name, repo.RepoURL, repo.Ref, er.Error())
+	}
+
+	return revisions, nil
+}
+
+// DoReadHead returns the commit the branch or the tag of the repo points to, without cloning it
+func DoReadHead(repo *model.GitRepository, auth transport.AuthMethod) (string, *model.ServerResponse) {
+	remote := git.NewRemote(memory.NewStorage(), &config.RemoteConfig{
+		Name: git.DefaultRemoteName,
+		URLs: []string{repo.RepoURL},
+	})
+	refs, err := remote.List(&git.ListOptions{Auth: auth, PeelingOption: git.AppendPeeled})
+	if err != nil {
+		return "", gitError(err, "Unable to list the references of git repo %s (%s), details: %s", repo.RepoURL, repo.Ref, err.Error())
+	}
+
+	head := ""
+	for _, ref := range refs {
+		switch ref.Name().String() {
+		case repo.Ref + "^{}":
+			// the commit of an annotated tag
+			return ref.Hash().String(), nil
+		case repo.Ref:
+			head = ref.Hash().String()
+		}
+	}
+	if head == "" {
+		return "", gitError(plumbing.ErrReferenceNotFound, "Reference %s not found in git repo %s", repo.Ref, repo.RepoURL)
+	}
+	return head, nil
+}
+
+func DoPushContent(repo *model.GitRepository, auth transport.AuthMethod, content string, commitOpts *model.GitCommit, path string, ifMatch string) *model.ServerResponse {
 	localrepo, er := NewLocalRepo(repo.RepoURL, repo.Ref, auth)
 	if er != nil {
 		return er
 	}
 
+	if er := checkVersion(localrepo.Filesystem, path, ifMatch); er != nil {
+		return er
+	}
+
 	err := localrepo.CommitFile(content, path, commitOpts)
 
 	if err != nil {
@@ -110,20 +204,53 @@ func DoPushContent(repo *model.GitRepository, auth transport.AuthMethod, content
 	err = localrepo.SafePush(auth)
 
 	if err != nil && err != git.NoErrAlreadyUpToDate {
+		return gitError(err, "Unable to push %s (%s/%s) => %s (%s), details: %s", path, repo.Namespace, repo.Name, repo.RepoURL, repo.Ref, err.Error())
+	}
+
+	return nil
+}
+
+// DoPushContents commits the changes of the files in a single commit and pushes it, the changes are
+// conditioned by the versions (If-Match) of the files
+func DoPushContents(repo *model.GitRepository, auth transport.AuthMethod, changes []*model.GitFileChange, commitOpts *model.GitCommit) *model.ServerResponse {
+	localrepo, er := NewLocalRepo(repo.RepoURL, repo.Ref, auth)
+	if er != nil {
+		return er
+	}
+
+	for _, change := range changes {
+		if er := checkVersion(localrepo.Filesystem, change.Path, change.IfMatch); er != nil {
+			return er
+		}
+	}
+
+	err := localrepo.CommitFiles(changes, commitOpts)
+
+	if err != nil {
 		return model.
 			NewServerResponse(model.GitRepoResponse).
-			UnprocessableEntity("Unable to push %s (%s/%s) => %s (%s), details: %s", path, repo.Namespace, repo.Name, repo.RepoURL, repo.Ref, err.Error())
+			UnprocessableEntity("Unable to commit %d files (%s/%s) => %s (%s), details: %s", len(changes), repo.Namespace, repo.Name, repo.RepoURL, repo.Ref, err.Error())
+	}
+
+	err = localrepo.SafePush(auth)
+
+	if err != nil && err != git.NoErrAlreadyUpToDate {
+		return gitError(err, "Unable to push %d files (%s/%s) => %s (%s), details: %s", len(changes), repo.Namespace, repo.Name, repo.RepoURL, repo.Ref, err.Error())
 	}
 
 	return nil
 }
 
-func DoDeleteFile(repo *model.GitRepository, auth transport.AuthMethod, commitOpts *model.GitCommit, path string) *model.ServerResponse {
+func DoDeleteFile(repo *model.GitRepository, auth transport.AuthMethod, commitOpts *model.GitCommit, path string, ifMatch string) *model.ServerResponse {
 	localrepo, er := NewLocalRepo(repo.RepoURL, repo.Ref, auth)
 	if er != nil {
 		return er
 	}
 
+	if er := checkVersion(localrepo.Filesystem, path, ifMatch); er != nil {
+		return er
+	}
+
 	err := localrepo.DeleteFile(path, commitOpts)
 
 	if err != nil {
@@ -135,9 +262,7 @@ func DoDeleteFile(repo *model.GitRepository, auth transport.AuthMethod, commitOp
 	err = localrepo.SafePush(auth)
 
 	if err != nil && err != git.NoErrAlreadyUpToDate {
-		return model.
-			NewServerResponse(model.GitRepoResponse).
-			UnprocessableEntity("Unable to push %s (%s/%s) => %s (%s), details: %s", path, repo.Namespace, repo.Name, repo.RepoURL, repo.Ref, err.Error())
+		return gitError(err, "Unable to push %s (%s/%s) => %s (%s), details: %s", path, repo.Namespace, repo.Name, repo.RepoURL, repo.Ref, err.Error())
 	}
 
 	return model.NewServerResponse(model.OkdpServerResponse).Deleted("Release name %s successfully deleted", path)
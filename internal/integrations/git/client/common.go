@@ -25,6 +25,7 @@ import (
 	"github.com/go-git/go-git/v5/plumbing/transport"
 
 	"github.com/okdp/okdp-server/internal/model"
+	"github.com/okdp/okdp-server/internal/utils"
 )
 
 func (r LocalRepo) SafePush(auth transport.AuthMethod) error {
@@ -50,25 +51,35 @@ func (r LocalRepo) SafePush(auth transport.AuthMethod) error {
 }
 
 func (r LocalRepo) CommitFile(content string, path string, commitOpts *model.GitCommit) error {
+	return r.CommitFiles([]*model.GitFileChange{{Path: path, Content: content}}, commitOpts)
+}
+
+// CommitFiles writes the files and commits them in a single commit
+func (r LocalRepo) CommitFiles(changes []*model.GitFileChange, commitOpts *model.GitCommit) error {
 
 	fs := r.Worktree.Filesystem
 
-	f, err := fs.Create(path)
-	if err != nil {
-		return err
+	for _, change := range changes {
+		if err := writeFile(fs, change.Path, change.Content); err != nil {
+			return err
+		}
+		if _, err := r.Worktree.Add(change.Path); err != nil {
+			return err
+		}
 	}
-	defer f.Close()
+	_, err := r.Worktree.Commit(commitOpts.Message, &commitOpts.CommitOptions)
 
-	if _, err := f.Write([]byte(content)); err != nil {
-		return err
-	}
+	return err
+}
 
-	_, err = r.Worktree.Add(path)
+func writeFile(fs billy.Filesystem, path string, content string) error {
+	f, err := fs.Create(path)
 	if err != nil {
 		return err
 	}
-	_, err = r.Worktree.Commit(commitOpts.Message, &commitOpts.CommitOptions)
+	defer f.Close()
 
+	_, err = f.Write([]byte(content))
 	return err
 }
 
@@ -145,5 +156,20 @@ func ReadContent(fs billy.Filesystem, filePath string) (*model.GitContent, *mode
 	return &model.GitContent{
 		Content: &content,
 		Path:    filePath,
+		SHA:     utils.GitBlobSHA(content),
 	}, nil
 }
+
+// checkVersion ensures the file at path still has the expected blob hash.
+// An empty or '*' ifMatch matches any version (a missing file included).
+func checkVersion(fs billy.Filesystem, path string, ifMatch string) *model.ServerResponse {
+	if ifMatch == "" || ifMatch == "*" {
+		return nil
+	}
+	current, err := ReadContent(fs, path)
+	if err != nil || current.SHA != ifMatch {
+		return model.NewServerResponse(model.GitRepoResponse).
+			PreconditionFailed("File '%s' has been modified since version '%s', get it again and retry", path, ifMatch)
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package client
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+
+	"github.com/okdp/okdp-server/internal/model"
+)
+
+// gitError converts a git error into a server response with the matching HTTP status and error code.
+// The credentials rejected by the git server are returned as forbidden to not be confused with the user authentication.
+func gitError(err error, messages ...interface{}) *model.ServerResponse {
+	response := model.NewServerResponse(model.GitRepoResponse)
+	switch {
+	case errors.Is(err, transport.ErrAuthenticationRequired), errors.Is(err, transport.ErrAuthorizationFailed):
+		return response.WithCode(model.ErrGitAuthFailed).Forbidden(messages...)
+	case errors.Is(err, transport.ErrRepositoryNotFound), errors.Is(err, plumbing.ErrReferenceNotFound):
+		return response.WithCode(model.ErrGitRepoNotFound).NotFoundError(messages...)
+	case isPushRejected(err):
+		return response.WithCode(model.ErrGitPushRejected).ConflictError(messages...)
+	default:
+		return response.UnprocessableEntity(messages...)
+	}
+}
+
+// isPushRejected returns true if the remote refused the update (non fast-forward, protected branch, hook, etc)
+func isPushRejected(err error) bool {
+	if errors.Is(err, git.ErrNonFastForwardUpdate) || errors.Is(err, git.ErrForceNeeded) {
+		return true
+	}
+	msg := err.Error()
+	return strings.Contains(msg, "non-fast-forward update") || strings.HasPrefix(msg, "command error on ")
+}
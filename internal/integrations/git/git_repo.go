@@ -17,6 +17,7 @@
 package git
 
 import (
+	"context"
 	"path/filepath"
 
 	"github.com/okdp/okdp-server/internal/integrations/git/client"
@@ -35,16 +36,16 @@ func NewRepository() *Repository {
 	}
 }
 
-func (r Repository) ListGitRepos(clusterID string, namespace string) ([]*model.GitRepository, *model.ServerResponse) {
+func (r Repository) ListGitRepos(ctx context.Context, clusterID string, namespace string) ([]*model.GitRepository, *model.ServerResponse) {
 	kubeClient, err := r.GetClient(clusterID)
 	if err != nil {
 		return nil, err
 	}
-	return kubeClient.ListGitRepos(namespace)
+	return kubeClient.ListGitRepos(ctx, namespace)
 }
 
-func (r Repository) GetGitRepo(clusterID string, namespace string, kustomizationName string) (*model.GitRepository, *model.ServerResponse) {
-	gitRepos, err := r.ListGitRepos(clusterID, namespace)
+func (r Repository) GetGitRepo(ctx context.Context, clusterID string, namespace string, kustomizationName string) (*model.GitRepository, *model.ServerResponse) {
+	gitRepos, err := r.ListGitRepos(ctx, clusterID, namespace)
 	if err != nil {
 		return nil, err
 	}
@@ -57,8 +58,8 @@ func (r Repository) GetGitRepo(clusterID string, namespace string, kustomization
 	return nil, model.RepoNotFoundError(clusterID, namespace, kustomizationName)
 }
 
-func (r Repository) GetContents(clusterID string, namespace string, kustomizationName string) ([]*model.GitContent, *model.ServerResponse) {
-	repo, err := r.GetGitRepo(clusterID, namespace, kustomizationName)
+func (r Repository) GetContents(ctx context.Context, clusterID string, namespace string, kustomizationName string) ([]*model.GitContent, *model.ServerResponse) {
+	repo, err := r.GetGitRepo(ctx, clusterID, namespace, kustomizationName)
 	if err != nil {
 		return nil, err
 	}
@@ -66,15 +67,15 @@ func (r Repository) GetContents(clusterID string, namespace string, kustomizatio
 	if err != nil {
 		return nil, err
 	}
-	auth, err := kubeClient.GetAuthMethod(repo.Credentials.SecretRef, namespace)
+	auth, err := kubeClient.GetAuthMethod(ctx, repo.Credentials.SecretRef, namespace)
 	if err != nil {
 		return nil, err
 	}
-	return client.DoReadContent(repo, auth)
+	return client.DoReadContent(ctx, repo, auth)
 }
 
-func (r Repository) Write(clusterID string, namespace string, kustomizationName string, content string, commitOpts *model.GitCommit, path string) *model.ServerResponse {
-	repo, err := r.GetGitRepo(clusterID, namespace, kustomizationName)
+func (r Repository) Write(ctx context.Context, clusterID string, namespace string, kustomizationName string, content string, commitOpts *model.GitCommit, path string, ifMatch string) *model.ServerResponse {
+	repo, err := r.GetGitRepo(ctx, clusterID, namespace, kustomizationName)
 	if err != nil {
 		return err
 	}
@@ -82,15 +83,17 @@ func (r Repository) Write(clusterID string, namespace string, kustomizationName
 	if err != nil {
 		return err
 	}
-	auth, err := kubeClient.GetAuthMethod(repo.Credentials.SecretRef, namespace)
+	auth, err := kubeClient.GetAuthMethod(ctx, repo.Credentials.SecretRef, namespace)
 	if err != nil {
 		return err
 	}
-	return client.DoPushContent(repo, auth, content, commitOpts, utils.PathOrFallback(path, filepath.Join(repo.Path, path)))
+	return client.DoPushContent(repo, auth, content, commitOpts, utils.PathOrFallback(path, filepath.Join(repo.Path, path)), ifMatch)
 }
 
-func (r Repository) DeleteFile(clusterID string, namespace string, kustomizationName string, commitOpts *model.GitCommit, path string) *model.ServerResponse {
-	repo, err := r.GetGitRepo(clusterID, namespace, kustomizationName)
+// WriteFiles commits the changes of the files of the git repo in a single commit, the paths are relative
+// to the root of the git repo
+func (r Repository) WriteFiles(ctx context.Context, clusterID string, namespace string, kustomizationName string, changes []*model.GitFileChange, commitOpts *model.GitCommit) *model.ServerResponse {
+	repo, err := r.GetGitRepo(ctx, clusterID, namespace, kustomizationName)
 	if err != nil {
 		return err
 	}
@@ -98,9 +101,57 @@ func (r Repository) DeleteFile(clusterID string, namespace string, kustomization
 	if err != nil {
 		return err
 	}
-	auth, err := kubeClient.GetAuthMethod(repo.Credentials.SecretRef, namespace)
+	auth, err := kubeClient.GetAuthMethod(ctx, repo.Credentials.SecretRef, namespace)
 	if err != nil {
 		return err
 	}
-	return client.DoDeleteFile(repo, auth, commitOpts, path)
+	return client.DoPushContents(repo, auth, changes, commitOpts)
+}
+
+func (r Repository) DeleteFile(ctx context.Context, clusterID string, namespace string, kustomizationName string, commitOpts *model.GitCommit, path string, ifMatch string) *model.ServerResponse {
+	repo, err := r.GetGitRepo(ctx, clusterID, namespace, kustomizationName)
+	if err != nil {
+		return err
+	}
+	kubeClient, err := r.GetClient(clusterID)
+	if err != nil {
+		return err
+	}
+	auth, err := kubeClient.GetAuthMethod(ctx, repo.Credentials.SecretRef, namespace)
+	if err != nil {
+		return err
+	}
+	return client.DoDeleteFile(repo, auth, commitOpts, path, ifMatch)
+}
+
+func (r Repository) GetHistory(ctx context.Context, clusterID string, namespace string, kustomizationName string, path string) ([]*model.GitFileRevision, *model.ServerResponse) {
+	repo, err := r.GetGitRepo(ctx, clusterID, namespace, kustomizationName)
+	if err != nil {
+		return nil, err
+	}
+	kubeClient, err := r.GetClient(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	auth, err := kubeClient.GetAuthMethod(ctx, repo.Credentials.SecretRef, namespace)
+	if err != nil {
+		return nil, err
+	}
+	return client.DoReadHistory(repo, auth, path)
+}
+
+func (r Repository) GetHead(ctx context.Context, clusterID string, namespace string, kustomizationName string) (string, *model.ServerResponse) {
+	repo, err := r.GetGitRepo(ctx, clusterID, namespace, kustomizationName)
+	if err != nil {
+		return "", err
+	}
+	kubeClient, err := r.GetClient(clusterID)
+	if err != nil {
+		return "", err
+	}
+	auth, err := kubeClient.GetAuthMethod(ctx, repo.Credentials.SecretRef, namespace)
+	if err != nil {
+		return "", err
+	}
+	return client.DoReadHead(repo, auth)
 }
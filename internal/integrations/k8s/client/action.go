@@ -0,0 +1,255 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	fluxmeta "github.com/fluxcd/pkg/apis/meta"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	kubocdv1alpha1 "kubocd/api/v1alpha1"
+
+	"github.com/okdp/okdp-server/internal/model"
+)
+
+// actionPollInterval is the interval between two checks of the resource while waiting for its reconciliation
+var actionPollInterval = time.Second
+
+// actionTarget adapts a resource kind to the suspend, resume and reconcile actions
+type actionTarget struct {
+	kind       string
+	object     ctrlclient.Object
+	errorCodes k8sErrorCodes
+	suspended  func() bool
+	suspend    func(bool)
+	// requested propagates the reconciliation requested at requestedAt, nil when the resource is reconciled on its own
+	requested func(requestedAt string) error
+	// reconciled returns whether the resource handled the reconciliation requested at requestedAt, its readiness and a message
+	reconciled func(requestedAt string) (bool, bool, string)
+}
+
+// releaseTarget requests the reconciliation of the release and of its HelmReleases: KuboCD does not write an unchanged
+// status, the release is reconciled once its HelmReleases handled the request
+func (c KubeClient) releaseTarget(ctx context.Context) *actionTarget {
+	release := &kubocdv1alpha1.Release{}
+	return &actionTarget{
+		kind:       "Release",
+		object:     release,
+		errorCodes: releaseErrorCodes,
+		suspended:  func() bool { return release.Spec.Suspended },
+		suspend:    func(suspended bool) { release.Spec.Suspended = suspended },
+		requested: func(requestedAt string) error {
+			for _, helmRelease := range c.releaseHelmReleases(ctx, release) {
+				patch := []byte(fmt.Sprintf(`{"metadata":{"annotations":{%q:%q}}}`, fluxmeta.ReconcileRequestAnnotation, requestedAt))
+				if err := c.Patch(ctx, &helmRelease, ctrlclient.RawPatch(types.MergePatchType, patch)); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		reconciled: func(requestedAt string) (bool, bool, string) {
+			helmReleases := c.releaseHelmReleases(ctx, release)
+			if len(helmReleases) == 0 {
+				return true, release.Status.Phase == kubocdv1alpha1.ReleasePhaseReady, string(release.Status.Phase)
+			}
+			ready := true
+			var messages []string
+			for i := range helmReleases {
+				status := readHelmReleaseStatus(&helmReleases[i])
+				done, helmReleaseReady, message := fluxReconciled(&helmReleases[i], status.LastHandledReconcileAt, status.Conditions, requestedAt)
+				if !done {
+					return false, false, ""
+				}
+				ready = ready && helmReleaseReady
+				messages = append(messages, fmt.Sprintf("HelmRelease '%s': %s", helmReleases[i].GetName(), message))
+			}
+			sort.Strings(messages)
+			return true, ready, strings.Join(messages, "; ")
+		},
+	}
+}
+
+// releaseHelmReleases returns the HelmReleases of the release
+func (c KubeClient) releaseHelmReleases(ctx context.Context, release *kubocdv1alpha1.Release) []unstructured.Unstructured {
+	var helmReleases []unstructured.Unstructured
+	for _, helmRelease := range c.listHelmReleases(ctx, release.Namespace) {
+		if isReleaseHelmRelease(&helmRelease, (*model.Release)(release)) {
+			helmReleases = append(helmReleases, helmRelease)
+		}
+	}
+	return helmReleases
+}
+
+// helmReleaseStatus is the part of the HelmRelease status telling the handled reconciliation
+type helmReleaseStatus struct {
+	fluxmeta.ReconcileRequestStatus `json:",inline"`
+	Conditions                      []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// readHelmReleaseStatus reads the status of the HelmRelease, read as unstructured
+func readHelmReleaseStatus(helmRelease *unstructured.Unstructured) *helmReleaseStatus {
+	status := &helmReleaseStatus{}
+	if s, ok := helmRelease.Object["status"].(map[string]interface{}); ok {
+		_ = runtime.DefaultUnstructuredConverter.FromUnstructured(s, status)
+	}
+	return status
+}
+
+func kustomizationTarget() *actionTarget {
+	kustomization := &kustomizev1.Kustomization{}
+	return &actionTarget{
+		kind:       kustomizev1.KustomizationKind,
+		object:     kustomization,
+		errorCodes: gitRepoErrorCodes,
+		suspended:  func() bool { return kustomization.Spec.Suspend },
+		suspend:    func(suspended bool) { kustomization.Spec.Suspend = suspended },
+		reconciled: func(requestedAt string) (bool, bool, string) {
+			return fluxReconciled(kustomization, kustomization.Status.LastHandledReconcileAt, kustomization.Status.Conditions, requestedAt)
+		},
+	}
+}
+
+func gitRepositoryTarget() *actionTarget {
+	repo := &sourcev1.GitRepository{}
+	return &actionTarget{
+		kind:       sourcev1.GitRepositoryKind,
+		object:     repo,
+		errorCodes: gitRepoErrorCodes,
+		suspended:  func() bool { return repo.Spec.Suspend },
+		suspend:    func(suspended bool) { repo.Spec.Suspend = suspended },
+		reconciled: func(requestedAt string) (bool, bool, string) {
+			return fluxReconciled(repo, repo.Status.LastHandledReconcileAt, repo.Status.Conditions, requestedAt)
+		},
+	}
+}
+
+// fluxReconciled checks whether the fluxcd resource handled the request and reported its Ready condition for its current generation
+func fluxReconciled(obj ctrlclient.Object, lastHandledReconcileAt string, conditions []metav1.Condition, requestedAt string) (bool, bool, string) {
+	if lastHandledReconcileAt != requestedAt {
+		return false, false, ""
+	}
+	ready := apimeta.FindStatusCondition(conditions, fluxmeta.ReadyCondition)
+	if ready == nil || ready.Status == metav1.ConditionUnknown || ready.ObservedGeneration != obj.GetGeneration() {
+		return false, false, ""
+	}
+	return true, ready.Status == metav1.ConditionTrue, ready.Message
+}
+
+// RunReleaseAction suspends, resumes or reconciles a KuboCD release
+func (c KubeClient) RunReleaseAction(ctx context.Context, namespace string, name string, action model.Action, timeout time.Duration) (*model.ActionResult, *model.ServerResponse) {
+	return c.runAction(ctx, c.releaseTarget(ctx), namespace, name, action, timeout)
+}
+
+// RunKustomizationAction suspends, resumes or reconciles a fluxcd kustomization
+func (c KubeClient) RunKustomizationAction(ctx context.Context, namespace string, name string, action model.Action, timeout time.Duration) (*model.ActionResult, *model.ServerResponse) {
+	return c.runAction(ctx, kustomizationTarget(), namespace, name, action, timeout)
+}
+
+// RunGitRepositoryAction suspends, resumes or reconciles a fluxcd git repository
+func (c KubeClient) RunGitRepositoryAction(ctx context.Context, namespace string, name string, action model.Action, timeout time.Duration) (*model.ActionResult, *model.ServerResponse) {
+	return c.runAction(ctx, gitRepositoryTarget(), namespace, name, action, timeout)
+}
+
+// runAction patches the resource to suspend or resume it and, on resume and reconcile, requests its reconciliation
+// with the fluxcd reconcile.fluxcd.io/requestedAt annotation. When timeout is set, it waits for the reconciliation.
+func (c KubeClient) runAction(ctx context.Context, target *actionTarget, namespace string, name string, action model.Action, timeout time.Duration) (*model.ActionResult, *model.ServerResponse) {
+	key := ctrlclient.ObjectKey{Namespace: namespace, Name: name}
+	if err := c.WithWatch.Get(ctx, key, target.object); err != nil {
+		return nil, k8sError(err, target.errorCodes, "Failed to get %s '%s/%s', details: '%s'", target.kind, namespace, name, err.Error())
+	}
+	original := target.object.DeepCopyObject().(ctrlclient.Object)
+	result := model.NewActionResult(target.kind, namespace, name, action)
+
+	requestedAt := ""
+	switch action {
+	case model.ActionSuspend:
+		target.suspend(true)
+	case model.ActionResume:
+		target.suspend(false)
+		requestedAt = time.Now().Format(time.RFC3339Nano)
+	case model.ActionReconcile:
+		if target.suspended() {
+			return nil, model.ResourceSuspendedError(target.kind, namespace, name)
+		}
+		requestedAt = time.Now().Format(time.RFC3339Nano)
+	default:
+		return nil, model.NewServerResponse(model.OkdpServerResponse).BadRequest("Unsupported action '%s'", action)
+	}
+
+	if requestedAt != "" {
+		annotations := target.object.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[fluxmeta.ReconcileRequestAnnotation] = requestedAt
+		target.object.SetAnnotations(annotations)
+		result.RequestedAt = &requestedAt
+	}
+
+	if err := c.Patch(ctx, target.object, ctrlclient.MergeFrom(original)); err != nil {
+		return nil, k8sError(err, target.errorCodes, "Failed to %s %s '%s/%s', details: '%s'", action, target.kind, namespace, name, err.Error())
+	}
+	if requestedAt != "" && target.requested != nil {
+		if err := target.requested(requestedAt); err != nil {
+			return nil, k8sError(err, target.errorCodes, "Failed to %s the HelmReleases of %s '%s/%s', details: '%s'", action, target.kind, namespace, name, err.Error())
+		}
+	}
+
+	switch {
+	case action == model.ActionSuspend:
+		result.Outcome = model.ActionSuspended
+		return result, nil
+	case timeout <= 0:
+		result.Outcome = model.ActionRequested
+		return result, nil
+	}
+
+	result.Outcome = model.ActionTimeout
+	err := wait.PollUntilContextTimeout(ctx, actionPollInterval, timeout, true, func(ctx context.Context) (bool, error) {
+		if err := c.WithWatch.Get(ctx, key, target.object); err != nil {
+			return false, err
+		}
+		done, ready, message := target.reconciled(requestedAt)
+		if !done {
+			return false, nil
+		}
+		result.Outcome = model.ActionNotReady
+		if ready {
+			result.Outcome = model.ActionReady
+		}
+		result.Message = &message
+		return true, nil
+	})
+	if err != nil && !wait.Interrupted(err) {
+		return nil, k8sError(err, target.errorCodes, "Failed to get %s '%s/%s', details: '%s'", target.kind, namespace, name, err.Error())
+	}
+
+	return result, nil
+}
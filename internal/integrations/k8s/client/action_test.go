@@ -0,0 +1,220 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	fluxmeta "github.com/fluxcd/pkg/apis/meta"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	kubocdv1alpha1 "kubocd/api/v1alpha1"
+
+	"github.com/okdp/okdp-server/internal/model"
+)
+
+func newKustomization(suspended bool) *kustomizev1.Kustomization {
+	return &kustomizev1.Kustomization{
+		ObjectMeta: metav1.ObjectMeta{Name: "releases-system", Namespace: "flux-system"},
+		Spec:       kustomizev1.KustomizationSpec{Suspend: suspended},
+	}
+}
+
+func TestKubeClient_RunKustomizationAction_Suspend(t *testing.T) {
+	// Given
+	direct := newFakeClient(newKustomization(false))
+	client := KubeClient{clusterID: "test", WithWatch: direct}
+
+	// When
+	result, err := client.RunKustomizationAction(context.Background(), "flux-system", "releases-system", model.ActionSuspend, time.Minute)
+
+	// Then
+	require.Nil(t, err)
+	assert.Equal(t, model.ActionSuspended, result.Outcome)
+	assert.Nil(t, result.RequestedAt)
+	var kustomization kustomizev1.Kustomization
+	require.NoError(t, direct.Get(context.Background(), ctrlclient.ObjectKey{Namespace: "flux-system", Name: "releases-system"}, &kustomization))
+	assert.True(t, kustomization.Spec.Suspend)
+}
+
+func TestKubeClient_RunKustomizationAction_Resume(t *testing.T) {
+	// Given
+	direct := newFakeClient(newKustomization(true))
+	client := KubeClient{clusterID: "test", WithWatch: direct}
+
+	// When
+	result, err := client.RunKustomizationAction(context.Background(), "flux-system", "releases-system", model.ActionResume, 0)
+
+	// Then
+	require.Nil(t, err)
+	assert.Equal(t, model.ActionRequested, result.Outcome, "the reconciliation is not waited")
+	require.NotNil(t, result.RequestedAt)
+	var kustomization kustomizev1.Kustomization
+	require.NoError(t, direct.Get(context.Background(), ctrlclient.ObjectKey{Namespace: "flux-system", Name: "releases-system"}, &kustomization))
+	assert.False(t, kustomization.Spec.Suspend)
+	assert.Equal(t, *result.RequestedAt, kustomization.Annotations[fluxmeta.ReconcileRequestAnnotation])
+}
+
+func TestKubeClient_RunKustomizationAction_ReconcileSuspended(t *testing.T) {
+	// Given
+	client := KubeClient{clusterID: "test", WithWatch: newFakeClient(newKustomization(true))}
+
+	// When
+	result, err := client.RunKustomizationAction(context.Background(), "flux-system", "releases-system", model.ActionReconcile, 0)
+
+	// Then
+	assert.Nil(t, result)
+	require.NotNil(t, err)
+	assert.Equal(t, http.StatusConflict, err.Status)
+	assert.Equal(t, string(model.ErrResourceSuspended), *err.Code)
+}
+
+func TestKubeClient_RunKustomizationAction_Timeout(t *testing.T) {
+	// Given
+	actionPollInterval = 10 * time.Millisecond
+	client := KubeClient{clusterID: "test", WithWatch: newFakeClient(newKustomization(false))}
+
+	// When
+	result, err := client.RunKustomizationAction(context.Background(), "flux-system", "releases-system", model.ActionReconcile, 50*time.Millisecond)
+
+	// Then
+	require.Nil(t, err)
+	assert.Equal(t, model.ActionTimeout, result.Outcome, "the request is never handled by the fake cluster")
+}
+
+// newChildHelmRelease returns a HelmRelease owned by the podinfo release
+func newChildHelmRelease() *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "helm.toolkit.fluxcd.io/v2",
+		"kind":       "HelmRelease",
+		"metadata": map[string]interface{}{
+			"name":            "podinfo-main",
+			"namespace":       "default",
+			"ownerReferences": []interface{}{map[string]interface{}{"apiVersion": "kubocd.kubotal.io/v1alpha1", "kind": "Release", "name": "podinfo", "uid": "uid-1"}},
+		},
+	}}
+}
+
+// newHelmControllerClient returns a fake client where the HelmReleases handle the reconcile requests when handling is set,
+// as the flux helm controller does
+func newHelmControllerClient(handling bool, objs ...ctrlclient.Object) ctrlclient.WithWatch {
+	return fake.NewClientBuilder().WithScheme(newScheme()).WithObjects(objs...).WithInterceptorFuncs(interceptor.Funcs{
+		List: func(ctx context.Context, client ctrlclient.WithWatch, list ctrlclient.ObjectList, opts ...ctrlclient.ListOption) error {
+			if err := client.List(ctx, list, opts...); err != nil {
+				return err
+			}
+			helmReleases, ok := list.(*unstructured.UnstructuredList)
+			if !ok || !handling {
+				return nil
+			}
+			for _, helmRelease := range helmReleases.Items {
+				requestedAt := helmRelease.GetAnnotations()[fluxmeta.ReconcileRequestAnnotation]
+				_ = unstructured.SetNestedField(helmRelease.Object, requestedAt, "status", "lastHandledReconcileAt")
+				_ = unstructured.SetNestedSlice(helmRelease.Object, []interface{}{map[string]interface{}{
+					"type": "Ready", "status": "True", "reason": "UpgradeSucceeded", "message": "Helm upgrade succeeded",
+					"observedGeneration": helmRelease.GetGeneration(), "lastTransitionTime": "2025-01-01T00:00:00Z",
+				}}, "status", "conditions")
+			}
+			return nil
+		},
+	}).Build()
+}
+
+func TestKubeClient_RunReleaseAction_Wait(t *testing.T) {
+	// Given
+	actionPollInterval = 10 * time.Millisecond
+	direct := newHelmControllerClient(true, newRelease("podinfo", "uid-1"), newChildHelmRelease())
+	client := KubeClient{clusterID: "test", WithWatch: direct}
+
+	// When
+	result, err := client.RunReleaseAction(context.Background(), "default", "podinfo", model.ActionReconcile, time.Minute)
+
+	// Then
+	require.Nil(t, err)
+	assert.Equal(t, model.ActionReady, result.Outcome)
+	assert.Equal(t, "HelmRelease 'podinfo-main': Helm upgrade succeeded", *result.Message)
+	helmRelease := newChildHelmRelease()
+	require.NoError(t, direct.Get(context.Background(), ctrlclient.ObjectKeyFromObject(helmRelease), helmRelease))
+	assert.Equal(t, *result.RequestedAt, helmRelease.GetAnnotations()[fluxmeta.ReconcileRequestAnnotation], "the request is propagated to the HelmRelease")
+}
+
+func TestKubeClient_RunReleaseAction_WaitNotHandled(t *testing.T) {
+	// Given
+	actionPollInterval = 10 * time.Millisecond
+	release := newRelease("podinfo", "uid-1")
+	release.Status.Phase = kubocdv1alpha1.ReleasePhaseReady
+	client := KubeClient{clusterID: "test", WithWatch: newHelmControllerClient(false, release, newChildHelmRelease())}
+
+	// When
+	result, err := client.RunReleaseAction(context.Background(), "default", "podinfo", model.ActionReconcile, 50*time.Millisecond)
+
+	// Then
+	require.Nil(t, err)
+	assert.Equal(t, model.ActionTimeout, result.Outcome, "the READY phase is the one of the previous reconciliation")
+	assert.Nil(t, result.Message)
+}
+
+func TestKubeClient_RunReleaseAction_WaitNoHelmRelease(t *testing.T) {
+	// Given
+	actionPollInterval = 10 * time.Millisecond
+	release := newRelease("podinfo", "uid-1")
+	release.Status.Phase = kubocdv1alpha1.ReleasePhaseError
+	client := KubeClient{clusterID: "test", WithWatch: newHelmControllerClient(false, release)}
+
+	// When
+	result, err := client.RunReleaseAction(context.Background(), "default", "podinfo", model.ActionReconcile, time.Minute)
+
+	// Then
+	require.Nil(t, err)
+	assert.Equal(t, model.ActionNotReady, result.Outcome)
+	assert.Equal(t, "ERROR", *result.Message)
+}
+
+func TestFluxReconciled(t *testing.T) {
+	// Given
+	kustomization := newKustomization(false)
+	kustomization.Generation = 2
+	kustomization.Status.LastHandledReconcileAt = "now"
+	condition := func(status metav1.ConditionStatus, generation int64) []metav1.Condition {
+		return []metav1.Condition{{Type: fluxmeta.ReadyCondition, Status: status, ObservedGeneration: generation, Message: "msg"}}
+	}
+
+	// When / Then
+	done, _, _ := fluxReconciled(kustomization, "before", condition(metav1.ConditionTrue, 2), "now")
+	assert.False(t, done, "the request is not handled yet")
+	done, _, _ = fluxReconciled(kustomization, "now", condition(metav1.ConditionUnknown, 2), "now")
+	assert.False(t, done, "the reconciliation is in progress")
+	done, _, _ = fluxReconciled(kustomization, "now", condition(metav1.ConditionTrue, 1), "now")
+	assert.False(t, done, "the condition is the one of the previous generation")
+	done, ready, message := fluxReconciled(kustomization, "now", condition(metav1.ConditionFalse, 2), "now")
+	assert.True(t, done)
+	assert.False(t, ready)
+	assert.Equal(t, "msg", message)
+	done, ready, _ = fluxReconciled(kustomization, "now", condition(metav1.ConditionTrue, 2), "now")
+	assert.True(t, done)
+	assert.True(t, ready)
+}
@@ -0,0 +1,218 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1"
+	sourcev1b2 "github.com/fluxcd/source-controller/api/v1beta2"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	apiruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	restclient "k8s.io/client-go/rest"
+	ctrlcache "sigs.k8s.io/controller-runtime/pkg/cache"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	kubocdv1alpha1 "kubocd/api/v1alpha1"
+
+	log "github.com/okdp/okdp-server/internal/common/logging"
+	"github.com/okdp/okdp-server/internal/config"
+)
+
+const (
+	eventInvolvedObjectName = "involvedObject.name"
+	eventInvolvedObjectKind = "involvedObject.kind"
+	// defaultSyncTimeout is the time given to the informers of a cluster to sync when not configured
+	defaultSyncTimeout = 2 * time.Minute
+)
+
+// cachedObjects are the kinds served by the informers, the other kinds (secrets, ...) are always read from the API server
+func cachedObjects() []ctrlclient.Object {
+	return []ctrlclient.Object{
+		&kubocdv1alpha1.Release{},
+		&kustomizev1.Kustomization{},
+		&sourcev1.GitRepository{},
+		&sourcev1b2.OCIRepository{},
+		&corev1.Namespace{},
+		&corev1.Pod{},
+		&corev1.Event{},
+	}
+}
+
+// informers are the shared informers of a cluster. The reads are served by the informers once they are synced,
+// they go directly to the API server until then. The informers which do not sync in time are stopped (given up),
+// the reads of the cluster then always go to the API server.
+type informers struct {
+	cache   ctrlcache.Cache
+	scheme  *apiruntime.Scheme
+	kinds   map[schema.GroupVersionKind]bool
+	synced  atomic.Bool
+	givenUp atomic.Bool
+}
+
+// startInformers starts the shared informers of a cluster, the informers live as long as the server (ctx)
+func startInformers(ctx context.Context, clusterID string, restConfig *restclient.Config, scheme *apiruntime.Scheme, conf config.Informers) (*informers, error) {
+	options := ctrlcache.Options{
+		Scheme:                      scheme,
+		ReaderFailOnMissingInformer: true,
+	}
+	if conf.ResyncPeriod > 0 {
+		resyncPeriod := time.Duration(conf.ResyncPeriod) * time.Second
+		options.SyncPeriod = &resyncPeriod
+	}
+
+	cache, err := ctrlcache.New(restConfig, options)
+	if err != nil {
+		return nil, err
+	}
+
+	syncTimeout := defaultSyncTimeout
+	if conf.SyncTimeout > 0 {
+		syncTimeout = time.Duration(conf.SyncTimeout) * time.Second
+	}
+
+	ctx, stop := context.WithCancel(ctx)
+	if err := indexEvents(ctx, cache); err != nil {
+		stop()
+		return nil, err
+	}
+
+	i := &informers{
+		cache:  cache,
+		scheme: scheme,
+		kinds:  make(map[schema.GroupVersionKind]bool),
+	}
+	for _, obj := range cachedObjects() {
+		gvk, err := apiutil.GVKForObject(obj, scheme)
+		if err != nil {
+			stop()
+			return nil, err
+		}
+		if _, err := cache.GetInformer(ctx, obj, ctrlcache.BlockUntilSynced(false)); err != nil {
+			log.Warn("Unable to start the informer of %s on cluster '%s', reading it from the API server, details: %v", gvk.Kind, clusterID, err)
+			continue
+		}
+		i.kinds[gvk] = true
+	}
+
+	go func() {
+		if err := cache.Start(ctx); err != nil {
+			log.Error("The informers of the cluster '%s' stopped, details: %v", clusterID, err)
+		}
+	}()
+	go func() {
+		syncCtx, cancel := context.WithTimeout(ctx, syncTimeout)
+		defer cancel()
+		if cache.WaitForCacheSync(syncCtx) {
+			i.synced.Store(true)
+			log.Info("The informers of the cluster '%s' are synced", clusterID)
+			return
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		i.givenUp.Store(true)
+		stop()
+		log.Warn("The informers of the cluster '%s' are not synced after %s, reading the cluster from the API server", clusterID, syncTimeout)
+	}()
+
+	return i, nil
+}
+
+// eventIndexes index the events by involved object, in order to look up the events of a release
+var eventIndexes = map[string]ctrlclient.IndexerFunc{
+	eventInvolvedObjectName: func(obj ctrlclient.Object) []string {
+		return []string{obj.(*corev1.Event).InvolvedObject.Name}
+	},
+	eventInvolvedObjectKind: func(obj ctrlclient.Object) []string {
+		return []string{obj.(*corev1.Event).InvolvedObject.Kind}
+	},
+}
+
+func indexEvents(ctx context.Context, cache ctrlcache.Cache) error {
+	for field, index := range eventIndexes {
+		if err := cache.IndexField(ctx, &corev1.Event{}, field, index); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Syncing returns true if the informers are enabled and still syncing, neither synced nor given up
+func (i *informers) Syncing() bool {
+	return i != nil && !i.synced.Load() && !i.givenUp.Load()
+}
+
+// serves returns true if the object (or list) is read from the informers
+func (i *informers) serves(obj apiruntime.Object) bool {
+	if i == nil || !i.synced.Load() {
+		return false
+	}
+	gvk, err := apiutil.GVKForObject(obj, i.scheme)
+	if err != nil {
+		return false
+	}
+	gvk.Kind = strings.TrimSuffix(gvk.Kind, "List")
+	return i.kinds[gvk]
+}
+
+// Get reads the object from the informers when cached, from the API server otherwise.
+// The read-modify-write operations read from the API server (c.WithWatch.Get) to get the latest version.
+func (c KubeClient) Get(ctx context.Context, key ctrlclient.ObjectKey, obj ctrlclient.Object, opts ...ctrlclient.GetOption) error {
+	if c.informers.serves(obj) {
+		return c.informers.cache.Get(ctx, key, obj, opts...)
+	}
+	return c.WithWatch.Get(ctx, key, obj, opts...)
+}
+
+// List reads the objects from the informers when cached, from the API server otherwise
+func (c KubeClient) List(ctx context.Context, list ctrlclient.ObjectList, opts ...ctrlclient.ListOption) error {
+	if c.informers.serves(list) {
+		return c.informers.cache.List(ctx, list, opts...)
+	}
+	return c.WithWatch.List(ctx, list, opts...)
+}
+
+// listInNamespaces lists the objects of the given namespaces (all the namespaces when none is given).
+// The informers are looked up by namespace index, the API server is listed once cluster-wide and
+// the callers filter the namespaces.
+func (c KubeClient) listInNamespaces(ctx context.Context, list ctrlclient.ObjectList, namespaces []string, opts ...ctrlclient.ListOption) error {
+	if len(namespaces) == 0 || !c.informers.serves(list) {
+		return c.List(ctx, list, opts...)
+	}
+
+	var items []apiruntime.Object
+	for _, namespace := range namespaces {
+		page := list.DeepCopyObject().(ctrlclient.ObjectList)
+		if err := c.List(ctx, page, append(opts, ctrlclient.InNamespace(namespace))...); err != nil {
+			return err
+		}
+		objs, err := apimeta.ExtractList(page)
+		if err != nil {
+			return err
+		}
+		items = append(items, objs...)
+	}
+	return apimeta.SetList(list, items)
+}
@@ -0,0 +1,150 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrlcache "sigs.k8s.io/controller-runtime/pkg/cache"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/okdp/okdp-server/internal/model"
+)
+
+// fakeCache serves the reads of the informers from a fake client
+type fakeCache struct {
+	ctrlcache.Cache
+	reader ctrlclient.Reader
+}
+
+func (f fakeCache) Get(ctx context.Context, key ctrlclient.ObjectKey, obj ctrlclient.Object, opts ...ctrlclient.GetOption) error {
+	return f.reader.Get(ctx, key, obj, opts...)
+}
+
+func (f fakeCache) List(ctx context.Context, list ctrlclient.ObjectList, opts ...ctrlclient.ListOption) error {
+	return f.reader.List(ctx, list, opts...)
+}
+
+func newFakeClient(objs ...ctrlclient.Object) ctrlclient.WithWatch {
+	builder := fake.NewClientBuilder().WithScheme(newScheme()).WithObjects(objs...)
+	for field, index := range eventIndexes {
+		builder = builder.WithIndex(&corev1.Event{}, field, index)
+	}
+	return builder.Build()
+}
+
+func newCachedClient(direct ctrlclient.WithWatch, cached ctrlclient.WithWatch, synced bool) KubeClient {
+	i := &informers{
+		cache:  fakeCache{reader: cached},
+		scheme: newScheme(),
+		kinds: map[schema.GroupVersionKind]bool{
+			corev1.SchemeGroupVersion.WithKind("Pod"):   true,
+			corev1.SchemeGroupVersion.WithKind("Event"): true,
+		},
+	}
+	i.synced.Store(synced)
+	return KubeClient{clusterID: "test", WithWatch: direct, informers: i}
+}
+
+func newNamespacedPod(name string, namespace string) *corev1.Pod {
+	return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
+}
+
+func TestInformers_Serves(t *testing.T) {
+	// Given
+	var disabled *informers
+	unsynced := newCachedClient(nil, nil, false).informers
+	synced := newCachedClient(nil, nil, true).informers
+	givenUp := newCachedClient(nil, nil, false).informers
+	givenUp.givenUp.Store(true)
+
+	// When / Then
+	assert.False(t, disabled.Syncing(), "disabled informers do not block the readiness")
+	assert.False(t, disabled.serves(&corev1.Pod{}))
+	assert.True(t, unsynced.Syncing())
+	assert.False(t, unsynced.serves(&corev1.Pod{}), "unsynced informers are not read")
+	assert.False(t, synced.Syncing())
+	assert.True(t, synced.serves(&corev1.Pod{}))
+	assert.True(t, synced.serves(&corev1.PodList{}))
+	assert.False(t, synced.serves(&corev1.Secret{}), "secrets are never cached")
+	assert.False(t, givenUp.Syncing(), "the informers given up after the sync timeout do not block the readiness")
+	assert.False(t, givenUp.serves(&corev1.Pod{}), "the cluster is read from the API server")
+}
+
+func TestKubeClient_ListInNamespaces(t *testing.T) {
+	// Given
+	direct := newFakeClient(newNamespacedPod("direct", "a"))
+	cached := newFakeClient(newNamespacedPod("p1", "a"), newNamespacedPod("p2", "b"), newNamespacedPod("p3", "c"))
+	client := newCachedClient(direct, cached, true)
+
+	// When
+	pods, err := client.ListPods(context.Background(), "a", "c")
+
+	// Then
+	require.Nil(t, err)
+	names := []string{}
+	for _, pod := range pods {
+		names = append(names, pod.Name)
+	}
+	assert.ElementsMatch(t, []string{"p1", "p3"}, names)
+}
+
+func TestKubeClient_ReadsDirectlyUntilSynced(t *testing.T) {
+	// Given
+	direct := newFakeClient(newNamespacedPod("direct", "a"))
+	cached := newFakeClient(newNamespacedPod("cached", "a"))
+	client := newCachedClient(direct, cached, false)
+
+	// When
+	pods, err := client.ListPods(context.Background(), "a")
+
+	// Then
+	require.Nil(t, err)
+	require.Len(t, pods, 1)
+	assert.Equal(t, "direct", pods[0].Name)
+}
+
+func TestKubeClient_ListEventsRelease(t *testing.T) {
+	// Given
+	event := func(name string, involvedName string, involvedKind string) *corev1.Event {
+		return &corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: name, Namespace: "default"},
+			InvolvedObject: corev1.ObjectReference{Name: involvedName, Kind: involvedKind},
+		}
+	}
+	cached := newFakeClient(
+		event("e1", "podinfo", "Release"),
+		event("e2", "podinfo", "Pod"),
+		event("e3", "other", "Release"),
+	)
+	client := newCachedClient(newFakeClient(), cached, true)
+
+	// When
+	events, err := client.ListEventsRelease(context.Background(), "default", "podinfo", &model.ListOptions{})
+
+	// Then
+	require.Nil(t, err)
+	require.Len(t, events.Items, 1)
+	assert.Equal(t, "e1", events.Items[0]["metadata"].(map[string]interface{})["name"])
+}
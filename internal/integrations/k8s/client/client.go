@@ -17,13 +17,18 @@
 package client
 
 import (
+	"context"
 	"fmt"
+	"sort"
 	"sync"
 
 	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
 	sourcev1 "github.com/fluxcd/source-controller/api/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	apiruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	restclient "k8s.io/client-go/rest"
@@ -43,6 +48,8 @@ import (
 var (
 	instance *KubeClients
 	once     sync.Once
+	// serverCtx is the lifetime of the server, the informers are stopped once it is done
+	serverCtx = context.Background()
 )
 
 type KubeClients struct {
@@ -51,14 +58,23 @@ type KubeClients struct {
 
 type KubeClient struct {
 	clusterID string
-	ctrlclient.Client
+	ctrlclient.WithWatch
 	*kubernetes.Clientset
+	informers *informers
+	dynamic   dynamic.Interface
+	config    *restclient.Config
+}
+
+// SetServerContext ties the informers to the lifetime of the server, it is called before the first use of the clients
+func SetServerContext(ctx context.Context) {
+	serverCtx = ctx
 }
 
 func GetClients() *KubeClients {
 	once.Do(func() {
 		clients := make(map[string]*KubeClient)
 		clusters := config.GetAppConfig().Clusters
+		informersConf := config.GetAppConfig().Informers
 
 		for _, cluster := range clusters {
 			log.Info("K8S Cluster configuration: %+v", cluster)
@@ -68,8 +84,9 @@ func GetClients() *KubeClients {
 				log.Fatal("Failed to get config for cluster ID '%s (%s)': %v", cluster.ID, cluster.Env, err)
 			}
 
-			ctrlClient, err := ctrlclient.New(config, ctrlclient.Options{
-				Scheme: newScheme(),
+			scheme := newScheme()
+			ctrlClient, err := ctrlclient.NewWithWatch(config, ctrlclient.Options{
+				Scheme: scheme,
 			})
 			if err != nil {
 				log.Fatal("Failed to initialize controller-runtime client for cluster for cluster ID '%s (%s)': %v", cluster.ID, cluster.Env, err)
@@ -80,7 +97,20 @@ func GetClients() *KubeClients {
 				log.Fatal("Failed to initialize client-go clientset for cluster ID '%s (%s)': %v", cluster.ID, cluster.Env, err)
 			}
 
-			clients[utils.MapKey(cluster.ID)] = &KubeClient{cluster.ID, ctrlClient, k8sClientset}
+			dynamicClient, err := dynamic.NewForConfig(config)
+			if err != nil {
+				log.Fatal("Failed to initialize the dynamic client for cluster ID '%s (%s)': %v", cluster.ID, cluster.Env, err)
+			}
+
+			var clusterInformers *informers
+			if informersConf.Enabled {
+				clusterInformers, err = startInformers(serverCtx, cluster.ID, config, scheme, informersConf)
+				if err != nil {
+					log.Fatal("Failed to initialize the informers for cluster ID '%s (%s)': %v", cluster.ID, cluster.Env, err)
+				}
+			}
+
+			clients[utils.MapKey(cluster.ID)] = &KubeClient{cluster.ID, ctrlClient, k8sClientset, clusterInformers, dynamicClient, config}
 		}
 
 		instance = &KubeClients{clients: clients}
@@ -89,6 +119,15 @@ func GetClients() *KubeClients {
 	return instance
 }
 
+// NewKubeClients returns the clients of the clusters on the given controller-runtime clients, without informers
+func NewKubeClients(clients map[string]ctrlclient.WithWatch) *KubeClients {
+	kubeClients := &KubeClients{clients: map[string]*KubeClient{}}
+	for clusterID, client := range clients {
+		kubeClients.clients[utils.MapKey(clusterID)] = &KubeClient{clusterID: clusterID, WithWatch: client}
+	}
+	return kubeClients
+}
+
 func (c KubeClients) GetClient(clusterID string) (*KubeClient, *model.ServerResponse) {
 	client, found := c.clients[clusterID]
 	if !found {
@@ -98,11 +137,25 @@ func (c KubeClients) GetClient(clusterID string) (*KubeClient, *model.ServerResp
 	return client, nil
 }
 
+// Unsynced returns the IDs of the clusters whose informers are still syncing
+func (c KubeClients) Unsynced() []string {
+	unsynced := []string{}
+	for _, client := range c.clients {
+		if client.informers.Syncing() {
+			unsynced = append(unsynced, client.clusterID)
+		}
+	}
+	sort.Strings(unsynced)
+	return unsynced
+}
+
 func newScheme() *apiruntime.Scheme {
 	scheme := apiruntime.NewScheme()
 	_ = sourcev1.AddToScheme(scheme)
 	_ = kustomizev1.AddToScheme(scheme)
 	_ = corev1.AddToScheme(scheme)
+	_ = appsv1.AddToScheme(scheme)
+	_ = batchv1.AddToScheme(scheme)
 	_ = kubocdv1alpha1.AddToScheme(scheme)
 	return scheme
 }
@@ -0,0 +1,90 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package client
+
+import (
+	"net/http"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/okdp/okdp-server/internal/model"
+)
+
+// k8sErrorCodes maps the kubernetes status reasons to the error codes of a specific resource
+type k8sErrorCodes map[metav1.StatusReason]model.ErrorCode
+
+var (
+	namespaceErrorCodes = k8sErrorCodes{
+		metav1.StatusReasonNotFound:      model.ErrNamespaceNotFound,
+		metav1.StatusReasonAlreadyExists: model.ErrNamespaceAlreadyExists,
+	}
+	releaseErrorCodes = k8sErrorCodes{
+		metav1.StatusReasonNotFound:      model.ErrReleaseNotFound,
+		metav1.StatusReasonAlreadyExists: model.ErrReleaseAlreadyExists,
+		metav1.StatusReasonConflict:      model.ErrReleaseConflict,
+		metav1.StatusReasonInvalid:       model.ErrReleaseInvalid,
+	}
+	gitRepoErrorCodes = k8sErrorCodes{
+		metav1.StatusReasonNotFound: model.ErrGitRepoNotFound,
+	}
+)
+
+// k8sStatusCodes maps the kubernetes status reasons to the HTTP status returned to the user.
+// The unauthorized errors mean the okdp-server credentials are rejected by the cluster, they are returned as forbidden
+// to not be confused with the user authentication.
+var k8sStatusCodes = map[metav1.StatusReason]int{
+	metav1.StatusReasonNotFound:           http.StatusNotFound,
+	metav1.StatusReasonAlreadyExists:      http.StatusConflict,
+	metav1.StatusReasonConflict:           http.StatusConflict,
+	metav1.StatusReasonForbidden:          http.StatusForbidden,
+	metav1.StatusReasonUnauthorized:       http.StatusForbidden,
+	metav1.StatusReasonInvalid:            http.StatusUnprocessableEntity,
+	metav1.StatusReasonBadRequest:         http.StatusBadRequest,
+	metav1.StatusReasonExpired:            http.StatusGone,
+	metav1.StatusReasonTimeout:            http.StatusGatewayTimeout,
+	metav1.StatusReasonServerTimeout:      http.StatusGatewayTimeout,
+	metav1.StatusReasonTooManyRequests:    http.StatusTooManyRequests,
+	metav1.StatusReasonServiceUnavailable: http.StatusServiceUnavailable,
+}
+
+// k8sError converts a kubernetes API error into a server response with the matching HTTP status and error code.
+// The field causes of the invalid requests are returned as error details.
+func k8sError(err error, codes k8sErrorCodes, messages ...interface{}) *model.ServerResponse {
+	response := model.NewServerResponse(model.K8sClusterResponse)
+	reason := apierrors.ReasonForError(err)
+
+	statusCode, found := k8sStatusCodes[reason]
+	if !found {
+		return response.UnprocessableEntity(messages...)
+	}
+	code, found := codes[reason]
+	if !found && reason == metav1.StatusReasonInvalid {
+		code, found = model.ErrValidationFailed, true
+	}
+	if found {
+		response.WithCode(code)
+	}
+	response.GenericError(statusCode, messages...)
+
+	if status, ok := err.(apierrors.APIStatus); ok && status.Status().Details != nil {
+		for _, cause := range status.Status().Details.Causes {
+			response.WithDetails(model.NewErrorDetail(cause.Field, string(cause.Type), cause.Message))
+		}
+	}
+	return response
+}
@@ -0,0 +1,87 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package client
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/okdp/okdp-server/internal/model"
+)
+
+var releaseResource = schema.GroupResource{Group: "kubocd.kubotal.io", Resource: "releases"}
+
+func TestK8sError_NotFound(t *testing.T) {
+	// When
+	resp := k8sError(apierrors.NewNotFound(releaseResource, "podinfo"), releaseErrorCodes, "Failed to get release")
+	// Then
+	assert.Equal(t, http.StatusNotFound, resp.Status)
+	assert.True(t, resp.HasCode(model.ErrReleaseNotFound))
+}
+
+func TestK8sError_Conflict(t *testing.T) {
+	// When
+	resp := k8sError(apierrors.NewConflict(releaseResource, "podinfo", errors.New("object modified")), releaseErrorCodes, "Failed to update release")
+	// Then
+	assert.Equal(t, http.StatusConflict, resp.Status)
+	assert.True(t, resp.HasCode(model.ErrReleaseConflict))
+}
+
+func TestK8sError_Invalid(t *testing.T) {
+	// Given
+	err := apierrors.NewInvalid(schema.GroupKind{Group: "kubocd.kubotal.io", Kind: "Release"}, "podinfo", field.ErrorList{
+		field.Required(field.NewPath("spec", "package", "repository"), "repository is required"),
+	})
+	// When
+	resp := k8sError(err, nil, "Failed to create release")
+	// Then
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.Status)
+	assert.True(t, resp.HasCode(model.ErrValidationFailed))
+	assert.Len(t, *resp.Details, 1)
+	assert.Equal(t, "spec.package.repository", *(*resp.Details)[0].Field)
+	assert.Equal(t, "FieldValueRequired", *(*resp.Details)[0].Reason)
+}
+
+func TestK8sError_Forbidden(t *testing.T) {
+	// When
+	resp := k8sError(apierrors.NewUnauthorized("invalid token"), nil, "Failed to list releases")
+	// Then
+	assert.Equal(t, http.StatusForbidden, resp.Status)
+	assert.True(t, resp.HasCode(model.ErrForbidden))
+}
+
+func TestK8sError_Unknown(t *testing.T) {
+	// When
+	resp := k8sError(errors.New("connection refused"), releaseErrorCodes, "Failed to list releases")
+	// Then
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.Status)
+	assert.True(t, resp.HasCode(model.ErrKubernetes))
+}
+
+func TestK8sError_Expired(t *testing.T) {
+	// When
+	resp := k8sError(apierrors.NewResourceExpired("continue token expired"), nil, "Failed to list releases")
+	// Then
+	assert.Equal(t, http.StatusGone, resp.Status)
+	assert.True(t, resp.HasCode(model.ErrContinueExpired))
+}
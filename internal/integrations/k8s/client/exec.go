@@ -0,0 +1,104 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"errors"
+	"net/url"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/client-go/kubernetes/scheme"
+	restclient "k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+	utilexec "k8s.io/client-go/util/exec"
+
+	"github.com/okdp/okdp-server/internal/model"
+)
+
+// terminalSizeQueue notifies the executor of the terminal sizes until the channel is closed
+type terminalSizeQueue <-chan model.TerminalSize
+
+func (q terminalSizeQueue) Next() *remotecommand.TerminalSize {
+	size, open := <-q
+	if !open {
+		return nil
+	}
+	return &remotecommand.TerminalSize{Width: size.Cols, Height: size.Rows}
+}
+
+// Exec runs the command in the container of the pod with the streams attached until the command exits or the context
+// is done. It returns the exit code of the command, the stderr is merged into the stdout with a TTY.
+func (c KubeClient) Exec(ctx context.Context, namespace string, pod string, container string, options *model.ExecOptions) (int, *model.ServerResponse) {
+	request := c.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(namespace).
+		Name(pod).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: container,
+			Command:   options.Command,
+			Stdin:     options.Stdin != nil,
+			Stdout:    true,
+			Stderr:    !options.TTY,
+			TTY:       options.TTY,
+		}, scheme.ParameterCodec)
+
+	executor, err := newExecutor(c.config, request.URL())
+	if err != nil {
+		return -1, k8sError(err, nil, "Failed to exec into '%s/%s (%s)' on cluster '%s', details: '%s'", namespace, pod, container, c.clusterID, err.Error())
+	}
+
+	streams := remotecommand.StreamOptions{
+		Stdin:  options.Stdin,
+		Stdout: options.Stdout,
+		Tty:    options.TTY,
+	}
+	if !options.TTY {
+		streams.Stderr = options.Stderr
+	}
+	if options.TTY && options.Resize != nil {
+		streams.TerminalSizeQueue = terminalSizeQueue(options.Resize)
+	}
+
+	err = executor.StreamWithContext(ctx, streams)
+	var exitErr utilexec.ExitError
+	switch {
+	case errors.As(err, &exitErr):
+		return exitErr.ExitStatus(), nil
+	case err != nil:
+		return -1, k8sError(err, nil, "Failed to exec into '%s/%s (%s)' on cluster '%s', details: '%s'", namespace, pod, container, c.clusterID, err.Error())
+	}
+	return 0, nil
+}
+
+// newExecutor returns an executor using the WebSocket protocol, falling back to SPDY on the API servers which do not
+// support it (as kubectl does)
+func newExecutor(config *restclient.Config, url *url.URL) (remotecommand.Executor, error) {
+	spdy, err := remotecommand.NewSPDYExecutor(config, "POST", url)
+	if err != nil {
+		return nil, err
+	}
+	websocket, err := remotecommand.NewWebSocketExecutor(config, "GET", url.String())
+	if err != nil {
+		return nil, err
+	}
+	return remotecommand.NewFallbackExecutor(websocket, spdy, func(err error) bool {
+		return httpstream.IsUpgradeFailure(err) || httpstream.IsHTTPSProxyError(err)
+	})
+}
@@ -30,10 +30,8 @@ import (
 
 func (c KubeClient) ListKutomizations(ctx context.Context, namespaces ...string) ([]*kustomizev1.Kustomization, *model.ServerResponse) {
 	var kustomizationList kustomizev1.KustomizationList
-	if err := c.List(ctx, &kustomizationList); err != nil {
-		return nil, model.
-			NewServerResponse(model.K8sClusterResponse).
-			UnprocessableEntity("Failed to list Kustomizations '%s' ", err.Error())
+	if err := c.listInNamespaces(ctx, &kustomizationList, namespaces); err != nil {
+		return nil, k8sError(err, nil, "Failed to list Kustomizations '%s' ", err.Error())
 	}
 
 	filtered := utils.Filter(kustomizationList.Items, func(k kustomizev1.Kustomization) bool {
@@ -44,17 +42,29 @@ func (c KubeClient) ListKutomizations(ctx context.Context, namespaces ...string)
 
 }
 
+func (c KubeClient) GetKustomization(ctx context.Context, name string, namespace string) (*kustomizev1.Kustomization, *model.ServerResponse) {
+	key := ctrlclient.ObjectKey{
+		Namespace: namespace,
+		Name:      name,
+	}
+	var kustomization kustomizev1.Kustomization
+	err := c.Get(ctx, key, &kustomization)
+	if err != nil {
+		return nil, k8sError(err, gitRepoErrorCodes, "Failed to get fluxcd Kustomization '%s' in namespace '%s', details: '%s'", name, namespace, err.Error())
+	}
+
+	return &kustomization, nil
+}
+
 func (c KubeClient) ListGitRepositories(ctx context.Context, namespaces ...string) ([]*sourcev1.GitRepository, *model.ServerResponse) {
 
 	var repos sourcev1.GitRepositoryList
-	if err := c.List(ctx, &repos); err != nil {
-		return nil, model.
-			NewServerResponse(model.K8sClusterResponse).
-			UnprocessableEntity("Failed to list Git Repositories '%s'", err.Error())
+	if err := c.listInNamespaces(ctx, &repos, namespaces); err != nil {
+		return nil, k8sError(err, nil, "Failed to list Git Repositories '%s'", err.Error())
 	}
 
 	filtered := utils.Filter(repos.Items, func(k sourcev1.GitRepository) bool {
-		return utils.Contains(namespaces, k.Namespace)
+		return len(namespaces) == 0 || utils.Contains(namespaces, k.Namespace)
 	})
 
 	return filtered, nil
@@ -68,9 +78,7 @@ func (c KubeClient) GetGitRepository(ctx context.Context, name string, namespace
 	var repo sourcev1.GitRepository
 	err := c.Get(ctx, repoKey, &repo)
 	if err != nil {
-		return nil, model.
-			NewServerResponse(model.K8sClusterResponse).
-			UnprocessableEntity("Failed to get fluxcd Git Repository '%s' in namespace '%s', details: '%s'", name, namespace, err.Error())
+		return nil, k8sError(err, gitRepoErrorCodes, "Failed to get fluxcd Git Repository '%s' in namespace '%s', details: '%s'", name, namespace, err.Error())
 	}
 
 	return &repo, nil
@@ -79,10 +87,8 @@ func (c KubeClient) GetGitRepository(ctx context.Context, name string, namespace
 func (c KubeClient) ListOCIRepositories(ctx context.Context, namespaces ...string) ([]*sourcev1b2.OCIRepository, *model.ServerResponse) {
 
 	var repos sourcev1b2.OCIRepositoryList
-	if err := c.List(ctx, &repos); err != nil {
-		return nil, model.
-			NewServerResponse(model.K8sClusterResponse).
-			UnprocessableEntity("Failed to list OCI Repositories '%s'", err.Error())
+	if err := c.listInNamespaces(ctx, &repos, namespaces); err != nil {
+		return nil, k8sError(err, nil, "Failed to list OCI Repositories '%s'", err.Error())
 	}
 
 	filtered := utils.Filter(repos.Items, func(k sourcev1b2.OCIRepository) bool {
@@ -100,9 +106,7 @@ func (c KubeClient) GetOCIRepository(ctx context.Context, name string, namespace
 	var repo sourcev1b2.OCIRepository
 	err := c.Get(ctx, repoKey, &repo)
 	if err != nil {
-		return nil, model.
-			NewServerResponse(model.K8sClusterResponse).
-			UnprocessableEntity("Failed to get fluxcd OCI Repository '%s' in namespace '%s', details: '%s'", name, namespace, err.Error())
+		return nil, k8sError(err, nil, "Failed to get fluxcd OCI Repository '%s' in namespace '%s', details: '%s'", name, namespace, err.Error())
 	}
 
 	return &repo, nil
@@ -26,9 +26,7 @@ import (
 	"github.com/okdp/okdp-server/internal/utils"
 )
 
-func (c KubeClient) ListGitRepos(namespaces ...string) ([]*model.GitRepository, *model.ServerResponse) {
-
-	ctx := context.Background()
+func (c KubeClient) ListGitRepos(ctx context.Context, namespaces ...string) ([]*model.GitRepository, *model.ServerResponse) {
 
 	var kustomizations []*kustomizev1.Kustomization
 	kustomizations, err := c.ListKutomizations(ctx, namespaces...)
@@ -0,0 +1,269 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	kubocdv1alpha1 "kubocd/api/v1alpha1"
+
+	"github.com/okdp/okdp-server/internal/common/constants"
+	"github.com/okdp/okdp-server/internal/model"
+	"github.com/okdp/okdp-server/internal/utils"
+)
+
+// helmReleaseListGVK is read as unstructured, the flux helm-controller API is not a dependency of the server
+var helmReleaseListGVK = schema.GroupVersionKind{Group: "helm.toolkit.fluxcd.io", Version: "v2", Kind: "HelmReleaseList"}
+
+// crashReasons are the container reasons which make a release degraded rather than progressing
+var crashReasons = []string{"CrashLoopBackOff", "ImagePullBackOff", "ErrImagePull", "CreateContainerConfigError", "OOMKilled", "Error"}
+
+// GetReleaseHealth returns the aggregated health of the release
+func (c KubeClient) GetReleaseHealth(ctx context.Context, namespace string, releaseName string) (*model.ReleaseHealth, *model.ServerResponse) {
+	release, err := c.GetRelease(ctx, namespace, releaseName)
+	if err != nil {
+		return nil, err
+	}
+	healths, err := c.releasesHealth(ctx, namespace, []*model.Release{release})
+	if err != nil {
+		return nil, err
+	}
+	return healths[0], nil
+}
+
+// ListReleasesHealth returns the aggregated health of all the releases of the namespace
+func (c KubeClient) ListReleasesHealth(ctx context.Context, namespace string) ([]*model.ReleaseHealth, *model.ServerResponse) {
+	releases, err := c.ListReleases(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	return c.releasesHealth(ctx, namespace, releases)
+}
+
+// releasesHealth lists the pods, the workloads and the events of the releases (target) namespaces and the helm
+// releases once and computes the health of each release
+func (c KubeClient) releasesHealth(ctx context.Context, namespace string, releases []*model.Release) ([]*model.ReleaseHealth, *model.ServerResponse) {
+	namespaces := []string{namespace}
+	for _, release := range releases {
+		if target := release.Spec.TargetNamespace; target != "" && !utils.Contains(namespaces, target) {
+			namespaces = append(namespaces, target)
+		}
+	}
+
+	pods, err := c.ListPods(ctx, namespaces...)
+	if err != nil {
+		return nil, err
+	}
+
+	var eventList corev1.EventList
+	if er := c.listInNamespaces(ctx, &eventList, namespaces); er != nil {
+		return nil, k8sError(er, nil, "failed to list events from cluster '%s', details: '%s'", c.clusterID, er.Error())
+	}
+	events := utils.Filter(eventList.Items, func(e corev1.Event) bool {
+		return e.Type == corev1.EventTypeWarning && utils.Contains(namespaces, e.Namespace)
+	})
+
+	helmReleases := c.listHelmReleases(ctx, namespace)
+	resolver, er := c.newWorkloadResolver(ctx, namespaces...)
+	if er != nil {
+		return nil, k8sError(er, nil, "failed to list the workloads from cluster '%s', details: '%s'", c.clusterID, er.Error())
+	}
+
+	now := time.Now()
+	result := make([]*model.ReleaseHealth, 0, len(releases))
+	for _, release := range releases {
+		var releasePods []*corev1.Pod
+		for _, p := range resolver.releasePods(ctx, newReleaseSelector(release, namespace, helmReleases), pods) {
+			releasePods = append(releasePods, p.pod)
+		}
+		result = append(result, releaseHealth(release, releasePods, events, helmReleases, now))
+	}
+	return result, nil
+}
+
+// releaseHealth rolls the release phase, its pods, the warning events and the helm releases up into a single status
+func releaseHealth(release *model.Release, pods []*corev1.Pod, events []*corev1.Event, helmReleases []unstructured.Unstructured, now time.Time) *model.ReleaseHealth {
+	health := model.NewReleaseHealth(release)
+
+	podNames := map[string]bool{}
+	crashing := 0
+	for _, pod := range pods {
+		podNames[pod.Name] = true
+		health.Pods++
+		restarts := podRestarts(pod)
+		health.Restarts += restarts
+
+		podHealth := utils.GetPodHealth(pod)
+		if podHealth == constants.StateHealthy || podHealth == constants.StateCompleted {
+			health.ReadyPods++
+			continue
+		}
+		reason := podReason(pod)
+		if podHealth == constants.StateFailed || utils.Contains(crashReasons, reason) || restarts > 0 {
+			crashing++
+		}
+		health.UnreadyPods = append(health.UnreadyPods, &model.UnreadyPod{
+			Name:     pod.Name,
+			Health:   podHealth,
+			Reason:   reason,
+			Restarts: restarts,
+		})
+	}
+
+	var lastReconciled time.Time
+	helmReleaseNames := map[string]bool{}
+	for i := range helmReleases {
+		if isReleaseHelmRelease(&helmReleases[i], release) {
+			helmReleaseNames[helmReleases[i].GetName()] = true
+			if t := helmReleaseReconciled(&helmReleases[i]); t.After(lastReconciled) {
+				lastReconciled = t
+			}
+		}
+	}
+	health.WithLastReconciled(lastReconciled, now)
+
+	var warnings []*model.HealthEvent
+	for _, event := range events {
+		involved := event.InvolvedObject
+		if (involved.Kind == "Release" && involved.Name == release.Name && event.Namespace == release.Namespace) ||
+			(involved.Kind == "Pod" && podNames[involved.Name]) ||
+			(involved.Kind == "HelmRelease" && helmReleaseNames[involved.Name]) {
+			warnings = append(warnings, model.NewHealthEvent(event))
+		}
+	}
+	health.WithWarningEvents(utils.NilToEmptySlice(warnings))
+
+	notReady := notReadyHelmReleases(release)
+	phase := release.Status.Phase
+	switch {
+	case release.Spec.Suspended || phase == kubocdv1alpha1.ReleasePhaseSuspended:
+		health.Status = model.HealthSuspended
+		health.Reasons = append(health.Reasons, "The release is suspended")
+	case phase == kubocdv1alpha1.ReleasePhaseError:
+		health.Status = model.HealthFailed
+		health.Reasons = append(health.Reasons, "The release is in ERROR phase")
+		health.Reasons = append(health.Reasons, notReady...)
+	case phase != kubocdv1alpha1.ReleasePhaseReady:
+		health.Status = model.HealthProgressing
+		health.Reasons = append(health.Reasons, fmt.Sprintf("The release is in %s phase", utils.DefaultIfEmpty(string(phase), "UNKNOWN")))
+		if release.Status.MissingDependency != "" {
+			health.Reasons = append(health.Reasons, fmt.Sprintf("Waiting for the dependency '%s'", release.Status.MissingDependency))
+		}
+	case crashing > 0 || len(notReady) > 0:
+		health.Status = model.HealthDegraded
+		health.Reasons = append(health.Reasons, notReady...)
+		if crashing > 0 {
+			health.Reasons = append(health.Reasons, fmt.Sprintf("%d pod(s) are failing or restarting", crashing))
+		}
+	case len(health.UnreadyPods) > 0:
+		health.Status = model.HealthProgressing
+		health.Reasons = append(health.Reasons, fmt.Sprintf("%d of %d pod(s) are not ready", len(health.UnreadyPods), health.Pods))
+	}
+
+	return health
+}
+
+// podRestarts returns the restarts of all the containers of the pod
+func podRestarts(pod *corev1.Pod) int32 {
+	var restarts int32
+	for _, cs := range pod.Status.InitContainerStatuses {
+		restarts += cs.RestartCount
+	}
+	for _, cs := range pod.Status.ContainerStatuses {
+		restarts += cs.RestartCount
+	}
+	return restarts
+}
+
+// podReason returns the first waiting or terminated container reason, or the pod reason
+func podReason(pod *corev1.Pod) string {
+	statuses := append(append([]corev1.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...)
+	for _, cs := range statuses {
+		switch {
+		case cs.State.Waiting != nil && cs.State.Waiting.Reason != "":
+			return cs.State.Waiting.Reason
+		case cs.State.Terminated != nil && cs.State.Terminated.ExitCode != 0:
+			return cs.State.Terminated.Reason
+		}
+	}
+	return pod.Status.Reason
+}
+
+// notReadyHelmReleases returns the reasons of the release child helm releases which are not ready
+func notReadyHelmReleases(release *model.Release) []string {
+	var reasons []string
+	for name, state := range release.Status.HelmReleaseStates {
+		if state.Ready != metav1.ConditionTrue {
+			reasons = append(reasons, fmt.Sprintf("HelmRelease '%s' is not ready: %s", name, state.Status))
+		}
+	}
+	sort.Strings(reasons)
+	return reasons
+}
+
+// isReleaseHelmRelease returns true if the helm release is a child of the release
+func isReleaseHelmRelease(helmRelease *unstructured.Unstructured, release *model.Release) bool {
+	if _, ok := release.Status.HelmReleaseStates[helmRelease.GetName()]; ok {
+		return true
+	}
+	for _, owner := range helmRelease.GetOwnerReferences() {
+		if owner.Kind == "Release" && owner.Name == release.Name {
+			return true
+		}
+	}
+	return false
+}
+
+// helmReleaseReconciled returns the last time the helm release was successfully reconciled, either when it
+// became ready or when it was last deployed
+func helmReleaseReconciled(helmRelease *unstructured.Unstructured) time.Time {
+	var last time.Time
+	conditions, _, _ := unstructured.NestedSlice(helmRelease.Object, "status", "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if ok && condition["type"] == "Ready" && condition["status"] == string(metav1.ConditionTrue) {
+			last = laterTime(last, condition["lastTransitionTime"])
+		}
+	}
+	history, _, _ := unstructured.NestedSlice(helmRelease.Object, "status", "history")
+	if len(history) > 0 {
+		if snapshot, ok := history[0].(map[string]interface{}); ok {
+			last = laterTime(last, snapshot["lastDeployed"])
+		}
+	}
+	return last
+}
+
+func laterTime(last time.Time, value interface{}) time.Time {
+	s, ok := value.(string)
+	if !ok {
+		return last
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil || !t.After(last) {
+		return last
+	}
+	return t
+}
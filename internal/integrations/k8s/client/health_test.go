@@ -0,0 +1,217 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	kubocdv1alpha1 "kubocd/api/v1alpha1"
+
+	"github.com/okdp/okdp-server/internal/model"
+)
+
+var healthNow = time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+func newHealthRelease(phase kubocdv1alpha1.ReleasePhase) *model.Release {
+	return &model.Release{
+		ObjectMeta: metav1.ObjectMeta{Name: "redis", Namespace: "team-a"},
+		Status: kubocdv1alpha1.ReleaseStatus{
+			Phase: phase,
+			HelmReleaseStates: map[string]kubocdv1alpha1.HelmReleaseState{
+				"redis-main": {Ready: metav1.ConditionTrue, Status: "Deployed"},
+			},
+		},
+	}
+}
+
+func newHealthPod(name string, ready bool, restarts int32, waitingReason string) *corev1.Pod {
+	status := corev1.ContainerStatus{Name: "main", Ready: ready, RestartCount: restarts}
+	if waitingReason != "" {
+		status.State.Waiting = &corev1.ContainerStateWaiting{Reason: waitingReason}
+	}
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "team-a"},
+		Status: corev1.PodStatus{
+			Phase:             corev1.PodRunning,
+			ContainerStatuses: []corev1.ContainerStatus{status},
+		},
+	}
+}
+
+func newWarningEvent(kind string, name string, reason string, at time.Time) *corev1.Event {
+	return &corev1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Name: name + "." + reason, Namespace: "team-a"},
+		InvolvedObject: corev1.ObjectReference{Kind: kind, Name: name, Namespace: "team-a"},
+		Type:           corev1.EventTypeWarning,
+		Reason:         reason,
+		LastTimestamp:  metav1.NewTime(at),
+	}
+}
+
+func newHelmRelease(name string, readySince time.Time) unstructured.Unstructured {
+	return unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "helm.toolkit.fluxcd.io/v2",
+		"kind":       "HelmRelease",
+		"metadata":   map[string]interface{}{"name": name, "namespace": "team-a"},
+		"status": map[string]interface{}{
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Ready", "status": "True", "lastTransitionTime": readySince.Format(time.RFC3339)},
+			},
+		},
+	}}
+}
+
+func TestReleaseHealth_Healthy(t *testing.T) {
+	// Given
+	release := newHealthRelease(kubocdv1alpha1.ReleasePhaseReady)
+	pods := []*corev1.Pod{newHealthPod("redis-main-0", true, 2, "")}
+	helmReleases := []unstructured.Unstructured{
+		newHelmRelease("redis-main", healthNow.Add(-90*time.Second)),
+		newHelmRelease("other-main", healthNow.Add(-time.Second)),
+	}
+
+	// When
+	health := releaseHealth(release, pods, nil, helmReleases, healthNow)
+
+	// Then
+	assert.Equal(t, model.HealthHealthy, health.Status)
+	assert.Empty(t, health.Reasons)
+	assert.Equal(t, 1, health.Pods)
+	assert.Equal(t, 1, health.ReadyPods)
+	assert.Equal(t, int32(2), health.Restarts)
+	assert.Empty(t, health.UnreadyPods)
+	require.NotNil(t, health.LastReconciled)
+	assert.Equal(t, healthNow.Add(-90*time.Second), *health.LastReconciled, "only the release helm releases are considered")
+	assert.Equal(t, "1m30s", health.SinceLastReconciled)
+}
+
+func TestReleaseHealth_Degraded(t *testing.T) {
+	// Given
+	release := newHealthRelease(kubocdv1alpha1.ReleasePhaseReady)
+	pods := []*corev1.Pod{
+		newHealthPod("redis-main-0", true, 0, ""),
+		newHealthPod("redis-main-1", false, 5, "CrashLoopBackOff"),
+	}
+	var events []*corev1.Event
+	for i := 0; i < model.MaxHealthEvents+2; i++ {
+		events = append(events, newWarningEvent("Pod", "redis-main-1", "BackOff", healthNow.Add(time.Duration(-i)*time.Minute)))
+	}
+	events = append(events, newWarningEvent("Pod", "postgres-0", "BackOff", healthNow))
+
+	// When
+	health := releaseHealth(release, pods, events, nil, healthNow)
+
+	// Then
+	assert.Equal(t, model.HealthDegraded, health.Status)
+	assert.Equal(t, []string{"1 pod(s) are failing or restarting"}, health.Reasons)
+	assert.Equal(t, 1, health.ReadyPods)
+	assert.Equal(t, int32(5), health.Restarts)
+	require.Len(t, health.UnreadyPods, 1)
+	assert.Equal(t, &model.UnreadyPod{Name: "redis-main-1", Health: "NotReady", Reason: "CrashLoopBackOff", Restarts: 5}, health.UnreadyPods[0])
+	require.Len(t, health.WarningEvents, model.MaxHealthEvents, "only the most recent events are kept")
+	assert.Equal(t, "redis-main-1", health.WarningEvents[0].Name)
+	assert.Equal(t, healthNow, health.WarningEvents[0].LastSeen)
+	assert.Nil(t, health.LastReconciled)
+}
+
+func TestReleaseHealth_Progressing(t *testing.T) {
+	// Given
+	release := newHealthRelease(kubocdv1alpha1.ReleasePhaseReady)
+	pods := []*corev1.Pod{newHealthPod("redis-main-0", false, 0, "ContainerCreating")}
+
+	// When
+	health := releaseHealth(release, pods, nil, nil, healthNow)
+
+	// Then
+	assert.Equal(t, model.HealthProgressing, health.Status)
+	assert.Equal(t, []string{"1 of 1 pod(s) are not ready"}, health.Reasons)
+}
+
+func TestReleaseHealth_Phases(t *testing.T) {
+	tests := []struct {
+		name      string
+		phase     kubocdv1alpha1.ReleasePhase
+		suspended bool
+		status    string
+	}{
+		{name: "error", phase: kubocdv1alpha1.ReleasePhaseError, status: model.HealthFailed},
+		{name: "waiting dependencies", phase: kubocdv1alpha1.ReleasePhaseWaitDependencies, status: model.HealthProgressing},
+		{name: "not reconciled yet", phase: "", status: model.HealthProgressing},
+		{name: "suspended phase", phase: kubocdv1alpha1.ReleasePhaseSuspended, status: model.HealthSuspended},
+		{name: "suspended spec", phase: kubocdv1alpha1.ReleasePhaseError, suspended: true, status: model.HealthSuspended},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			release := newHealthRelease(tt.phase)
+			release.Spec.Suspended = tt.suspended
+
+			// When
+			health := releaseHealth(release, nil, nil, nil, healthNow)
+
+			// Then
+			assert.Equal(t, tt.status, health.Status)
+			assert.NotEmpty(t, health.Reasons)
+		})
+	}
+}
+
+func TestReleaseHealth_HelmReleaseNotReady(t *testing.T) {
+	// Given
+	release := newHealthRelease(kubocdv1alpha1.ReleasePhaseReady)
+	release.Status.HelmReleaseStates["redis-main"] = kubocdv1alpha1.HelmReleaseState{Ready: metav1.ConditionFalse, Status: "UpgradeFailed"}
+
+	// When
+	health := releaseHealth(release, nil, nil, nil, healthNow)
+
+	// Then
+	assert.Equal(t, model.HealthDegraded, health.Status)
+	assert.Equal(t, []string{"HelmRelease 'redis-main' is not ready: UpgradeFailed"}, health.Reasons)
+}
+
+func TestKubeClient_ListReleasesHealth(t *testing.T) {
+	// Given
+	ready := newHealthRelease(kubocdv1alpha1.ReleasePhaseReady)
+	failed := newHealthRelease(kubocdv1alpha1.ReleasePhaseError)
+	failed.Name = "postgres"
+	pod := newHealthPod("redis-main-0", true, 0, "")
+	pod.Labels = map[string]string{fluxHelmReleaseNameLabel: "redis-main", fluxHelmReleaseNamespaceLabel: "team-a"}
+	direct := newFakeClient((*kubocdv1alpha1.Release)(ready), (*kubocdv1alpha1.Release)(failed), pod)
+	client := KubeClient{clusterID: "test", WithWatch: direct}
+
+	// When
+	healths, err := client.ListReleasesHealth(context.Background(), "team-a")
+
+	// Then
+	require.Nil(t, err)
+	project := model.NewProjectHealth("team-a", healths)
+	assert.Equal(t, model.HealthFailed, project.Status)
+	require.Len(t, project.Releases, 2)
+	assert.Equal(t, "postgres", project.Releases[0].Name)
+	assert.Equal(t, model.HealthFailed, project.Releases[0].Status)
+	assert.Equal(t, "redis", project.Releases[1].Name)
+	assert.Equal(t, model.HealthHealthy, project.Releases[1].Status)
+	assert.Equal(t, 1, project.Releases[1].Pods)
+}
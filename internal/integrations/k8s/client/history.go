@@ -0,0 +1,155 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	kubocdv1alpha1 "kubocd/api/v1alpha1"
+
+	"github.com/okdp/okdp-server/internal/model"
+)
+
+// releaseHistoryLabel labels the ConfigMaps holding the revisions of the releases
+const releaseHistoryLabel = "okdp.io/release-history"
+
+// releaseHistoryName returns the name of the ConfigMap holding the revisions of a release,
+// one entry per revision (revision number => JSON revision)
+func releaseHistoryName(releaseName string) string {
+	return releaseName + "-okdp-history"
+}
+
+// ListReleaseRevisions returns the revisions of a release, from the oldest to the current one
+func (c KubeClient) ListReleaseRevisions(ctx context.Context, namespace string, releaseName string) ([]*model.ReleaseRevision, *model.ServerResponse) {
+	var history corev1.ConfigMap
+	err := c.Get(ctx, ctrlclient.ObjectKey{Namespace: namespace, Name: releaseHistoryName(releaseName)}, &history)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, k8sError(err, nil, "Failed to get the history of KuboCD Release '%s/%s', details: '%s'", namespace, releaseName, err.Error())
+	}
+
+	var release kubocdv1alpha1.Release
+	if er := c.WithWatch.Get(ctx, ctrlclient.ObjectKey{Namespace: namespace, Name: releaseName}, &release); er != nil {
+		return nil, k8sError(er, releaseErrorCodes, "Failed to get KuboCD Release '%s/%s', details: '%s'", namespace, releaseName, er.Error())
+	}
+	if err != nil || !ownedBy(&history, release.UID) {
+		return []*model.ReleaseRevision{}, nil
+	}
+
+	return decodeRevisions(&history), nil
+}
+
+// RecordReleaseRevision appends the revision to the history of the release, only the last MaxReleaseRevisions are kept.
+// The history is owned by the release (deleted with it) and restarts when the release is recreated.
+func (c KubeClient) RecordReleaseRevision(ctx context.Context, namespace string, revision *model.ReleaseRevision) *model.ServerResponse {
+	releaseName := revision.Release.Name
+
+	var release kubocdv1alpha1.Release
+	if err := c.WithWatch.Get(ctx, ctrlclient.ObjectKey{Namespace: namespace, Name: releaseName}, &release); err != nil {
+		return k8sError(err, releaseErrorCodes, "Failed to get KuboCD Release '%s/%s', details: '%s'", namespace, releaseName, err.Error())
+	}
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		history := corev1.ConfigMap{}
+		key := ctrlclient.ObjectKey{Namespace: namespace, Name: releaseHistoryName(releaseName)}
+		err := c.WithWatch.Get(ctx, key, &history)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+		exists := err == nil
+
+		revisions := []*model.ReleaseRevision{}
+		if exists && ownedBy(&history, release.UID) {
+			revisions = decodeRevisions(&history)
+		}
+		revision.Revision = 1
+		if len(revisions) > 0 {
+			revision.Revision = revisions[len(revisions)-1].Revision + 1
+		}
+		revisions = append(revisions, revision)
+		if len(revisions) > model.MaxReleaseRevisions {
+			revisions = revisions[len(revisions)-model.MaxReleaseRevisions:]
+		}
+
+		history.Name = key.Name
+		history.Namespace = key.Namespace
+		history.Labels = map[string]string{releaseHistoryLabel: releaseName}
+		history.OwnerReferences = []metav1.OwnerReference{{
+			APIVersion: kubocdv1alpha1.GroupVersion.String(),
+			Kind:       "Release",
+			Name:       release.Name,
+			UID:        release.UID,
+		}}
+		if history.Data, err = encodeRevisions(revisions); err != nil {
+			return err
+		}
+
+		if exists {
+			return c.Update(ctx, &history)
+		}
+		return c.Create(ctx, &history)
+	})
+	if err != nil {
+		return k8sError(err, nil, "Failed to record the revision of KuboCD Release '%s/%s', details: '%s'", namespace, releaseName, err.Error())
+	}
+	return nil
+}
+
+func ownedBy(history *corev1.ConfigMap, uid types.UID) bool {
+	for _, owner := range history.OwnerReferences {
+		if owner.UID == uid {
+			return true
+		}
+	}
+	return false
+}
+
+func decodeRevisions(history *corev1.ConfigMap) []*model.ReleaseRevision {
+	revisions := []*model.ReleaseRevision{}
+	for _, data := range history.Data {
+		var revision model.ReleaseRevision
+		if err := json.Unmarshal([]byte(data), &revision); err != nil {
+			continue
+		}
+		revisions = append(revisions, &revision)
+	}
+	sort.Slice(revisions, func(i, j int) bool {
+		return revisions[i].Revision < revisions[j].Revision
+	})
+	return revisions
+}
+
+func encodeRevisions(revisions []*model.ReleaseRevision) (map[string]string, error) {
+	data := make(map[string]string, len(revisions))
+	for _, revision := range revisions {
+		b, err := json.Marshal(revision)
+		if err != nil {
+			return nil, err
+		}
+		data[strconv.Itoa(revision.Revision)] = string(b)
+	}
+	return data, nil
+}
@@ -0,0 +1,96 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	kubocdv1alpha1 "kubocd/api/v1alpha1"
+
+	"github.com/okdp/okdp-server/internal/model"
+)
+
+func newRelease(name string, uid string) *kubocdv1alpha1.Release {
+	return &kubocdv1alpha1.Release{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", UID: types.UID(uid)}}
+}
+
+func record(t *testing.T, client KubeClient, name string, message string) {
+	release := model.Release(*newRelease(name, ""))
+	err := client.RecordReleaseRevision(context.Background(), "default", model.NewReleaseRevision(&release, "jdoe", "jdoe@example.com", message))
+	require.Nil(t, err)
+}
+
+func TestKubeClient_RecordReleaseRevision(t *testing.T) {
+	// Given
+	client := KubeClient{clusterID: "test", WithWatch: newFakeClient(newRelease("podinfo", "uid-1"))}
+
+	// When
+	for i := 0; i < model.MaxReleaseRevisions+2; i++ {
+		record(t, client, "podinfo", "Updated")
+	}
+	revisions, err := client.ListReleaseRevisions(context.Background(), "default", "podinfo")
+
+	// Then
+	require.Nil(t, err)
+	require.Len(t, revisions, model.MaxReleaseRevisions, "only the last revisions are kept")
+	assert.Equal(t, 3, revisions[0].Revision)
+	assert.Equal(t, model.MaxReleaseRevisions+2, revisions[len(revisions)-1].Revision)
+	assert.Equal(t, "jdoe", revisions[0].Author)
+	assert.Equal(t, "podinfo", revisions[0].Release.Name)
+}
+
+func TestKubeClient_ReleaseHistoryRestartsWhenRecreated(t *testing.T) {
+	// Given
+	direct := newFakeClient(newRelease("podinfo", "uid-1"))
+	client := KubeClient{clusterID: "test", WithWatch: direct}
+	record(t, client, "podinfo", "Created")
+	record(t, client, "podinfo", "Updated")
+	require.NoError(t, direct.Delete(context.Background(), newRelease("podinfo", "uid-1")))
+	require.NoError(t, direct.Create(context.Background(), newRelease("podinfo", "uid-2")))
+
+	// When
+	before, err := client.ListReleaseRevisions(context.Background(), "default", "podinfo")
+	require.Nil(t, err)
+	record(t, client, "podinfo", "Created")
+	after, err := client.ListReleaseRevisions(context.Background(), "default", "podinfo")
+
+	// Then
+	require.Nil(t, err)
+	assert.Empty(t, before, "the history of the previous release is ignored")
+	require.Len(t, after, 1)
+	assert.Equal(t, 1, after[0].Revision)
+}
+
+func TestKubeClient_ListReleaseRevisions_NotFound(t *testing.T) {
+	// Given
+	client := KubeClient{clusterID: "test", WithWatch: newFakeClient()}
+
+	// When
+	revisions, err := client.ListReleaseRevisions(context.Background(), "default", "podinfo")
+
+	// Then
+	assert.Nil(t, revisions)
+	require.NotNil(t, err)
+	assert.Equal(t, http.StatusNotFound, err.Status)
+}
@@ -33,9 +33,7 @@ func (c KubeClient) ListNamespaces(ctx context.Context) ([]*model.Namespace, *mo
 	var namespaceList corev1.NamespaceList
 	err := c.List(ctx, &namespaceList)
 	if err != nil {
-		return nil, model.
-			NewServerResponse(model.K8sClusterResponse).
-			UnprocessableEntity("Failed to list Kubernetes namespaces on clusterId '%s', details: '%s'", c.clusterID, err.Error())
+		return nil, k8sError(err, namespaceErrorCodes, "Failed to list Kubernetes namespaces on clusterId '%s', details: '%s'", c.clusterID, err.Error())
 	}
 
 	exclude := map[string]bool{
@@ -69,11 +67,10 @@ func (c KubeClient) GetNamespaceByName(ctx context.Context, clusterID string, na
 		if apierrors.IsNotFound(err) {
 			return nil, model.
 				NewServerResponse(model.K8sClusterResponse).
-				NotFoundError("Namespace '%s' not found on clusterId '%s'", name, clusterID)
+				NotFoundError("Namespace '%s' not found on clusterId '%s'", name, clusterID).
+				WithCode(model.ErrNamespaceNotFound)
 		}
-		return nil, model.
-			NewServerResponse(model.K8sClusterResponse).
-			UnprocessableEntity("Failed to get namespace '%s' on clusterId '%s', details: '%s'", name, clusterID, err.Error())
+		return nil, k8sError(err, namespaceErrorCodes, "Failed to get namespace '%s' on clusterId '%s', details: '%s'", name, clusterID, err.Error())
 	}
 
 	return model.ToNamespace(ns), nil
@@ -87,13 +84,13 @@ func (c KubeClient) CreateNamespace(ctx context.Context, namespace *model.Namesp
 	}
 
 	var existing corev1.Namespace
-	err := c.Get(ctx, ctrlclient.ObjectKey{Name: name}, &existing)
+	err := c.WithWatch.Get(ctx, ctrlclient.ObjectKey{Name: name}, &existing)
 	if err == nil {
 		return model.NewServerResponse(model.K8sClusterResponse).
-			ConflictError("Namespace '%s' already exists '%s'", name)
+			ConflictError("Namespace '%s' already exists", name).
+			WithCode(model.ErrNamespaceAlreadyExists)
 	} else if !apierrors.IsNotFound(err) {
-		return model.NewServerResponse(model.K8sClusterResponse).
-			UnprocessableEntity("Failed to check for namespace '%s': %v", name, err)
+		return k8sError(err, namespaceErrorCodes, "Failed to check for namespace '%s': %v", name, err)
 	}
 
 	newNS := &corev1.Namespace{
@@ -112,15 +109,16 @@ func (c KubeClient) CreateNamespace(ctx context.Context, namespace *model.Namesp
 	}
 
 	if err := c.Create(ctx, newNS); err != nil {
-		return model.NewServerResponse(model.K8sClusterResponse).
-			UnprocessableEntity("Failed to create namespace '%s': %v", name, err)
+		return k8sError(err, namespaceErrorCodes, "Failed to create namespace '%s': %v", name, err)
 	}
 
 	return model.NewServerResponse(model.K8sClusterResponse).
 		Created("Namespace '%s' created successfully", name)
 }
 
-func (c KubeClient) UpdateNamespace(ctx context.Context, namespace *model.Namespace) *model.ServerResponse {
+// UpdateNamespace merges the labels and annotations into the namespace and removes the removedAnnotations. When ifMatch is set,
+// the update fails with 412 if the namespace changed since this version.
+func (c KubeClient) UpdateNamespace(ctx context.Context, namespace *model.Namespace, ifMatch string, removedAnnotations ...string) *model.ServerResponse {
 	name := namespace.Metadata.Name
 	if name == "" {
 		return model.NewServerResponse(model.K8sClusterResponse).
@@ -128,22 +126,29 @@ func (c KubeClient) UpdateNamespace(ctx context.Context, namespace *model.Namesp
 	}
 
 	var existing corev1.Namespace
-	err := c.Get(ctx, ctrlclient.ObjectKey{Name: name}, &existing)
+	err := c.WithWatch.Get(ctx, ctrlclient.ObjectKey{Name: name}, &existing)
 	if err != nil {
 		if apierrors.IsNotFound(err) {
 			return model.NewServerResponse(model.K8sClusterResponse).
-				NotFoundError("Namespace '%s' not found", name)
+				NotFoundError("Namespace '%s' not found", name).
+				WithCode(model.ErrNamespaceNotFound)
 		}
-		return model.NewServerResponse(model.K8sClusterResponse).
-			UnprocessableEntity("Failed to get namespace '%s': %v", name, err)
+		return k8sError(err, namespaceErrorCodes, "Failed to get namespace '%s': %v", name, err)
+	}
+
+	if !utils.MatchesETag(ifMatch, existing.ResourceVersion) {
+		return namespacePreconditionFailed(name, ifMatch)
 	}
 
 	utils.MergeLabels(&existing, namespace.Metadata.Labels)
 	utils.MergeAnnotations(&existing, namespace.Metadata.Annotations)
+	utils.RemoveAnnotations(&existing, removedAnnotations)
 
 	if err := c.Update(ctx, &existing); err != nil {
-		return model.NewServerResponse(model.K8sClusterResponse).
-			UnprocessableEntity("Failed to update namespace '%s': %v", name, err)
+		if ifMatch != "" && apierrors.IsConflict(err) {
+			return namespacePreconditionFailed(name, ifMatch)
+		}
+		return k8sError(err, namespaceErrorCodes, "Failed to update namespace '%s': %v", name, err)
 	}
 
 	return model.NewServerResponse(model.K8sClusterResponse).
@@ -151,28 +156,47 @@ func (c KubeClient) UpdateNamespace(ctx context.Context, namespace *model.Namesp
 }
 
 // TODO: delete all resources inside the namespace + patch those resources to remove finalizers
-func (c KubeClient) DeleteNamespace(ctx context.Context, namespace string) *model.ServerResponse {
+func (c KubeClient) DeleteNamespace(ctx context.Context, namespace string, ifMatch string) *model.ServerResponse {
 	if namespace == "" {
 		return model.NewServerResponse(model.K8sClusterResponse).
 			BadRequest("Namespace name must be provided")
 	}
 
 	var existing corev1.Namespace
-	err := c.Get(ctx, ctrlclient.ObjectKey{Name: namespace}, &existing)
+	err := c.WithWatch.Get(ctx, ctrlclient.ObjectKey{Name: namespace}, &existing)
 	if err != nil {
 		if apierrors.IsNotFound(err) {
 			return model.NewServerResponse(model.K8sClusterResponse).
-				NotFoundError("Namespace '%s' not found", namespace)
+				NotFoundError("Namespace '%s' not found", namespace).
+				WithCode(model.ErrNamespaceNotFound)
 		}
-		return model.NewServerResponse(model.K8sClusterResponse).
-			UnprocessableEntity("Failed to get namespace '%s': %v", namespace, err)
+		return k8sError(err, namespaceErrorCodes, "Failed to get namespace '%s': %v", namespace, err)
 	}
 
-	if err := c.Delete(ctx, &existing); err != nil {
-		return model.NewServerResponse(model.K8sClusterResponse).
-			UnprocessableEntity("Failed to delete namespace '%s': %v", namespace, err)
+	if !utils.MatchesETag(ifMatch, existing.ResourceVersion) {
+		return namespacePreconditionFailed(namespace, ifMatch)
+	}
+
+	if err := c.Delete(ctx, &existing, ctrlclient.Preconditions{ResourceVersion: &existing.ResourceVersion}); err != nil {
+		if ifMatch != "" && apierrors.IsConflict(err) {
+			return namespacePreconditionFailed(namespace, ifMatch)
+		}
+		return k8sError(err, namespaceErrorCodes, "Failed to delete namespace '%s': %v", namespace, err)
 	}
 
 	return model.NewServerResponse(model.K8sClusterResponse).
 		Deleted("Namespace '%s' deleted successfully", namespace)
 }
+
+func namespacePreconditionFailed(namespace string, ifMatch string) *model.ServerResponse {
+	return model.NewServerResponse(model.K8sClusterResponse).
+		PreconditionFailed("Namespace '%s' has been modified since version '%s', get it again and retry", namespace, ifMatch)
+}
+
+func (c KubeClient) ServerVersion() (string, *model.ServerResponse) {
+	info, err := c.Discovery().ServerVersion()
+	if err != nil {
+		return "", k8sError(err, nil, "Failed to get the Kubernetes version of clusterId '%s', details: '%s'", c.clusterID, err.Error())
+	}
+	return info.GitVersion, nil
+}
@@ -20,8 +20,9 @@ import (
 	"context"
 	"encoding/json"
 
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/fields"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	kubocdv1alpha1 "kubocd/api/v1alpha1"
@@ -34,10 +35,8 @@ import (
 func (c KubeClient) ListReleases(ctx context.Context, namespaces ...string) ([]*model.Release, *model.ServerResponse) {
 
 	var releaseList kubocdv1alpha1.ReleaseList
-	if err := c.List(ctx, &releaseList); err != nil {
-		return nil, model.
-			NewServerResponse(model.K8sClusterResponse).
-			UnprocessableEntity("Failed to list KuboCD Releases '%s'", err.Error())
+	if err := c.listInNamespaces(ctx, &releaseList, namespaces); err != nil {
+		return nil, k8sError(err, nil, "Failed to list KuboCD Releases '%s'", err.Error())
 	}
 
 	converted := model.ReleaseList(releaseList)
@@ -49,6 +48,52 @@ func (c KubeClient) ListReleases(ctx context.Context, namespaces ...string) ([]*
 	return filtered, nil
 }
 
+// ListReleasesPage returns a page of the releases of a namespace. The label selector is always applied
+// by the Kubernetes API server, the pagination too when no other filter or sort is requested, otherwise
+// the releases are filtered, sorted and paginated in memory.
+func (c KubeClient) ListReleasesPage(ctx context.Context, namespace string, options *model.ListOptions) (*model.ListResult[*model.Release], *model.ServerResponse) {
+	listOptions := []ctrlclient.ListOption{
+		ctrlclient.InNamespace(namespace),
+		ctrlclient.MatchingLabelsSelector{Selector: options.Selector},
+	}
+
+	var releaseList kubocdv1alpha1.ReleaseList
+
+	// the informers do not support the continue tokens, the cached releases are paginated in memory
+	serverSide := options.Limit > 0 && !options.IsFiltered() && (options.Cursor.Offset == 0 || options.Cursor.Token != "") &&
+		!c.informers.serves(&releaseList)
+	if serverSide {
+		listOptions = append(listOptions, ctrlclient.Limit(int64(options.Limit)), ctrlclient.Continue(options.Cursor.Token))
+	}
+
+	if err := c.List(ctx, &releaseList, listOptions...); err != nil {
+		return nil, k8sError(err, releaseErrorCodes, "Failed to list KuboCD Releases '%s'", err.Error())
+	}
+
+	converted := model.ReleaseList(releaseList)
+	releases := converted.ToReleases()
+
+	if !serverSide {
+		return model.List(releases, options, func(r *model.Release) bool {
+			return r.Matches(options)
+		}, model.ReleaseComparators)
+	}
+
+	result := &model.ListResult[*model.Release]{Items: releases}
+	offset := options.Cursor.Offset + len(releases)
+	if releaseList.Continue != "" {
+		result.Continue = utils.Cursor{Offset: offset, Token: releaseList.Continue}.Encode()
+	}
+	switch {
+	case releaseList.RemainingItemCount != nil:
+		total := offset + int(*releaseList.RemainingItemCount)
+		result.Total = &total
+	case releaseList.Continue == "":
+		result.Total = &offset
+	}
+	return result, nil
+}
+
 func (c KubeClient) GetRelease(ctx context.Context, namespace string, releaseName string) (*model.Release, *model.ServerResponse) {
 	releaseKey := ctrlclient.ObjectKey{
 		Namespace: namespace,
@@ -57,9 +102,7 @@ func (c KubeClient) GetRelease(ctx context.Context, namespace string, releaseNam
 
 	var release kubocdv1alpha1.Release
 	if err := c.Get(ctx, releaseKey, &release); err != nil {
-		return nil, model.
-			NewServerResponse(model.K8sClusterResponse).
-			UnprocessableEntity("Failed to get KuboCD Release '%s'", err.Error())
+		return nil, k8sError(err, releaseErrorCodes, "Failed to get KuboCD Release '%s'", err.Error())
 	}
 
 	converted := model.Release(release)
@@ -76,9 +119,7 @@ func (c KubeClient) GetReleaseStatus(ctx context.Context, namespace string, rele
 
 	var release kubocdv1alpha1.Release
 	if err := c.Get(ctx, releaseKey, &release); err != nil {
-		return nil, model.
-			NewServerResponse(model.K8sClusterResponse).
-			UnprocessableEntity("Failed to get KuboCD Release Status '%s'", err.Error())
+		return nil, k8sError(err, releaseErrorCodes, "Failed to get KuboCD Release Status '%s'", err.Error())
 	}
 
 	converted := model.ReleaseStatus(release.Status)
@@ -97,17 +138,20 @@ func (c KubeClient) CreateRelease(ctx context.Context, namespace string, release
 		err = c.Create(ctx, &rel)
 	}
 	if err != nil {
-		return model.
-			NewServerResponse(model.K8sClusterResponse).
-			UnprocessableEntity("Failed to create KuboCD Release '%s/%s (%s)', details: '%s'", release.Namespace, release.Name, dryRun, err.Error())
+		return k8sError(err, releaseErrorCodes, "Failed to create KuboCD Release '%s/%s (%s)', details: '%s'", release.Namespace, release.Name, dryRun, err.Error())
 	}
 
 	return model.NewServerResponse(model.K8sClusterResponse).Created("Successfuly created release %s/%s", release.Namespace, release.Name)
 }
 
-func (c KubeClient) UpdateRelease(ctx context.Context, namespace string, release *model.Release, dryRun bool) *model.ServerResponse {
+// UpdateRelease updates a release. When ifMatch is set (other than '*'), the update is conditioned
+// by the resource version and fails with 412 if the release changed in the meantime.
+func (c KubeClient) UpdateRelease(ctx context.Context, namespace string, release *model.Release, dryRun bool, ifMatch string) *model.ServerResponse {
 	rel := kubocdv1alpha1.Release(*release)
 	rel.Namespace = namespace
+	if ifMatch != "" && ifMatch != "*" {
+		rel.ResourceVersion = ifMatch
+	}
 	var err error
 
 	if dryRun {
@@ -116,15 +160,19 @@ func (c KubeClient) UpdateRelease(ctx context.Context, namespace string, release
 		err = c.Update(ctx, &rel)
 	}
 	if err != nil {
-		return model.
-			NewServerResponse(model.K8sClusterResponse).
-			UnprocessableEntity("Failed to update KuboCD Release '%s/%s (%s)', details: '%s'", release.Namespace, release.Name, dryRun, err.Error())
+		if ifMatch != "" && apierrors.IsConflict(err) {
+			return releasePreconditionFailed(namespace, release.Name, ifMatch)
+		}
+		return k8sError(err, releaseErrorCodes, "Failed to update KuboCD Release '%s/%s (%s)', details: '%s'", release.Namespace, release.Name, dryRun, err.Error())
 	}
 
 	return model.NewServerResponse(model.K8sClusterResponse).Updated("Successfuly updated release %s/%s", release.Namespace, release.Name)
 }
 
-func (c KubeClient) DeleteRelease(ctx context.Context, namespace string, releaseName string) *model.ServerResponse {
+// PatchRelease applies a JSON Patch or a JSON Merge Patch to the live release and returns the patched release.
+// When ifMatch is set (other than '*'), the patch is conditioned by the resource version and fails with 412
+// if the release changed in the meantime.
+func (c KubeClient) PatchRelease(ctx context.Context, namespace string, releaseName string, patch *model.Patch, dryRun bool, ifMatch string) (*model.Release, *model.ServerResponse) {
 	rel := kubocdv1alpha1.Release{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      releaseName,
@@ -132,34 +180,87 @@ func (c KubeClient) DeleteRelease(ctx context.Context, namespace string, release
 		},
 	}
 
-	err := c.Delete(ctx, &rel)
+	if ifMatch != "" && ifMatch != "*" {
+		var er *model.ServerResponse
+		if patch, er = patch.WithResourceVersion(ifMatch); er != nil {
+			return nil, er
+		}
+	}
+
+	var patchOptions []ctrlclient.PatchOption
+	if dryRun {
+		patchOptions = append(patchOptions, ctrlclient.DryRunAll)
+	}
+
+	err := c.Patch(ctx, &rel, ctrlclient.RawPatch(patch.Type, patch.Data), patchOptions...)
 	if err != nil {
-		return model.
-			NewServerResponse(model.K8sClusterResponse).
-			UnprocessableEntity("Failed to delete KuboCD Release '%s/%s', details: '%s'", namespace, releaseName, err.Error())
+		if ifMatch != "" && apierrors.IsConflict(err) {
+			return nil, releasePreconditionFailed(namespace, releaseName, ifMatch)
+		}
+		return nil, k8sError(err, releaseErrorCodes, "Failed to patch KuboCD Release '%s/%s (%s)', details: '%s'", namespace, releaseName, dryRun, err.Error())
+	}
+
+	converted := model.Release(rel)
+	converted.SanitizeMetadata()
+
+	return &converted, nil
+}
+
+// DeleteRelease deletes a release. When ifMatch is set (other than '*'), the deletion is conditioned
+// by the resource version and fails with 412 if the release changed in the meantime.
+func (c KubeClient) DeleteRelease(ctx context.Context, namespace string, releaseName string, ifMatch string) *model.ServerResponse {
+	rel := kubocdv1alpha1.Release{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      releaseName,
+			Namespace: namespace,
+		},
+	}
+
+	var deleteOptions []ctrlclient.DeleteOption
+	if ifMatch != "" && ifMatch != "*" {
+		deleteOptions = append(deleteOptions, ctrlclient.Preconditions{ResourceVersion: &ifMatch})
+	}
+
+	err := c.Delete(ctx, &rel, deleteOptions...)
+	if err != nil {
+		if ifMatch != "" && apierrors.IsConflict(err) {
+			return releasePreconditionFailed(namespace, releaseName, ifMatch)
+		}
+		return k8sError(err, releaseErrorCodes, "Failed to delete KuboCD Release '%s/%s', details: '%s'", namespace, releaseName, err.Error())
 	}
 
 	return model.NewServerResponse(model.K8sClusterResponse).Deleted("Successfuly deleted release %s", releaseName)
 
 }
 
-func (c KubeClient) ListEventsRelease(ctx context.Context, namespace, releaseName string) ([]map[string]interface{}, *model.ServerResponse) {
-	fieldSelector := fields.AndSelectors(
-		fields.OneTermEqualSelector("involvedObject.name", releaseName),
-		fields.OneTermEqualSelector("involvedObject.kind", "Release"),
-	).String()
+func releasePreconditionFailed(namespace string, releaseName string, ifMatch string) *model.ServerResponse {
+	return model.NewServerResponse(model.K8sClusterResponse).
+		PreconditionFailed("KuboCD Release '%s/%s' has been modified since version '%s', get it again and retry", namespace, releaseName, ifMatch)
+}
 
-	eventList, err := c.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{
-		FieldSelector: fieldSelector,
+func (c KubeClient) ListEventsRelease(ctx context.Context, namespace, releaseName string, options *model.ListOptions) (*model.ListResult[map[string]interface{}], *model.ServerResponse) {
+	var eventList corev1.EventList
+	err := c.List(ctx, &eventList, ctrlclient.InNamespace(namespace), ctrlclient.MatchingFields{
+		eventInvolvedObjectName: releaseName,
+		eventInvolvedObjectKind: "Release",
 	})
 	if err != nil {
-		return nil, model.
-			NewServerResponse(model.K8sClusterResponse).
-			UnprocessableEntity("Failed to list events for release '%s/%s': %s", namespace, releaseName, err.Error())
+		return nil, k8sError(err, nil, "Failed to list events for release '%s/%s': %s", namespace, releaseName, err.Error())
+	}
+
+	items := make([]*corev1.Event, len(eventList.Items))
+	for i := range eventList.Items {
+		items[i] = &eventList.Items[i]
+	}
+	page, er := model.List(items, options, func(e *corev1.Event) bool {
+		return model.MatchesEvent(e, options)
+	}, model.EventComparators)
+	if er != nil {
+		return nil, er
 	}
 
-	events := make([]map[string]interface{}, 0, len(eventList.Items))
-	for _, event := range eventList.Items {
+	events := make([]map[string]interface{}, 0, len(page.Items))
+	for _, event := range page.Items {
 		b, err := json.Marshal(event)
 		if err != nil {
 			continue
@@ -170,5 +271,9 @@ func (c KubeClient) ListEventsRelease(ctx context.Context, namespace, releaseNam
 		}
 		events = append(events, m)
 	}
-	return events, nil
+	return &model.ListResult[map[string]interface{}]{
+		Items:    events,
+		Total:    page.Total,
+		Continue: page.Continue,
+	}, nil
 }
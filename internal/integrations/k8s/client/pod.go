@@ -18,71 +18,85 @@ package client
 import (
 	"context"
 	"io"
-	"strings"
 
 	corev1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/okdp/okdp-server/internal/model"
 	"github.com/okdp/okdp-server/internal/utils"
 )
 
+// GetPods returns the pods of the release and their controlling workload, the pods are discovered through the
+// owner references from the release HelmReleases objects (Deployments, StatefulSets, DaemonSets, Jobs) to the pods
 func (c KubeClient) GetPods(ctx context.Context, namespace string, releaseName string) ([]*model.PodInfo, *model.ServerResponse) {
 	release, err := c.GetRelease(ctx, namespace, releaseName)
 	if err != nil {
 		return nil, err
 	}
 
-	podList, er := c.CoreV1().Pods(utils.DefaultIfEmpty(release.Spec.TargetNamespace, namespace)).List(ctx, metav1.ListOptions{})
+	selector := newReleaseSelector(release, namespace, c.listHelmReleases(ctx, namespace))
+	pods, err := c.ListPods(ctx, selector.targetNamespace)
+	if err != nil {
+		return nil, err
+	}
+	resolver, er := c.newWorkloadResolver(ctx, selector.targetNamespace)
 	if er != nil {
-		return nil, model.
-			NewServerResponse(model.K8sClusterResponse).
-			UnprocessableEntity("failed to list pods from cluster '%s' matching release name '%s/%s', details: '%s'", c.clusterID, namespace, releaseName, er.Error())
+		return nil, k8sError(er, nil, "failed to list the workloads from cluster '%s' of release '%s/%s', details: '%s'", c.clusterID, namespace, releaseName, er.Error())
 	}
 
 	var result []*model.PodInfo
-
-	for _, pod := range podList.Items {
-		if strings.HasPrefix(pod.Name, releaseName) {
-			var containers []struct {
-				Image   string  `json:"image"`
-				Message *string `json:"message,omitempty"`
-				Name    string  `json:"name"`
-				Reason  *string `json:"reason,omitempty"`
-				State   string  `json:"state"`
-			}
-
-			for _, c := range pod.Spec.Containers {
-				cs := utils.GetContainerState(&pod, c.Name)
-				containers = append(containers, struct {
-					Image   string  `json:"image"`
-					Message *string `json:"message,omitempty"`
-					Name    string  `json:"name"`
-					Reason  *string `json:"reason,omitempty"`
-					State   string  `json:"state"`
-				}{
-					Image:   c.Image,
-					Name:    c.Name,
-					State:   cs.State,
-					Reason:  utils.EmptyToNil(cs.Reason),
-					Message: utils.EmptyToNil(cs.Message),
-				})
-			}
-
-			result = append(result, &model.PodInfo{
-				Name:       pod.Name,
-				Namespace:  pod.Namespace,
-				CreatedAt:  pod.CreationTimestamp.Time,
-				State:      string(pod.Status.Phase),
-				Health:     utils.GetPodHealth(&pod),
-				Containers: containers,
-			})
-		}
+	for _, p := range resolver.releasePods(ctx, selector, pods) {
+		result = append(result, toPodInfo(p.pod, p.workload))
 	}
 
 	return utils.NilToEmptySlice(result), nil
 }
 
+func toPodInfo(pod *corev1.Pod, owner *workload) *model.PodInfo {
+	var containers []struct {
+		Image   string  `json:"image"`
+		Message *string `json:"message,omitempty"`
+		Name    string  `json:"name"`
+		Reason  *string `json:"reason,omitempty"`
+		State   string  `json:"state"`
+	}
+
+	for _, c := range pod.Spec.Containers {
+		cs := utils.GetContainerState(pod, c.Name)
+		containers = append(containers, struct {
+			Image   string  `json:"image"`
+			Message *string `json:"message,omitempty"`
+			Name    string  `json:"name"`
+			Reason  *string `json:"reason,omitempty"`
+			State   string  `json:"state"`
+		}{
+			Image:   c.Image,
+			Name:    c.Name,
+			State:   cs.State,
+			Reason:  utils.EmptyToNil(cs.Reason),
+			Message: utils.EmptyToNil(cs.Message),
+		})
+	}
+
+	info := &model.PodInfo{
+		Name:       pod.Name,
+		Namespace:  pod.Namespace,
+		CreatedAt:  pod.CreationTimestamp.Time,
+		State:      string(pod.Status.Phase),
+		Health:     utils.GetPodHealth(pod),
+		Containers: containers,
+	}
+	if owner != nil {
+		info.Workload = &struct {
+			Kind string `json:"kind"`
+			Name string `json:"name"`
+		}{
+			Kind: owner.kind,
+			Name: owner.name,
+		}
+	}
+	return info
+}
+
 func (c KubeClient) StreamLogs(ctx context.Context, namespace, pod, container string, tailLines *int64, isSSE bool) (io.ReadCloser, *model.ServerResponse) {
 	podLogOpts := &corev1.PodLogOptions{
 		Container:  container,
@@ -94,11 +108,22 @@ func (c KubeClient) StreamLogs(ctx context.Context, namespace, pod, container st
 	req := c.CoreV1().Pods(namespace).GetLogs(pod, podLogOpts)
 	stream, err := req.Stream(ctx)
 	if err != nil {
-		return nil, model.
-			NewServerResponse(model.K8sClusterResponse).
-			UnprocessableEntity("Failed to fetch logs from cluster '%s' for '%s/%s (%s)', details: '%s'",
-				c.clusterID, namespace, pod, container, err.Error())
+		return nil, k8sError(err, nil, "Failed to fetch logs from cluster '%s' for '%s/%s (%s)', details: '%s'",
+			c.clusterID, namespace, pod, container, err.Error())
 	}
 
 	return stream, nil
 }
+
+func (c KubeClient) ListPods(ctx context.Context, namespaces ...string) ([]*corev1.Pod, *model.ServerResponse) {
+	var podList corev1.PodList
+	if err := c.listInNamespaces(ctx, &podList, namespaces); err != nil {
+		return nil, k8sError(err, nil, "failed to list pods from cluster '%s', details: '%s'", c.clusterID, err.Error())
+	}
+
+	filtered := utils.Filter(podList.Items, func(p corev1.Pod) bool {
+		return len(namespaces) == 0 || utils.Contains(namespaces, p.Namespace)
+	})
+
+	return filtered, nil
+}
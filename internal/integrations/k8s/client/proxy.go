@@ -0,0 +1,196 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"mime"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/proxy"
+	restclient "k8s.io/client-go/rest"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/okdp/okdp-server/internal/model"
+)
+
+// proxySandboxPolicy runs the proxied pages without the same origin privileges, the scripts, the forms and the popups
+// of the applications are still allowed
+const proxySandboxPolicy = "sandbox allow-scripts allow-forms allow-popups allow-downloads"
+
+// GetReleaseProxyTarget returns the port of the service or the pod of the release to proxy to, given by its name or its
+// number. The ports of the pods are resolved to their number as the API server only proxies the pods by port number.
+func (c KubeClient) GetReleaseProxyTarget(ctx context.Context, namespace, releaseName, kind, name, port string) (*model.ProxyTarget, *model.ServerResponse) {
+	if kind != model.ProxyServices && kind != model.ProxyPods {
+		return nil, model.NewServerResponse(model.OkdpServerResponse).
+			BadRequest("Unsupported proxy target '%s', expected one of '%s' or '%s'", kind, model.ProxyServices, model.ProxyPods)
+	}
+
+	release, err := c.GetRelease(ctx, namespace, releaseName)
+	if err != nil {
+		return nil, err
+	}
+	selector := newReleaseSelector(release, namespace, c.listHelmReleases(ctx, namespace))
+
+	if kind == model.ProxyServices {
+		return c.serviceProxyTarget(ctx, selector, releaseName, name, port)
+	}
+	return c.podProxyTarget(ctx, selector, releaseName, name, port)
+}
+
+func (c KubeClient) serviceProxyTarget(ctx context.Context, selector *releaseSelector, releaseName, name, port string) (*model.ProxyTarget, *model.ServerResponse) {
+	service := &corev1.Service{}
+	if err := c.Get(ctx, ctrlclient.ObjectKey{Namespace: selector.targetNamespace, Name: name}, service); err != nil {
+		return nil, k8sError(err, nil, "Failed to get the service '%s/%s' from cluster '%s', details: '%s'", selector.targetNamespace, name, c.clusterID, err.Error())
+	}
+	if !selector.matches(service) {
+		return nil, model.NewServerResponse(model.K8sClusterResponse).
+			NotFoundError("The service '%s/%s' does not belong to the release '%s/%s'", selector.targetNamespace, name, selector.namespace, releaseName)
+	}
+	for _, p := range service.Spec.Ports {
+		number := strconv.Itoa(int(p.Port))
+		if p.Name == port || number == port {
+			return &model.ProxyTarget{Kind: model.ProxyServices, Namespace: service.Namespace, Name: service.Name, Port: number}, nil
+		}
+	}
+	return nil, model.NewServerResponse(model.K8sClusterResponse).
+		NotFoundError("The service '%s/%s' has no port '%s'", selector.targetNamespace, name, port)
+}
+
+func (c KubeClient) podProxyTarget(ctx context.Context, selector *releaseSelector, releaseName, name, port string) (*model.ProxyTarget, *model.ServerResponse) {
+	pod := &corev1.Pod{}
+	if err := c.Get(ctx, ctrlclient.ObjectKey{Namespace: selector.targetNamespace, Name: name}, pod); err != nil {
+		return nil, k8sError(err, nil, "Failed to get the pod '%s/%s' from cluster '%s', details: '%s'", selector.targetNamespace, name, c.clusterID, err.Error())
+	}
+	resolver, err := c.newWorkloadResolver(ctx)
+	if err != nil {
+		return nil, k8sError(err, nil, "Failed to resolve the workload of the pod '%s/%s' from cluster '%s', details: '%s'", selector.targetNamespace, name, c.clusterID, err.Error())
+	}
+	if _, ok := resolver.releasePod(ctx, selector, pod); !ok {
+		return nil, model.NewServerResponse(model.K8sClusterResponse).
+			NotFoundError("The pod '%s/%s' does not belong to the release '%s/%s'", selector.targetNamespace, name, selector.namespace, releaseName)
+	}
+	for _, container := range pod.Spec.Containers {
+		for _, p := range container.Ports {
+			number := strconv.Itoa(int(p.ContainerPort))
+			if p.Name == port || number == port {
+				return &model.ProxyTarget{Kind: model.ProxyPods, Namespace: pod.Namespace, Name: pod.Name, Port: number}, nil
+			}
+		}
+	}
+	return nil, model.NewServerResponse(model.K8sClusterResponse).
+		NotFoundError("The pod '%s/%s' has no port '%s'", selector.targetNamespace, name, port)
+}
+
+// NewReverseProxy returns a reverse proxy to the target through the API server proxy, served under the prefix.
+// The absolute links and the redirects of the responses are rewritten to stay under the prefix, the relative links
+// are kept working as the proxied path is appended to the prefix.
+func (c KubeClient) NewReverseProxy(target *model.ProxyTarget, prefix string) (*httputil.ReverseProxy, *model.ServerResponse) {
+	upstream, err := proxyURL(c.config, target)
+	if err != nil {
+		return nil, k8sError(err, nil, "Failed to build the proxy URL of the %s '%s/%s' on cluster '%s', details: '%s'", target.Kind, target.Namespace, target.Name, c.clusterID, err.Error())
+	}
+	transport, err := restclient.TransportFor(c.config)
+	if err != nil {
+		return nil, k8sError(err, nil, "Failed to build the proxy transport of cluster '%s', details: '%s'", c.clusterID, err.Error())
+	}
+
+	return &httputil.ReverseProxy{
+		Rewrite: func(r *httputil.ProxyRequest) {
+			r.Out.URL.Scheme = upstream.Scheme
+			r.Out.URL.Host = upstream.Host
+			r.Out.URL.Path = upstream.Path + strings.TrimPrefix(r.In.URL.Path, prefix)
+			r.Out.URL.RawPath = ""
+			r.Out.Host = ""
+			// the HTML responses are read uncompressed to rewrite their links
+			r.Out.Header.Del("Accept-Encoding")
+			r.Out.Header.Set("X-Forwarded-Prefix", prefix)
+			r.SetXForwarded()
+		},
+		Transport: &proxy.Transport{
+			PathPrepend:  prefix,
+			RoundTripper: &apiServerProxyTransport{prefix: upstream.Path, RoundTripper: transport},
+		},
+		ModifyResponse: sandboxResponse,
+	}, nil
+}
+
+// sandboxResponse isolates the proxied applications, which are served from the okdp-server origin: their pages are
+// sandboxed into an opaque origin so that their scripts cannot call the okdp API with the session of the user,
+// and they cannot set cookies on the okdp-server origin
+func sandboxResponse(resp *http.Response) error {
+	resp.Header.Add("Content-Security-Policy", proxySandboxPolicy)
+	resp.Header.Del("Set-Cookie")
+	return nil
+}
+
+// proxyURL returns the URL of the API server proxy of the target (/api/v1/namespaces/<namespace>/services/<name>:<port>/proxy)
+func proxyURL(config *restclient.Config, target *model.ProxyTarget) (*url.URL, error) {
+	server, _, err := restclient.DefaultServerUrlFor(config)
+	if err != nil {
+		return nil, err
+	}
+	server.Path = path.Join(server.Path, "/api/v1/namespaces", target.Namespace, target.Kind, target.Name+":"+target.Port, "proxy")
+	return server, nil
+}
+
+// apiServerProxyTransport strips the path of the API server proxy from the redirects and the HTML responses.
+// The API server prefixes the absolute paths of the responses with its own proxy path, they are then prefixed with
+// the path the proxy is served from.
+type apiServerProxyTransport struct {
+	prefix string
+	http.RoundTripper
+}
+
+func (t *apiServerProxyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.RoundTripper.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if location := resp.Header.Get("Location"); location != "" {
+		if u, err := url.Parse(location); err == nil && (u.Host == "" || u.Host == req.URL.Host) && strings.HasPrefix(u.Path, t.prefix) {
+			u.Scheme, u.Host = "", ""
+			u.Path = "/" + strings.TrimPrefix(strings.TrimPrefix(u.Path, t.prefix), "/")
+			resp.Header.Set("Location", u.String())
+		}
+		return resp, nil
+	}
+
+	if mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type")); mediaType != "text/html" || resp.Header.Get("Content-Encoding") != "" {
+		return resp, nil
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	body = bytes.ReplaceAll(body, []byte(t.prefix+"/"), []byte("/"))
+	body = bytes.ReplaceAll(body, []byte(t.prefix), []byte("/"))
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	resp.ContentLength = int64(len(body))
+	resp.Header.Del("Content-Length")
+	return resp, nil
+}
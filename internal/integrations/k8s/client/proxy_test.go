@@ -0,0 +1,189 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	restclient "k8s.io/client-go/rest"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/okdp/okdp-server/internal/model"
+)
+
+const apiServerProxyPath = "/api/v1/namespaces/team-a/services/spark-history:18080/proxy"
+
+func newSparkProxyTargets() []ctrlclient.Object {
+	objects := newSparkWorkloads()
+	for _, obj := range objects {
+		if pod, ok := obj.(*corev1.Pod); ok && pod.Name == "spark-master-5d8f-x2x8d" {
+			pod.Spec.Containers = []corev1.Container{{Name: "master", Ports: []corev1.ContainerPort{{Name: "ui", ContainerPort: 8080}}}}
+		}
+	}
+	return append(objects,
+		&corev1.Service{
+			ObjectMeta: helmReleaseMeta("spark-master", "svc-1", "spark-main"),
+			Spec:       corev1.ServiceSpec{Ports: []corev1.ServicePort{{Name: "rpc", Port: 7077}, {Name: "http", Port: 80}}},
+		},
+		&corev1.Service{
+			ObjectMeta: helmReleaseMeta("spark-history", "svc-2", "spark-history-main"),
+			Spec:       corev1.ServiceSpec{Ports: []corev1.ServicePort{{Name: "http", Port: 18080}}},
+		},
+	)
+}
+
+func TestKubeClient_GetReleaseProxyTarget(t *testing.T) {
+	tests := []struct {
+		name     string
+		kind     string
+		target   string
+		port     string
+		expected *model.ProxyTarget
+		status   int
+	}{
+		{name: "service port by name", kind: model.ProxyServices, target: "spark-master", port: "http",
+			expected: &model.ProxyTarget{Kind: model.ProxyServices, Namespace: "team-a", Name: "spark-master", Port: "80"}},
+		{name: "service port by number", kind: model.ProxyServices, target: "spark-master", port: "7077",
+			expected: &model.ProxyTarget{Kind: model.ProxyServices, Namespace: "team-a", Name: "spark-master", Port: "7077"}},
+		{name: "pod port by name", kind: model.ProxyPods, target: "spark-master-5d8f-x2x8d", port: "ui",
+			expected: &model.ProxyTarget{Kind: model.ProxyPods, Namespace: "team-a", Name: "spark-master-5d8f-x2x8d", Port: "8080"}},
+		{name: "unknown port", kind: model.ProxyServices, target: "spark-master", port: "metrics", status: http.StatusNotFound},
+		{name: "service of another release", kind: model.ProxyServices, target: "spark-history", port: "http", status: http.StatusNotFound},
+		{name: "pod of no release", kind: model.ProxyPods, target: "spark-debug", port: "8080", status: http.StatusNotFound},
+		{name: "unsupported kind", kind: "deployments", target: "spark-master", port: "http", status: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			client := KubeClient{clusterID: "test", WithWatch: newFakeClient(newSparkProxyTargets()...)}
+
+			// When
+			target, err := client.GetReleaseProxyTarget(context.Background(), "team-a", "spark", tt.kind, tt.target, tt.port)
+
+			// Then
+			if tt.expected != nil {
+				require.Nil(t, err)
+				assert.Equal(t, tt.expected, target)
+				return
+			}
+			require.NotNil(t, err)
+			assert.Equal(t, tt.status, err.Status)
+		})
+	}
+}
+
+func TestProxyURL(t *testing.T) {
+	// Given
+	config := &restclient.Config{Host: "https://kube.example.com:6443"}
+	target := &model.ProxyTarget{Kind: model.ProxyPods, Namespace: "team-a", Name: "spark-master-0", Port: "8080"}
+
+	// When
+	u, err := proxyURL(config, target)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "https://kube.example.com:6443/api/v1/namespaces/team-a/pods/spark-master-0:8080/proxy", u.String())
+}
+
+func TestApiServerProxyTransport_Rewrite(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		location    string
+		body        string
+		expected    string
+		expectedLoc string
+	}{
+		{name: "redirect under the API server proxy", location: apiServerProxyPath + "/login?next=%2F", expectedLoc: "/login?next=%2F"},
+		{name: "redirect to the root of the API server proxy", location: apiServerProxyPath, expectedLoc: "/"},
+		{name: "redirect to another host", location: "https://sso.example.com/auth", expectedLoc: "https://sso.example.com/auth"},
+		{name: "HTML links", contentType: "text/html; charset=utf-8",
+			body:     `<a href="` + apiServerProxyPath + `/jobs/">jobs</a><a href="` + apiServerProxyPath + `">home</a><img src="static/logo.png">`,
+			expected: `<a href="/jobs/">jobs</a><a href="/">home</a><img src="static/logo.png">`},
+		{name: "other content", contentType: "application/json",
+			body: `{"link":"` + apiServerProxyPath + `/api"}`, expected: `{"link":"` + apiServerProxyPath + `/api"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				if tt.location != "" {
+					w.Header().Set("Location", tt.location)
+					w.WriteHeader(http.StatusFound)
+					return
+				}
+				w.Header().Set("Content-Type", tt.contentType)
+				_, _ = io.WriteString(w, tt.body)
+			}))
+			defer server.Close()
+			transport := &apiServerProxyTransport{prefix: apiServerProxyPath, RoundTripper: http.DefaultTransport}
+			req, _ := http.NewRequest(http.MethodGet, server.URL+apiServerProxyPath+"/", nil)
+
+			// When
+			resp, err := transport.RoundTrip(req)
+
+			// Then
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			if tt.location != "" {
+				assert.Equal(t, tt.expectedLoc, resp.Header.Get("Location"))
+				return
+			}
+			body, _ := io.ReadAll(resp.Body)
+			assert.Equal(t, tt.expected, strings.TrimSpace(string(body)))
+		})
+	}
+}
+
+func TestKubeClient_NewReverseProxy_Prefix(t *testing.T) {
+	// Given
+	const prefix = "/api/v1/clusters/c1/namespaces/team-a/releases/spark/proxy/services/spark-history/18080"
+	var proxied *http.Request
+	apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = r
+		w.Header().Set("Content-Type", "text/html")
+		w.Header().Set("Set-Cookie", "session=abc; Path=/")
+		_, _ = io.WriteString(w, `<link href="`+apiServerProxyPath+`/static/app.css"><script src="static/app.js"></script>`)
+	}))
+	defer apiServer.Close()
+	client := KubeClient{clusterID: "test", config: &restclient.Config{Host: apiServer.URL}}
+	target := &model.ProxyTarget{Kind: model.ProxyServices, Namespace: "team-a", Name: "spark-history", Port: "18080"}
+	proxy, err := client.NewReverseProxy(target, prefix)
+	require.Nil(t, err)
+	resp := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, prefix+"/history/app-1/?tab=jobs", nil)
+
+	// When
+	proxy.ServeHTTP(resp, req)
+
+	// Then
+	require.NotNil(t, proxied)
+	assert.Equal(t, apiServerProxyPath+"/history/app-1/", proxied.URL.Path)
+	assert.Equal(t, "tab=jobs", proxied.URL.RawQuery)
+	assert.Equal(t, prefix, proxied.Header.Get("X-Forwarded-Prefix"))
+	assert.Equal(t, proxySandboxPolicy, resp.Header().Get("Content-Security-Policy"))
+	assert.Empty(t, resp.Header().Values("Set-Cookie"))
+	assert.Equal(t, `<link href="`+prefix+`/static/app.css"><script src="static/app.js"></script>`, resp.Body.String())
+}
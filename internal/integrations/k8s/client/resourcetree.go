@@ -0,0 +1,414 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	kubocdv1alpha1 "kubocd/api/v1alpha1"
+
+	"github.com/okdp/okdp-server/internal/common/constants"
+	log "github.com/okdp/okdp-server/internal/common/logging"
+	"github.com/okdp/okdp-server/internal/model"
+	"github.com/okdp/okdp-server/internal/utils"
+)
+
+var (
+	helmReleaseGVR = schema.GroupVersionResource{Group: "helm.toolkit.fluxcd.io", Version: "v2", Resource: "helmreleases"}
+	secretGVR      = corev1.SchemeGroupVersion.WithResource("secrets")
+)
+
+// managedResources are the resources deployed by the HelmReleases, matched by the helm controller labels and the Helm annotations
+var managedResources = []schema.GroupVersionResource{
+	appsv1.SchemeGroupVersion.WithResource("deployments"),
+	appsv1.SchemeGroupVersion.WithResource("statefulsets"),
+	appsv1.SchemeGroupVersion.WithResource("daemonsets"),
+	batchv1.SchemeGroupVersion.WithResource("jobs"),
+	batchv1.SchemeGroupVersion.WithResource("cronjobs"),
+	corev1.SchemeGroupVersion.WithResource("services"),
+	{Group: "networking.k8s.io", Version: "v1", Resource: "ingresses"},
+	corev1.SchemeGroupVersion.WithResource("persistentvolumeclaims"),
+	corev1.SchemeGroupVersion.WithResource("configmaps"),
+	secretGVR,
+}
+
+// ownedResources are the resources created by the controllers of the managed resources, matched by their owner references.
+// They are listed in this order so that the owners are in the tree before the resources they own.
+var ownedResources = []schema.GroupVersionResource{
+	batchv1.SchemeGroupVersion.WithResource("jobs"),
+	appsv1.SchemeGroupVersion.WithResource("replicasets"),
+	corev1.SchemeGroupVersion.WithResource("pods"),
+}
+
+// resourceTreeBuilder adds the resources to the tree once, the parents of a resource added again are merged
+type resourceTreeBuilder struct {
+	tree  *model.ResourceTree
+	nodes map[types.UID]*model.ResourceNode
+}
+
+// GetReleaseResourceTree returns the resources created by the release: its HelmReleases and their Helm storage secrets,
+// the resources deployed by the HelmReleases and the resources they own (ReplicaSets, Pods, ...).
+// The resources are listed with the dynamic client in the release namespace and target namespace.
+func (c KubeClient) GetReleaseResourceTree(ctx context.Context, namespace string, releaseName string) (*model.ResourceTree, *model.ServerResponse) {
+	// the release is not sanitized, its UID is the root node UID
+	var kuboRelease kubocdv1alpha1.Release
+	if err := c.Get(ctx, ctrlclient.ObjectKey{Namespace: namespace, Name: releaseName}, &kuboRelease); err != nil {
+		return nil, k8sError(err, releaseErrorCodes, "Failed to get KuboCD Release '%s/%s', details: '%s'", namespace, releaseName, err.Error())
+	}
+	release := (*model.Release)(&kuboRelease)
+
+	helmReleases, er := c.dynamicList(ctx, helmReleaseGVR, namespace, "")
+	if er != nil && !apierrors.IsNotFound(er) {
+		return nil, k8sError(er, nil, "failed to list the helm releases from cluster '%s' of release '%s/%s', details: '%s'", c.clusterID, namespace, releaseName, er.Error())
+	}
+	selector := newReleaseSelector(release, namespace, helmReleases)
+
+	builder := newResourceTreeBuilder(release)
+	root := &builder.tree.Release
+	parents := map[string]*model.ResourceRef{}
+	for i := range helmReleases {
+		helmRelease := &helmReleases[i]
+		if !isReleaseHelmRelease(helmRelease, release) {
+			continue
+		}
+		ref := builder.add(helmRelease, root)
+		parents[fluxHelmReleaseNameLabel+"="+helmRelease.GetName()] = ref
+
+		storageName := helmReleaseStorageName(helmRelease)
+		if storageName == "" {
+			continue
+		}
+		parents[helmReleaseNameAnnotation+"="+storageName] = ref
+		storageNamespace, _, _ := unstructured.NestedString(helmRelease.Object, "status", "storageNamespace")
+		secrets, er := c.dynamicList(ctx, secretGVR, utils.DefaultIfEmpty(storageNamespace, selector.targetNamespace), "owner=helm,name="+storageName)
+		if er != nil {
+			log.Ctx(ctx).Warn("Unable to list the helm storage secrets from cluster '%s' of release '%s/%s': %s", c.clusterID, namespace, releaseName, er.Error())
+		}
+		for j := range secrets {
+			builder.add(&secrets[j], ref)
+		}
+	}
+
+	for _, gvr := range managedResources {
+		objects, er := c.dynamicList(ctx, gvr, selector.targetNamespace, "")
+		if er != nil {
+			log.Ctx(ctx).Warn("Unable to list the %s from cluster '%s' in namespace '%s': %s", gvr.Resource, c.clusterID, selector.targetNamespace, er.Error())
+			continue
+		}
+		for i := range objects {
+			if !selector.matches(&objects[i]) {
+				continue
+			}
+			parent := parents[fluxHelmReleaseNameLabel+"="+objects[i].GetLabels()[fluxHelmReleaseNameLabel]]
+			if parent == nil {
+				parent = parents[helmReleaseNameAnnotation+"="+objects[i].GetAnnotations()[helmReleaseNameAnnotation]]
+			}
+			if parent == nil {
+				parent = root
+			}
+			builder.add(&objects[i], parent)
+		}
+	}
+
+	for _, gvr := range ownedResources {
+		objects, er := c.dynamicList(ctx, gvr, selector.targetNamespace, "")
+		if er != nil {
+			log.Ctx(ctx).Warn("Unable to list the %s from cluster '%s' in namespace '%s': %s", gvr.Resource, c.clusterID, selector.targetNamespace, er.Error())
+			continue
+		}
+		for i := range objects {
+			builder.addOwned(&objects[i])
+		}
+	}
+
+	return builder.tree.Sort(), nil
+}
+
+// dynamicList lists the resources of the namespace matching the label selector
+func (c KubeClient) dynamicList(ctx context.Context, gvr schema.GroupVersionResource, namespace string, labelSelector string) ([]unstructured.Unstructured, error) {
+	list, err := c.dynamic.Resource(gvr).Namespace(namespace).List(ctx, metav1.ListOptions{LabelSelector: labelSelector})
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// newResourceTreeBuilder returns a builder of the tree of the release, with the release as root node
+func newResourceTreeBuilder(release *model.Release) *resourceTreeBuilder {
+	ref := model.ResourceRef{
+		Group:     kubocdv1alpha1.GroupVersion.Group,
+		Version:   kubocdv1alpha1.GroupVersion.Version,
+		Kind:      "Release",
+		Namespace: release.Namespace,
+		Name:      release.Name,
+		UID:       string(release.UID),
+	}
+	health := releaseHealth(release, nil, nil, nil, time.Now())
+	builder := &resourceTreeBuilder{tree: model.NewResourceTree(ref), nodes: map[types.UID]*model.ResourceNode{}}
+	root := &model.ResourceNode{
+		ResourceRef: ref,
+		Health:      health.Status,
+		Status:      strings.Join(health.Reasons, "; "),
+		CreatedAt:   release.CreationTimestamp.Time,
+		ParentRefs:  []*model.ResourceRef{},
+	}
+	builder.tree.Nodes = append(builder.tree.Nodes, root)
+	builder.nodes[release.UID] = root
+	return builder
+}
+
+// add adds the resource to the tree under the parent and returns its reference
+func (b *resourceTreeBuilder) add(obj *unstructured.Unstructured, parent *model.ResourceRef) *model.ResourceRef {
+	if node, ok := b.nodes[obj.GetUID()]; ok {
+		node.ParentRefs = append(node.ParentRefs, parent)
+		return &node.ResourceRef
+	}
+	gvk := obj.GroupVersionKind()
+	health, status := resourceHealth(obj)
+	node := &model.ResourceNode{
+		ResourceRef: model.ResourceRef{
+			Group:     gvk.Group,
+			Version:   gvk.Version,
+			Kind:      gvk.Kind,
+			Namespace: obj.GetNamespace(),
+			Name:      obj.GetName(),
+			UID:       string(obj.GetUID()),
+		},
+		Health:     health,
+		Status:     status,
+		CreatedAt:  obj.GetCreationTimestamp().Time,
+		ParentRefs: []*model.ResourceRef{parent},
+	}
+	b.tree.Nodes = append(b.tree.Nodes, node)
+	b.nodes[obj.GetUID()] = node
+	return &node.ResourceRef
+}
+
+// addOwned adds the resource to the tree under its owners which are in the tree
+func (b *resourceTreeBuilder) addOwned(obj *unstructured.Unstructured) {
+	for _, owner := range obj.GetOwnerReferences() {
+		if parent, ok := b.nodes[owner.UID]; ok {
+			b.add(obj, &parent.ResourceRef)
+		}
+	}
+}
+
+// resourceHealth returns the health and the status message of the resource, no health is returned for the
+// resources without status (ConfigMaps, Secrets, ...)
+func resourceHealth(obj *unstructured.Unstructured) (string, string) {
+	switch obj.GetKind() {
+	case "HelmRelease":
+		return helmReleaseHealth(obj)
+	case "Deployment":
+		return typedHealth(obj, deploymentHealth)
+	case "StatefulSet":
+		return typedHealth(obj, statefulSetHealth)
+	case "DaemonSet":
+		return typedHealth(obj, daemonSetHealth)
+	case "ReplicaSet":
+		return typedHealth(obj, replicaSetHealth)
+	case "Job":
+		return typedHealth(obj, jobHealth)
+	case "CronJob":
+		return typedHealth(obj, cronJobHealth)
+	case "Pod":
+		return typedHealth(obj, podNodeHealth)
+	case "PersistentVolumeClaim":
+		return typedHealth(obj, pvcHealth)
+	case "Service":
+		return typedHealth(obj, serviceHealth)
+	case "Ingress":
+		return model.HealthHealthy, ""
+	default:
+		return "", ""
+	}
+}
+
+// typedHealth converts the resource into its typed object to compute its health
+func typedHealth[T any](obj *unstructured.Unstructured, health func(*T) (string, string)) (string, string) {
+	var typed T
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &typed); err != nil {
+		return "", fmt.Sprintf("Unable to read the %s status: %s", obj.GetKind(), err.Error())
+	}
+	return health(&typed)
+}
+
+func helmReleaseHealth(helmRelease *unstructured.Unstructured) (string, string) {
+	if suspended, _, _ := unstructured.NestedBool(helmRelease.Object, "spec", "suspend"); suspended {
+		return model.HealthSuspended, "HelmRelease is suspended"
+	}
+	conditions, _, _ := unstructured.NestedSlice(helmRelease.Object, "status", "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok || condition["type"] != "Ready" {
+			continue
+		}
+		message, _ := condition["message"].(string)
+		switch condition["status"] {
+		case string(metav1.ConditionTrue):
+			return model.HealthHealthy, message
+		case string(metav1.ConditionFalse):
+			return model.HealthDegraded, message
+		default:
+			return model.HealthProgressing, message
+		}
+	}
+	return model.HealthProgressing, "Waiting for the HelmRelease to be reconciled"
+}
+
+func deploymentHealth(deployment *appsv1.Deployment) (string, string) {
+	if deployment.Spec.Paused {
+		return model.HealthSuspended, "Deployment is paused"
+	}
+	for _, condition := range deployment.Status.Conditions {
+		if condition.Type == appsv1.DeploymentProgressing && condition.Status == corev1.ConditionFalse {
+			return model.HealthDegraded, condition.Message
+		}
+	}
+	replicas := replicasOf(deployment.Spec.Replicas)
+	status := deployment.Status
+	switch {
+	case status.ObservedGeneration < deployment.Generation:
+		return model.HealthProgressing, "Waiting for the rollout to be observed"
+	case status.UpdatedReplicas < replicas:
+		return model.HealthProgressing, fmt.Sprintf("%d of %d replicas are updated", status.UpdatedReplicas, replicas)
+	case status.Replicas > status.UpdatedReplicas:
+		return model.HealthProgressing, fmt.Sprintf("%d old replicas are pending termination", status.Replicas-status.UpdatedReplicas)
+	case status.AvailableReplicas < status.UpdatedReplicas:
+		return model.HealthProgressing, fmt.Sprintf("%d of %d updated replicas are available", status.AvailableReplicas, status.UpdatedReplicas)
+	}
+	return model.HealthHealthy, ""
+}
+
+func statefulSetHealth(statefulSet *appsv1.StatefulSet) (string, string) {
+	replicas := replicasOf(statefulSet.Spec.Replicas)
+	status := statefulSet.Status
+	switch {
+	case status.ObservedGeneration < statefulSet.Generation:
+		return model.HealthProgressing, "Waiting for the rollout to be observed"
+	case status.ReadyReplicas < replicas:
+		return model.HealthProgressing, fmt.Sprintf("%d of %d replicas are ready", status.ReadyReplicas, replicas)
+	case statefulSet.Spec.UpdateStrategy.Type != appsv1.OnDeleteStatefulSetStrategyType &&
+		status.UpdateRevision != status.CurrentRevision && status.UpdatedReplicas < replicas:
+		return model.HealthProgressing, fmt.Sprintf("%d of %d replicas are updated", status.UpdatedReplicas, replicas)
+	}
+	return model.HealthHealthy, ""
+}
+
+func daemonSetHealth(daemonSet *appsv1.DaemonSet) (string, string) {
+	status := daemonSet.Status
+	switch {
+	case status.ObservedGeneration < daemonSet.Generation:
+		return model.HealthProgressing, "Waiting for the rollout to be observed"
+	case status.UpdatedNumberScheduled < status.DesiredNumberScheduled:
+		return model.HealthProgressing, fmt.Sprintf("%d of %d pods are updated", status.UpdatedNumberScheduled, status.DesiredNumberScheduled)
+	case status.NumberAvailable < status.DesiredNumberScheduled:
+		return model.HealthProgressing, fmt.Sprintf("%d of %d pods are available", status.NumberAvailable, status.DesiredNumberScheduled)
+	}
+	return model.HealthHealthy, ""
+}
+
+func replicaSetHealth(replicaSet *appsv1.ReplicaSet) (string, string) {
+	for _, condition := range replicaSet.Status.Conditions {
+		if condition.Type == appsv1.ReplicaSetReplicaFailure && condition.Status == corev1.ConditionTrue {
+			return model.HealthDegraded, condition.Message
+		}
+	}
+	replicas := replicasOf(replicaSet.Spec.Replicas)
+	if replicaSet.Status.ReadyReplicas < replicas {
+		return model.HealthProgressing, fmt.Sprintf("%d of %d replicas are ready", replicaSet.Status.ReadyReplicas, replicas)
+	}
+	return model.HealthHealthy, ""
+}
+
+func jobHealth(job *batchv1.Job) (string, string) {
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case batchv1.JobFailed:
+			return model.HealthDegraded, condition.Message
+		case batchv1.JobComplete:
+			return model.HealthHealthy, "Job completed"
+		case batchv1.JobSuspended:
+			return model.HealthSuspended, "Job is suspended"
+		}
+	}
+	return model.HealthProgressing, "Job is running"
+}
+
+func cronJobHealth(cronJob *batchv1.CronJob) (string, string) {
+	if utils.OrFalse(cronJob.Spec.Suspend) {
+		return model.HealthSuspended, "CronJob is suspended"
+	}
+	return model.HealthHealthy, ""
+}
+
+func podNodeHealth(pod *corev1.Pod) (string, string) {
+	switch utils.GetPodHealth(pod) {
+	case constants.StateHealthy, constants.StateCompleted:
+		return model.HealthHealthy, string(pod.Status.Phase)
+	case constants.StateFailed:
+		return model.HealthDegraded, utils.DefaultIfEmpty(pod.Status.Message, podReason(pod))
+	}
+	reason := podReason(pod)
+	if utils.Contains(crashReasons, reason) {
+		return model.HealthDegraded, reason
+	}
+	return model.HealthProgressing, utils.DefaultIfEmpty(reason, string(pod.Status.Phase))
+}
+
+func pvcHealth(pvc *corev1.PersistentVolumeClaim) (string, string) {
+	switch pvc.Status.Phase {
+	case corev1.ClaimBound:
+		return model.HealthHealthy, string(pvc.Status.Phase)
+	case corev1.ClaimLost:
+		return model.HealthDegraded, string(pvc.Status.Phase)
+	default:
+		return model.HealthProgressing, string(pvc.Status.Phase)
+	}
+}
+
+func serviceHealth(service *corev1.Service) (string, string) {
+	if service.Spec.Type == corev1.ServiceTypeLoadBalancer && len(service.Status.LoadBalancer.Ingress) == 0 {
+		return model.HealthProgressing, "Waiting for the load balancer"
+	}
+	return model.HealthHealthy, ""
+}
+
+// replicasOf returns the desired replicas, 1 when not set
+func replicasOf(replicas *int32) int32 {
+	if replicas == nil {
+		return 1
+	}
+	return *replicas
+}
@@ -0,0 +1,201 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/utils/ptr"
+
+	kubocdv1alpha1 "kubocd/api/v1alpha1"
+
+	"github.com/okdp/okdp-server/internal/model"
+)
+
+func newDynamicClient(objs ...runtime.Object) *dynamicfake.FakeDynamicClient {
+	return dynamicfake.NewSimpleDynamicClientWithCustomListKinds(newScheme(), map[schema.GroupVersionResource]string{
+		helmReleaseGVR: "HelmReleaseList",
+		appsv1.SchemeGroupVersion.WithResource("deployments"):            "DeploymentList",
+		appsv1.SchemeGroupVersion.WithResource("statefulsets"):           "StatefulSetList",
+		appsv1.SchemeGroupVersion.WithResource("daemonsets"):             "DaemonSetList",
+		appsv1.SchemeGroupVersion.WithResource("replicasets"):            "ReplicaSetList",
+		batchv1.SchemeGroupVersion.WithResource("jobs"):                  "JobList",
+		batchv1.SchemeGroupVersion.WithResource("cronjobs"):              "CronJobList",
+		corev1.SchemeGroupVersion.WithResource("services"):               "ServiceList",
+		corev1.SchemeGroupVersion.WithResource("persistentvolumeclaims"): "PersistentVolumeClaimList",
+		corev1.SchemeGroupVersion.WithResource("configmaps"):             "ConfigMapList",
+		corev1.SchemeGroupVersion.WithResource("pods"):                   "PodList",
+		secretGVR: "SecretList",
+		{Group: "networking.k8s.io", Version: "v1", Resource: "ingresses"}: "IngressList",
+	}, objs...)
+}
+
+func newTreeObjects() []runtime.Object {
+	helmRelease := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "helm.toolkit.fluxcd.io/v2",
+		"kind":       "HelmRelease",
+		"metadata":   map[string]interface{}{"name": "podinfo-main", "namespace": "team-a", "uid": "hr-1"},
+		"status": map[string]interface{}{
+			"storageNamespace": "team-a",
+			"history":          []interface{}{map[string]interface{}{"name": "team-a-podinfo-main"}},
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Ready", "status": "True", "message": "Helm install succeeded"},
+			},
+		},
+	}}
+	replicas := ptr.To(int32(2))
+	return []runtime.Object{
+		helmRelease,
+		&corev1.Secret{
+			TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+			ObjectMeta: metav1.ObjectMeta{Name: "sh.helm.release.v1.team-a-podinfo-main.v1", Namespace: "team-a", UID: "secret-1",
+				Labels: map[string]string{"owner": "helm", "name": "team-a-podinfo-main"}},
+		},
+		&appsv1.Deployment{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+			ObjectMeta: helmReleaseMeta("podinfo", "deploy-1", "podinfo-main"),
+			Spec:       appsv1.DeploymentSpec{Replicas: replicas},
+			Status:     appsv1.DeploymentStatus{Replicas: 2, UpdatedReplicas: 2, AvailableReplicas: 1},
+		},
+		&appsv1.ReplicaSet{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "ReplicaSet"},
+			ObjectMeta: ownedMeta("podinfo-5d8f", "rs-1", "Deployment", "podinfo", "deploy-1"),
+			Spec:       appsv1.ReplicaSetSpec{Replicas: replicas},
+			Status:     appsv1.ReplicaSetStatus{ReadyReplicas: 1},
+		},
+		&corev1.Pod{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+			ObjectMeta: ownedMeta("podinfo-5d8f-x2x8d", "pod-1", "ReplicaSet", "podinfo-5d8f", "rs-1"),
+			Status:     newHealthPod("podinfo-5d8f-x2x8d", false, 3, "CrashLoopBackOff").Status,
+		},
+		&corev1.Service{
+			TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Service"},
+			ObjectMeta: metav1.ObjectMeta{Name: "podinfo", Namespace: "team-a", UID: "svc-1", Annotations: map[string]string{
+				helmReleaseNameAnnotation: "team-a-podinfo-main", helmReleaseNamespaceAnnotation: "team-a",
+			}},
+		},
+		&corev1.ConfigMap{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+			ObjectMeta: helmReleaseMeta("redis-config", "cm-1", "redis-main"),
+		},
+	}
+}
+
+func TestKubeClient_GetReleaseResourceTree(t *testing.T) {
+	// Given
+	release := &kubocdv1alpha1.Release{
+		ObjectMeta: metav1.ObjectMeta{Name: "podinfo", Namespace: "team-a", UID: "release-1"},
+		Status: kubocdv1alpha1.ReleaseStatus{
+			Phase:             kubocdv1alpha1.ReleasePhaseReady,
+			HelmReleaseStates: map[string]kubocdv1alpha1.HelmReleaseState{"podinfo-main": {Ready: metav1.ConditionTrue}},
+		},
+	}
+	client := KubeClient{clusterID: "test", WithWatch: newFakeClient(release), dynamic: newDynamicClient(newTreeObjects()...)}
+
+	// When
+	tree, err := client.GetReleaseResourceTree(context.Background(), "team-a", "podinfo")
+
+	// Then
+	require.Nil(t, err)
+	assert.Equal(t, "release-1", tree.Release.UID)
+	type node struct{ kind, name, health, status, parent string }
+	var nodes []node
+	for _, n := range tree.Nodes {
+		parent := ""
+		if len(n.ParentRefs) > 0 {
+			parent = n.ParentRefs[0].Kind + "/" + n.ParentRefs[0].Name
+		}
+		nodes = append(nodes, node{n.Kind, n.Name, n.Health, n.Status, parent})
+	}
+	assert.Equal(t, []node{
+		{"Release", "podinfo", model.HealthHealthy, "", ""},
+		{"HelmRelease", "podinfo-main", model.HealthHealthy, "Helm install succeeded", "Release/podinfo"},
+		{"Secret", "sh.helm.release.v1.team-a-podinfo-main.v1", "", "", "HelmRelease/podinfo-main"},
+		{"Service", "podinfo", model.HealthHealthy, "", "HelmRelease/podinfo-main"},
+		{"Deployment", "podinfo", model.HealthProgressing, "1 of 2 updated replicas are available", "HelmRelease/podinfo-main"},
+		{"ReplicaSet", "podinfo-5d8f", model.HealthProgressing, "1 of 2 replicas are ready", "Deployment/podinfo"},
+		{"Pod", "podinfo-5d8f-x2x8d", model.HealthDegraded, "CrashLoopBackOff", "ReplicaSet/podinfo-5d8f"},
+	}, nodes)
+}
+
+func TestResourceHealth(t *testing.T) {
+	tests := []struct {
+		name   string
+		obj    interface{}
+		health string
+		status string
+	}{
+		{
+			name:   "failed job",
+			obj:    &batchv1.Job{TypeMeta: metav1.TypeMeta{Kind: "Job"}, Status: batchv1.JobStatus{Conditions: []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Message: "BackoffLimitExceeded"}}}},
+			health: model.HealthDegraded,
+			status: "BackoffLimitExceeded",
+		},
+		{
+			name:   "suspended cronjob",
+			obj:    &batchv1.CronJob{TypeMeta: metav1.TypeMeta{Kind: "CronJob"}, Spec: batchv1.CronJobSpec{Suspend: ptr.To(true)}},
+			health: model.HealthSuspended,
+			status: "CronJob is suspended",
+		},
+		{
+			name:   "pending pvc",
+			obj:    &corev1.PersistentVolumeClaim{TypeMeta: metav1.TypeMeta{Kind: "PersistentVolumeClaim"}, Status: corev1.PersistentVolumeClaimStatus{Phase: corev1.ClaimPending}},
+			health: model.HealthProgressing,
+			status: "Pending",
+		},
+		{
+			name:   "ready statefulset",
+			obj:    &appsv1.StatefulSet{TypeMeta: metav1.TypeMeta{Kind: "StatefulSet"}, Status: appsv1.StatefulSetStatus{ReadyReplicas: 1}},
+			health: model.HealthHealthy,
+		},
+		{
+			name:   "load balancer without ingress",
+			obj:    &corev1.Service{TypeMeta: metav1.TypeMeta{Kind: "Service"}, Spec: corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer}},
+			health: model.HealthProgressing,
+			status: "Waiting for the load balancer",
+		},
+		{
+			name: "configmap",
+			obj:  &corev1.ConfigMap{TypeMeta: metav1.TypeMeta{Kind: "ConfigMap"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(tt.obj)
+			require.NoError(t, err)
+
+			// When
+			health, status := resourceHealth(&unstructured.Unstructured{Object: content})
+
+			// Then
+			assert.Equal(t, tt.health, health)
+			assert.Equal(t, tt.status, status)
+		})
+	}
+}
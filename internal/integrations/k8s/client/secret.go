@@ -43,17 +43,15 @@ func (c KubeClient) GetSecret(ctx context.Context, name string, namespace string
 	var secret corev1.Secret
 	err := c.Get(ctx, secretKey, &secret)
 	if err != nil {
-		return nil, model.
-			NewServerResponse(model.K8sClusterResponse).
-			UnprocessableEntity("Failed to get Kubernetes secret '%s' in namespace '%s', details: '%s'", name, namespace, err.Error())
+		return nil, k8sError(err, nil, "Failed to get Kubernetes secret '%s' in namespace '%s', details: '%s'", name, namespace, err.Error())
 	}
 
 	return &K8SSecret{secret}, nil
 
 }
 
-func (c KubeClient) GetAuthMethod(secretName string, namespace string) (transport.AuthMethod, *model.ServerResponse) {
-	secret, err := c.GetSecret(context.Background(), secretName, namespace)
+func (c KubeClient) GetAuthMethod(ctx context.Context, secretName string, namespace string) (transport.AuthMethod, *model.ServerResponse) {
+	secret, err := c.GetSecret(ctx, secretName, namespace)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,200 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"net/http"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	kubocdv1alpha1 "kubocd/api/v1alpha1"
+
+	log "github.com/okdp/okdp-server/internal/common/logging"
+	"github.com/okdp/okdp-server/internal/model"
+)
+
+// startWatch starts a Kubernetes watch from the resource version
+type startWatch func(options metav1.ListOptions) (watch.Interface, error)
+
+// convertObject converts the object of the watch event into the notified one, the object is skipped when false is returned
+type convertObject func(eventType watch.EventType, obj runtime.Object) (interface{}, bool)
+
+// WatchReleases watches the KuboCD releases of the namespace
+func (c KubeClient) WatchReleases(ctx context.Context, namespace string, resourceVersion string) (<-chan *model.WatchEvent, *model.ServerResponse) {
+	return c.watch(ctx, resourceVersion, func(options metav1.ListOptions) (watch.Interface, error) {
+		return c.Watch(ctx, &kubocdv1alpha1.ReleaseList{}, ctrlclient.InNamespace(namespace), &ctrlclient.ListOptions{Raw: &options})
+	}, func(_ watch.EventType, obj runtime.Object) (interface{}, bool) {
+		release, ok := obj.(*kubocdv1alpha1.Release)
+		if !ok {
+			return nil, false
+		}
+		converted := model.Release(*release)
+		return converted.SanitizeMetadata(), true
+	})
+}
+
+// WatchPods watches the pods of the release, the pods are matched through their owner references as in GetPods.
+// The pods notified once are notified until deleted, even when their controllers no longer exist, and forgotten then.
+func (c KubeClient) WatchPods(ctx context.Context, namespace string, releaseName string, resourceVersion string) (<-chan *model.WatchEvent, *model.ServerResponse) {
+	release, err := c.GetRelease(ctx, namespace, releaseName)
+	if err != nil {
+		return nil, err
+	}
+	selector := newReleaseSelector(release, namespace, c.listHelmReleases(ctx, namespace))
+	resolver, er := c.newWorkloadResolver(ctx)
+	if er != nil {
+		return nil, watchError(er)
+	}
+	notified := map[types.UID]*workload{}
+
+	return c.watch(ctx, resourceVersion, func(options metav1.ListOptions) (watch.Interface, error) {
+		return c.CoreV1().Pods(selector.targetNamespace).Watch(ctx, options)
+	}, func(eventType watch.EventType, obj runtime.Object) (interface{}, bool) {
+		pod, ok := obj.(*corev1.Pod)
+		if !ok {
+			return nil, false
+		}
+		if p, matched := resolver.releasePod(ctx, selector, pod); matched {
+			notified[pod.UID] = p.workload
+		} else if _, known := notified[pod.UID]; !known {
+			return nil, false
+		}
+		info := toPodInfo(pod, notified[pod.UID])
+		if eventType == watch.Deleted {
+			delete(notified, pod.UID)
+		}
+		return info, true
+	})
+}
+
+// WatchEventsRelease watches the Kubernetes events of the release
+func (c KubeClient) WatchEventsRelease(ctx context.Context, namespace string, releaseName string, resourceVersion string) (<-chan *model.WatchEvent, *model.ServerResponse) {
+	fieldSelector := fields.AndSelectors(
+		fields.OneTermEqualSelector("involvedObject.name", releaseName),
+		fields.OneTermEqualSelector("involvedObject.kind", "Release"),
+	).String()
+
+	return c.watch(ctx, resourceVersion, func(options metav1.ListOptions) (watch.Interface, error) {
+		options.FieldSelector = fieldSelector
+		return c.CoreV1().Events(namespace).Watch(ctx, options)
+	}, func(_ watch.EventType, obj runtime.Object) (interface{}, bool) {
+		_, ok := obj.(*corev1.Event)
+		return obj, ok
+	})
+}
+
+// watch starts the watch and forwards the converted notifications to the returned channel until the context is done.
+// The watch is restarted from the last notified resource version when the API server closes it.
+// The channel is closed when the watch ends, an ERROR notification is sent before if it ends on error.
+func (c KubeClient) watch(ctx context.Context, resourceVersion string, start startWatch, convert convertObject) (<-chan *model.WatchEvent, *model.ServerResponse) {
+	watcher, err := start(watchOptions(resourceVersion))
+	if err != nil {
+		return nil, watchError(err)
+	}
+
+	events := make(chan *model.WatchEvent)
+	go func() {
+		defer close(events)
+		for {
+			closed := forward(ctx, watcher, events, convert, &resourceVersion)
+			watcher.Stop()
+			if !closed {
+				return
+			}
+			log.Ctx(ctx).Debug("Watch closed by the API server of the cluster '%s', restarting from the resource version '%s'", c.clusterID, resourceVersion)
+			if watcher, err = start(watchOptions(resourceVersion)); err != nil {
+				send(ctx, events, model.WatchErrorEvent(watchError(err)))
+				return
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+// forward sends the notifications of the watcher to the events channel, the last notified resource version is kept in resourceVersion.
+// It returns true when the API server closed the watch, false when the context is done or the watch failed.
+func forward(ctx context.Context, watcher watch.Interface, events chan<- *model.WatchEvent, convert convertObject, resourceVersion *string) bool {
+	for {
+		select {
+		case <-ctx.Done():
+			return false
+		case event, open := <-watcher.ResultChan():
+			if !open {
+				return true
+			}
+			if event.Type == watch.Error {
+				send(ctx, events, model.WatchErrorEvent(watchError(apierrors.FromObject(event.Object))))
+				return false
+			}
+
+			if accessor, err := meta.Accessor(event.Object); err == nil {
+				*resourceVersion = accessor.GetResourceVersion()
+			}
+
+			notification := &model.WatchEvent{
+				Type:            string(event.Type),
+				ResourceVersion: *resourceVersion,
+			}
+			if event.Type != watch.Bookmark {
+				object, ok := convert(event.Type, event.Object)
+				if !ok {
+					continue
+				}
+				notification.Object = object
+			}
+			if !send(ctx, events, notification) {
+				return false
+			}
+		}
+	}
+}
+
+func send(ctx context.Context, events chan<- *model.WatchEvent, event *model.WatchEvent) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case events <- event:
+		return true
+	}
+}
+
+func watchOptions(resourceVersion string) metav1.ListOptions {
+	return metav1.ListOptions{
+		ResourceVersion:     resourceVersion,
+		AllowWatchBookmarks: true,
+	}
+}
+
+// watchError converts the watch error, a too old resource version is reported with WATCH_EXPIRED
+func watchError(err error) *model.ServerResponse {
+	if apierrors.IsResourceExpired(err) || apierrors.IsGone(err) {
+		return model.NewServerResponse(model.K8sClusterResponse).
+			GenericError(http.StatusGone, "The resource version is too old, restart the watch without resource version: %s", err.Error()).
+			WithCode(model.ErrWatchExpired)
+	}
+	return k8sError(err, nil, "Failed to watch the resources: %s", err.Error())
+}
@@ -0,0 +1,108 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/okdp/okdp-server/internal/model"
+)
+
+func newPod(name string, resourceVersion string) *corev1.Pod {
+	return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", ResourceVersion: resourceVersion}}
+}
+
+func TestForward_ConvertsAndFilters(t *testing.T) {
+	// Given
+	watcher := watch.NewFake()
+	events := make(chan *model.WatchEvent, 10)
+	resourceVersion := ""
+	convert := func(_ watch.EventType, obj runtime.Object) (interface{}, bool) {
+		pod := obj.(*corev1.Pod)
+		return pod.Name, strings.HasPrefix(pod.Name, "podinfo")
+	}
+	go func() {
+		watcher.Add(newPod("podinfo-1", "10"))
+		watcher.Add(newPod("other-1", "11"))
+		watcher.Modify(newPod("podinfo-1", "12"))
+		watcher.Action(watch.Bookmark, newPod("", "13"))
+		watcher.Delete(newPod("podinfo-1", "14"))
+		watcher.Stop()
+	}()
+
+	// When
+	closed := forward(context.Background(), watcher, events, convert, &resourceVersion)
+	close(events)
+
+	// Then
+	assert.True(t, closed)
+	assert.Equal(t, "14", resourceVersion)
+	received := []model.WatchEvent{}
+	for event := range events {
+		received = append(received, *event)
+	}
+	assert.Equal(t, []model.WatchEvent{
+		{Type: model.WatchAdded, ResourceVersion: "10", Object: "podinfo-1"},
+		{Type: model.WatchModified, ResourceVersion: "12", Object: "podinfo-1"},
+		{Type: model.WatchBookmark, ResourceVersion: "13"},
+		{Type: model.WatchDeleted, ResourceVersion: "14", Object: "podinfo-1"},
+	}, received)
+}
+
+func TestForward_Error(t *testing.T) {
+	// Given
+	watcher := watch.NewFake()
+	events := make(chan *model.WatchEvent, 1)
+	resourceVersion := "10"
+	expired := apierrors.NewResourceExpired("too old resource version: 10 (20)")
+	go watcher.Error(&expired.ErrStatus)
+
+	// When
+	closed := forward(context.Background(), watcher, events, func(_ watch.EventType, obj runtime.Object) (interface{}, bool) { return obj, true }, &resourceVersion)
+
+	// Then
+	assert.False(t, closed)
+	event := <-events
+	assert.Equal(t, model.WatchError, event.Type)
+	resp := event.Object.(*model.ServerResponse)
+	assert.Equal(t, http.StatusGone, resp.Status)
+	assert.True(t, resp.HasCode(model.ErrWatchExpired))
+}
+
+func TestForward_ContextDone(t *testing.T) {
+	// Given
+	watcher := watch.NewFake()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	resourceVersion := ""
+
+	// When
+	closed := forward(ctx, watcher, make(chan *model.WatchEvent), func(_ watch.EventType, obj runtime.Object) (interface{}, bool) { return obj, true }, &resourceVersion)
+
+	// Then
+	assert.False(t, closed)
+}
@@ -0,0 +1,233 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package client
+
+import (
+	"context"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	log "github.com/okdp/okdp-server/internal/common/logging"
+	"github.com/okdp/okdp-server/internal/model"
+	"github.com/okdp/okdp-server/internal/utils"
+)
+
+const (
+	// fluxHelmReleaseNameLabel and fluxHelmReleaseNamespaceLabel are set by the helm controller on the objects of a HelmRelease
+	fluxHelmReleaseNameLabel      = "helm.toolkit.fluxcd.io/name"
+	fluxHelmReleaseNamespaceLabel = "helm.toolkit.fluxcd.io/namespace"
+	// helmReleaseNameAnnotation and helmReleaseNamespaceAnnotation are set by Helm on the objects of a Helm release
+	helmReleaseNameAnnotation      = "meta.helm.sh/release-name"
+	helmReleaseNamespaceAnnotation = "meta.helm.sh/release-namespace"
+	// maxOwnerDepth bounds the walk up the owner references (Pod → ReplicaSet → Deployment, Pod → Job → CronJob)
+	maxOwnerDepth = 4
+)
+
+// workloadKinds are the workloads deployed by Helm and their intermediate controllers, which own the release pods
+var workloadKinds = map[string]struct {
+	newObject func() ctrlclient.Object
+	newList   func() ctrlclient.ObjectList
+}{
+	"Deployment":  {func() ctrlclient.Object { return &appsv1.Deployment{} }, func() ctrlclient.ObjectList { return &appsv1.DeploymentList{} }},
+	"StatefulSet": {func() ctrlclient.Object { return &appsv1.StatefulSet{} }, func() ctrlclient.ObjectList { return &appsv1.StatefulSetList{} }},
+	"DaemonSet":   {func() ctrlclient.Object { return &appsv1.DaemonSet{} }, func() ctrlclient.ObjectList { return &appsv1.DaemonSetList{} }},
+	"ReplicaSet":  {func() ctrlclient.Object { return &appsv1.ReplicaSet{} }, func() ctrlclient.ObjectList { return &appsv1.ReplicaSetList{} }},
+	"Job":         {func() ctrlclient.Object { return &batchv1.Job{} }, func() ctrlclient.ObjectList { return &batchv1.JobList{} }},
+	"CronJob":     {func() ctrlclient.Object { return &batchv1.CronJob{} }, func() ctrlclient.ObjectList { return &batchv1.CronJobList{} }},
+}
+
+// workload is a controller of a pod, the object is nil when the controller kind is not resolved
+type workload struct {
+	kind   string
+	name   string
+	object metav1.Object
+}
+
+// releasePod is a pod of a release and its controlling (top-most) workload, nil for the standalone pods
+type releasePod struct {
+	pod      *corev1.Pod
+	workload *workload
+}
+
+// releaseSelector matches the objects deployed by the HelmReleases of a KuboCD release
+type releaseSelector struct {
+	namespace       string
+	targetNamespace string
+	helmReleases    map[string]bool
+	storageNames    map[string]bool
+}
+
+// workloadResolver resolves the controllers of the pods, the controllers which are not preloaded are read on demand
+type workloadResolver struct {
+	client  KubeClient
+	objects map[types.UID]*workload
+}
+
+// listHelmReleases returns the flux HelmReleases of the namespace, none when they cannot be listed
+// (the helm controller API is not installed or not allowed)
+func (c KubeClient) listHelmReleases(ctx context.Context, namespace string) []unstructured.Unstructured {
+	helmReleases := &unstructured.UnstructuredList{}
+	helmReleases.SetGroupVersionKind(helmReleaseListGVK)
+	if err := c.List(ctx, helmReleases, ctrlclient.InNamespace(namespace)); err != nil {
+		log.Ctx(ctx).Warn("Unable to list the helm releases from cluster '%s' in namespace '%s': %s", c.clusterID, namespace, err.Error())
+		return nil
+	}
+	return helmReleases.Items
+}
+
+// newReleaseSelector returns the selector of the objects of the release. The HelmReleases of the release are the ones
+// reported in its status or owned by it, their objects are labeled by the helm controller and annotated by Helm.
+func newReleaseSelector(release *model.Release, namespace string, helmReleases []unstructured.Unstructured) *releaseSelector {
+	targetNamespace := utils.DefaultIfEmpty(release.Spec.TargetNamespace, namespace)
+	selector := &releaseSelector{
+		namespace:       namespace,
+		targetNamespace: targetNamespace,
+		helmReleases:    map[string]bool{},
+		storageNames:    map[string]bool{},
+	}
+	for name := range release.Status.HelmReleaseStates {
+		selector.helmReleases[name] = true
+	}
+	for i := range helmReleases {
+		if !isReleaseHelmRelease(&helmReleases[i], release) {
+			continue
+		}
+		selector.helmReleases[helmReleases[i].GetName()] = true
+		if name := helmReleaseStorageName(&helmReleases[i]); name != "" {
+			selector.storageNames[name] = true
+		}
+	}
+	return selector
+}
+
+// helmReleaseStorageName returns the name of the Helm release installed by the HelmRelease
+func helmReleaseStorageName(helmRelease *unstructured.Unstructured) string {
+	history, _, _ := unstructured.NestedSlice(helmRelease.Object, "status", "history")
+	if len(history) > 0 {
+		if snapshot, ok := history[0].(map[string]interface{}); ok {
+			if name, ok := snapshot["name"].(string); ok {
+				return name
+			}
+		}
+	}
+	name, _, _ := unstructured.NestedString(helmRelease.Object, "spec", "releaseName")
+	return name
+}
+
+// matches returns true if the object is deployed by one of the HelmReleases of the release
+func (s *releaseSelector) matches(obj metav1.Object) bool {
+	labels := obj.GetLabels()
+	if s.helmReleases[labels[fluxHelmReleaseNameLabel]] && labels[fluxHelmReleaseNamespaceLabel] == s.namespace {
+		return true
+	}
+	annotations := obj.GetAnnotations()
+	return s.storageNames[annotations[helmReleaseNameAnnotation]] && annotations[helmReleaseNamespaceAnnotation] == s.targetNamespace
+}
+
+// newWorkloadResolver returns a resolver with the workloads of the namespaces preloaded
+func (c KubeClient) newWorkloadResolver(ctx context.Context, namespaces ...string) (*workloadResolver, error) {
+	r := &workloadResolver{client: c, objects: map[types.UID]*workload{}}
+	if len(namespaces) == 0 {
+		return r, nil
+	}
+	for kind, workloadKind := range workloadKinds {
+		list := workloadKind.newList()
+		if err := c.listInNamespaces(ctx, list, namespaces); err != nil {
+			return nil, err
+		}
+		items, err := apimeta.ExtractList(list)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			if obj, ok := item.(ctrlclient.Object); ok && utils.Contains(namespaces, obj.GetNamespace()) {
+				r.objects[obj.GetUID()] = &workload{kind: kind, name: obj.GetName(), object: obj}
+			}
+		}
+	}
+	return r, nil
+}
+
+// controllers returns the controllers of the object, from its direct controller up to the top-most one
+func (r *workloadResolver) controllers(ctx context.Context, obj metav1.Object) []*workload {
+	var chain []*workload
+	for ref := metav1.GetControllerOf(obj); ref != nil && len(chain) < maxOwnerDepth; ref = metav1.GetControllerOf(obj) {
+		owner := r.owner(ctx, obj.GetNamespace(), ref)
+		chain = append(chain, owner)
+		if owner.object == nil {
+			break
+		}
+		obj = owner.object
+	}
+	return chain
+}
+
+// owner returns the controller referenced by an object of the namespace, it is read from the API server when not
+// preloaded. The controllers of an unknown kind, or which no longer exist, are not resolved.
+func (r *workloadResolver) owner(ctx context.Context, namespace string, ref *metav1.OwnerReference) *workload {
+	if owner, ok := r.objects[ref.UID]; ok {
+		return owner
+	}
+	owner := &workload{kind: ref.Kind, name: ref.Name}
+	if workloadKind, ok := workloadKinds[ref.Kind]; ok {
+		obj := workloadKind.newObject()
+		err := r.client.Get(ctx, ctrlclient.ObjectKey{Namespace: namespace, Name: ref.Name}, obj)
+		if err == nil && obj.GetUID() == ref.UID {
+			owner.object = obj
+		}
+	}
+	r.objects[ref.UID] = owner
+	return owner
+}
+
+// releasePod returns the pod and its controlling workload when the pod, or one of its controllers, is deployed by the release
+func (r *workloadResolver) releasePod(ctx context.Context, selector *releaseSelector, pod *corev1.Pod) (*releasePod, bool) {
+	if pod.Namespace != selector.targetNamespace {
+		return nil, false
+	}
+	chain := r.controllers(ctx, pod)
+	matched := selector.matches(pod)
+	for _, owner := range chain {
+		matched = matched || (owner.object != nil && selector.matches(owner.object))
+	}
+	if !matched {
+		return nil, false
+	}
+	result := &releasePod{pod: pod}
+	if len(chain) > 0 {
+		result.workload = chain[len(chain)-1]
+	}
+	return result, true
+}
+
+// releasePods returns the pods deployed by the release among the pods
+func (r *workloadResolver) releasePods(ctx context.Context, selector *releaseSelector, pods []*corev1.Pod) []*releasePod {
+	var result []*releasePod
+	for _, pod := range pods {
+		if p, ok := r.releasePod(ctx, selector, pod); ok {
+			result = append(result, p)
+		}
+	}
+	return result
+}
@@ -0,0 +1,167 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	kubocdv1alpha1 "kubocd/api/v1alpha1"
+
+	"github.com/okdp/okdp-server/internal/model"
+)
+
+func newSparkRelease() *kubocdv1alpha1.Release {
+	return &kubocdv1alpha1.Release{
+		ObjectMeta: metav1.ObjectMeta{Name: "spark", Namespace: "team-a"},
+		Status: kubocdv1alpha1.ReleaseStatus{
+			HelmReleaseStates: map[string]kubocdv1alpha1.HelmReleaseState{"spark-main": {Ready: metav1.ConditionTrue}},
+		},
+	}
+}
+
+func helmReleaseMeta(name string, uid types.UID, helmRelease string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:      name,
+		Namespace: "team-a",
+		UID:       uid,
+		Labels:    map[string]string{fluxHelmReleaseNameLabel: helmRelease, fluxHelmReleaseNamespaceLabel: "team-a"},
+	}
+}
+
+func ownedMeta(name string, uid types.UID, kind string, owner string, ownerUID types.UID) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:      name,
+		Namespace: "team-a",
+		UID:       uid,
+		OwnerReferences: []metav1.OwnerReference{
+			{APIVersion: "apps/v1", Kind: kind, Name: owner, UID: ownerUID, Controller: ptr.To(true)},
+		},
+	}
+}
+
+func newSparkWorkloads() []ctrlclient.Object {
+	return []ctrlclient.Object{
+		newSparkRelease(),
+		// Release pods: Deployment → ReplicaSet → Pod and Job → Pod
+		&appsv1.Deployment{ObjectMeta: helmReleaseMeta("spark-master", "deploy-1", "spark-main")},
+		&appsv1.ReplicaSet{ObjectMeta: ownedMeta("spark-master-5d8f", "rs-1", "Deployment", "spark-master", "deploy-1")},
+		&corev1.Pod{ObjectMeta: ownedMeta("spark-master-5d8f-x2x8d", "pod-1", "ReplicaSet", "spark-master-5d8f", "rs-1")},
+		&batchv1.Job{ObjectMeta: helmReleaseMeta("init-warehouse", "job-1", "spark-main")},
+		&corev1.Pod{ObjectMeta: ownedMeta("init-warehouse-r7k2p", "pod-2", "Job", "init-warehouse", "job-1")},
+		// Pods of another release with the release name as prefix, and of no release
+		&appsv1.StatefulSet{ObjectMeta: helmReleaseMeta("spark-history", "sts-1", "spark-history-main")},
+		&corev1.Pod{ObjectMeta: ownedMeta("spark-history-0", "pod-3", "StatefulSet", "spark-history", "sts-1")},
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "spark-debug", Namespace: "team-a", UID: "pod-4"}},
+	}
+}
+
+func TestKubeClient_GetPods_OwnerReferences(t *testing.T) {
+	// Given
+	client := KubeClient{clusterID: "test", WithWatch: newFakeClient(newSparkWorkloads()...)}
+
+	// When
+	pods, err := client.GetPods(context.Background(), "team-a", "spark")
+
+	// Then
+	require.Nil(t, err)
+	workloads := map[string]string{}
+	for _, pod := range pods {
+		require.NotNil(t, pod.Workload, pod.Name)
+		workloads[pod.Name] = pod.Workload.Kind + "/" + pod.Workload.Name
+	}
+	assert.Equal(t, map[string]string{
+		"spark-master-5d8f-x2x8d": "Deployment/spark-master",
+		"init-warehouse-r7k2p":    "Job/init-warehouse",
+	}, workloads)
+}
+
+func TestWorkloadResolver_ResolvesOwnersOnDemand(t *testing.T) {
+	// Given
+	client := KubeClient{clusterID: "test", WithWatch: newFakeClient(newSparkWorkloads()...)}
+	resolver, err := client.newWorkloadResolver(context.Background())
+	require.NoError(t, err)
+	release := model.Release(*newSparkRelease())
+	selector := newReleaseSelector(&release, "team-a", nil)
+	pod := &corev1.Pod{ObjectMeta: ownedMeta("spark-master-5d8f-abcde", "pod-5", "ReplicaSet", "spark-master-5d8f", "rs-1")}
+
+	// When
+	p, matched := resolver.releasePod(context.Background(), selector, pod)
+
+	// Then
+	require.True(t, matched)
+	assert.Equal(t, "Deployment", p.workload.kind)
+	assert.Equal(t, "spark-master", p.workload.name)
+}
+
+func TestWorkloadResolver_UnknownOwner(t *testing.T) {
+	// Given
+	client := KubeClient{clusterID: "test", WithWatch: newFakeClient()}
+	resolver, err := client.newWorkloadResolver(context.Background())
+	require.NoError(t, err)
+	release := model.Release(*newSparkRelease())
+	selector := newReleaseSelector(&release, "team-a", nil)
+	pod := &corev1.Pod{ObjectMeta: ownedMeta("spark-master-5d8f-abcde", "pod-5", "ReplicaSet", "spark-master-5d8f", "rs-1")}
+
+	// When
+	_, matched := resolver.releasePod(context.Background(), selector, pod)
+
+	// Then
+	assert.False(t, matched, "the pods are not matched by name")
+}
+
+func TestReleaseSelector_HelmAnnotations(t *testing.T) {
+	// Given
+	release := model.Release(*newSparkRelease())
+	release.Spec.TargetNamespace = "spark"
+	helmRelease := unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":            "spark-core",
+			"namespace":       "team-a",
+			"ownerReferences": []interface{}{map[string]interface{}{"apiVersion": "kubocd.kubotal.io/v1alpha1", "kind": "Release", "name": "spark", "uid": "release-1"}},
+		},
+		"status": map[string]interface{}{
+			"history": []interface{}{map[string]interface{}{"name": "spark-spark-core"}},
+		},
+	}}
+
+	// When
+	selector := newReleaseSelector(&release, "team-a", []unstructured.Unstructured{helmRelease})
+
+	// Then
+	assert.Equal(t, "spark", selector.targetNamespace)
+	assert.True(t, selector.matches(&metav1.ObjectMeta{Annotations: map[string]string{
+		helmReleaseNameAnnotation: "spark-spark-core", helmReleaseNamespaceAnnotation: "spark",
+	}}))
+	assert.True(t, selector.matches(&metav1.ObjectMeta{Labels: map[string]string{
+		fluxHelmReleaseNameLabel: "spark-core", fluxHelmReleaseNamespaceLabel: "team-a",
+	}}))
+	assert.False(t, selector.matches(&metav1.ObjectMeta{Labels: map[string]string{
+		fluxHelmReleaseNameLabel: "spark-core", fluxHelmReleaseNamespaceLabel: "team-b",
+	}}))
+}
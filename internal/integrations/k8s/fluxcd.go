@@ -0,0 +1,67 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package k8s
+
+import (
+	"context"
+	"time"
+
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1"
+
+	"github.com/okdp/okdp-server/internal/model"
+)
+
+func (r K8S) ListGitRepositories(ctx context.Context, clusterID string, namespaces ...string) ([]*sourcev1.GitRepository, *model.ServerResponse) {
+	kubeClient, err := r.GetClient(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	return kubeClient.ListGitRepositories(ctx, namespaces...)
+}
+
+func (r K8S) ListKustomizations(ctx context.Context, clusterID string, namespaces ...string) ([]*kustomizev1.Kustomization, *model.ServerResponse) {
+	kubeClient, err := r.GetClient(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	return kubeClient.ListKutomizations(ctx, namespaces...)
+}
+
+func (r K8S) GetKustomization(ctx context.Context, clusterID string, namespace string, name string) (*kustomizev1.Kustomization, *model.ServerResponse) {
+	kubeClient, err := r.GetClient(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	return kubeClient.GetKustomization(ctx, name, namespace)
+}
+
+func (r K8S) RunKustomizationAction(ctx context.Context, clusterID string, namespace string, name string, action model.Action, timeout time.Duration) (*model.ActionResult, *model.ServerResponse) {
+	kubeClient, err := r.GetClient(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	return kubeClient.RunKustomizationAction(ctx, namespace, name, action, timeout)
+}
+
+func (r K8S) RunGitRepositoryAction(ctx context.Context, clusterID string, namespace string, name string, action model.Action, timeout time.Duration) (*model.ActionResult, *model.ServerResponse) {
+	kubeClient, err := r.GetClient(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	return kubeClient.RunGitRepositoryAction(ctx, namespace, name, action, timeout)
+}
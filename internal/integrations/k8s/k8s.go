@@ -27,48 +27,61 @@ type K8S struct {
 	*client.KubeClients
 }
 
+// SetServerContext ties the informers of the clusters to the lifetime of the server, it is called before NewK8S
+func SetServerContext(ctx context.Context) {
+	client.SetServerContext(ctx)
+}
+
 func NewK8S() *K8S {
 	return &K8S{
 		client.GetClients(),
 	}
 }
 
-func (s K8S) GetNamespaceByName(clusterID string, namespace string) (*model.Namespace, *model.ServerResponse) {
+func (s K8S) GetNamespaceByName(ctx context.Context, clusterID string, namespace string) (*model.Namespace, *model.ServerResponse) {
 	kubeClient, err := s.GetClient(clusterID)
 	if err != nil {
 		return nil, err
 	}
-	return kubeClient.GetNamespaceByName(context.Background(), clusterID, namespace)
+	return kubeClient.GetNamespaceByName(ctx, clusterID, namespace)
 }
 
-func (s K8S) ListNamespaces(clusterID string) ([]*model.Namespace, *model.ServerResponse) {
+func (s K8S) ListNamespaces(ctx context.Context, clusterID string) ([]*model.Namespace, *model.ServerResponse) {
 	kubeClient, err := s.GetClient(clusterID)
 	if err != nil {
 		return nil, err
 	}
-	return kubeClient.ListNamespaces(context.Background())
+	return kubeClient.ListNamespaces(ctx)
 }
 
-func (s K8S) CreateNamespace(clusterID string, namespace *model.Namespace) *model.ServerResponse {
+func (s K8S) CreateNamespace(ctx context.Context, clusterID string, namespace *model.Namespace) *model.ServerResponse {
 	kubeClient, err := s.GetClient(clusterID)
 	if err != nil {
 		return err
 	}
-	return kubeClient.CreateNamespace(context.Background(), namespace)
+	return kubeClient.CreateNamespace(ctx, namespace)
 }
 
-func (s K8S) UpdateNamespace(clusterID string, namespace *model.Namespace) *model.ServerResponse {
+func (s K8S) UpdateNamespace(ctx context.Context, clusterID string, namespace *model.Namespace, ifMatch string, removedAnnotations ...string) *model.ServerResponse {
 	kubeClient, err := s.GetClient(clusterID)
 	if err != nil {
 		return err
 	}
-	return kubeClient.UpdateNamespace(context.Background(), namespace)
+	return kubeClient.UpdateNamespace(ctx, namespace, ifMatch, removedAnnotations...)
 }
 
-func (s K8S) DeleteNamespace(clusterID string, namespace string) *model.ServerResponse {
+func (s K8S) DeleteNamespace(ctx context.Context, clusterID string, namespace string, ifMatch string) *model.ServerResponse {
 	kubeClient, err := s.GetClient(clusterID)
 	if err != nil {
 		return err
 	}
-	return kubeClient.DeleteNamespace(context.Background(), namespace)
+	return kubeClient.DeleteNamespace(ctx, namespace, ifMatch)
+}
+
+func (s K8S) ServerVersion(clusterID string) (string, *model.ServerResponse) {
+	kubeClient, err := s.GetClient(clusterID)
+	if err != nil {
+		return "", err
+	}
+	return kubeClient.ServerVersion()
 }
@@ -18,62 +18,127 @@ package k8s
 
 import (
 	"context"
+	"time"
 
 	"github.com/okdp/okdp-server/internal/model"
 )
 
-func (r K8S) ListReleases(clusterID string, namespaces ...string) ([]*model.Release, *model.ServerResponse) {
+func (r K8S) ListReleases(ctx context.Context, clusterID string, namespaces ...string) ([]*model.Release, *model.ServerResponse) {
 	kubeClient, err := r.GetClient(clusterID)
 	if err != nil {
 		return nil, err
 	}
-	return kubeClient.ListReleases(context.Background(), namespaces...)
+	return kubeClient.ListReleases(ctx, namespaces...)
 }
 
-func (r K8S) GetRelease(clusterID string, namespace string, releaseName string) (*model.Release, *model.ServerResponse) {
+func (r K8S) ListReleasesPage(ctx context.Context, clusterID string, namespace string, options *model.ListOptions) (*model.ListResult[*model.Release], *model.ServerResponse) {
 	kubeClient, err := r.GetClient(clusterID)
 	if err != nil {
 		return nil, err
 	}
-	return kubeClient.GetRelease(context.Background(), namespace, releaseName)
+	return kubeClient.ListReleasesPage(ctx, namespace, options)
 }
 
-func (r K8S) GetReleaseStatus(clusterID string, namespace string, releaseName string) (*model.ReleaseStatus, *model.ServerResponse) {
+func (r K8S) GetRelease(ctx context.Context, clusterID string, namespace string, releaseName string) (*model.Release, *model.ServerResponse) {
 	kubeClient, err := r.GetClient(clusterID)
 	if err != nil {
 		return nil, err
 	}
-	return kubeClient.GetReleaseStatus(context.Background(), namespace, releaseName)
+	return kubeClient.GetRelease(ctx, namespace, releaseName)
 }
 
-func (r K8S) CreateRelease(clusterID string, namespace string, release *model.Release, dryRun bool) *model.ServerResponse {
+func (r K8S) GetReleaseStatus(ctx context.Context, clusterID string, namespace string, releaseName string) (*model.ReleaseStatus, *model.ServerResponse) {
+	kubeClient, err := r.GetClient(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	return kubeClient.GetReleaseStatus(ctx, namespace, releaseName)
+}
+
+func (r K8S) GetReleaseHealth(ctx context.Context, clusterID string, namespace string, releaseName string) (*model.ReleaseHealth, *model.ServerResponse) {
+	kubeClient, err := r.GetClient(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	return kubeClient.GetReleaseHealth(ctx, namespace, releaseName)
+}
+
+func (r K8S) ListReleasesHealth(ctx context.Context, clusterID string, namespace string) ([]*model.ReleaseHealth, *model.ServerResponse) {
+	kubeClient, err := r.GetClient(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	return kubeClient.ListReleasesHealth(ctx, namespace)
+}
+
+func (r K8S) GetReleaseResourceTree(ctx context.Context, clusterID string, namespace string, releaseName string) (*model.ResourceTree, *model.ServerResponse) {
+	kubeClient, err := r.GetClient(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	return kubeClient.GetReleaseResourceTree(ctx, namespace, releaseName)
+}
+
+func (r K8S) CreateRelease(ctx context.Context, clusterID string, namespace string, release *model.Release, dryRun bool) *model.ServerResponse {
 	kubeClient, err := r.GetClient(clusterID)
 	if err != nil {
 		return err
 	}
-	return kubeClient.CreateRelease(context.Background(), namespace, release, dryRun)
+	return kubeClient.CreateRelease(ctx, namespace, release, dryRun)
 }
 
-func (r K8S) UpdateRelease(clusterID string, namespace string, release *model.Release, dryRun bool) *model.ServerResponse {
+func (r K8S) UpdateRelease(ctx context.Context, clusterID string, namespace string, release *model.Release, dryRun bool, ifMatch string) *model.ServerResponse {
 	kubeClient, err := r.GetClient(clusterID)
 	if err != nil {
 		return err
 	}
-	return kubeClient.UpdateRelease(context.Background(), namespace, release, dryRun)
+	return kubeClient.UpdateRelease(ctx, namespace, release, dryRun, ifMatch)
+}
+
+func (r K8S) PatchRelease(ctx context.Context, clusterID string, namespace string, releaseName string, patch *model.Patch, dryRun bool, ifMatch string) (*model.Release, *model.ServerResponse) {
+	kubeClient, err := r.GetClient(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	return kubeClient.PatchRelease(ctx, namespace, releaseName, patch, dryRun, ifMatch)
+}
+
+func (r K8S) DeleteRelease(ctx context.Context, clusterID string, namespace string, releaseName string, ifMatch string) *model.ServerResponse {
+	kubeClient, err := r.GetClient(clusterID)
+	if err != nil {
+		return err
+	}
+	return kubeClient.DeleteRelease(ctx, namespace, releaseName, ifMatch)
+}
+
+func (r K8S) ListEventsRelease(ctx context.Context, clusterID string, namespace, releaseName string, options *model.ListOptions) (*model.ListResult[map[string]interface{}], *model.ServerResponse) {
+	kubeClient, err := r.GetClient(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	return kubeClient.ListEventsRelease(ctx, namespace, releaseName, options)
+}
+
+func (r K8S) ListReleaseRevisions(ctx context.Context, clusterID string, namespace string, releaseName string) ([]*model.ReleaseRevision, *model.ServerResponse) {
+	kubeClient, err := r.GetClient(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	return kubeClient.ListReleaseRevisions(ctx, namespace, releaseName)
 }
 
-func (r K8S) DeleteRelease(clusterID string, namespace string, releaseName string) *model.ServerResponse {
+func (r K8S) RecordReleaseRevision(ctx context.Context, clusterID string, namespace string, revision *model.ReleaseRevision) *model.ServerResponse {
 	kubeClient, err := r.GetClient(clusterID)
 	if err != nil {
 		return err
 	}
-	return kubeClient.DeleteRelease(context.Background(), namespace, releaseName)
+	return kubeClient.RecordReleaseRevision(ctx, namespace, revision)
 }
 
-func (r K8S) ListEventsRelease(clusterID string, namespace, releaseName string) ([]map[string]interface{}, *model.ServerResponse) {
+func (r K8S) RunReleaseAction(ctx context.Context, clusterID string, namespace string, releaseName string, action model.Action, timeout time.Duration) (*model.ActionResult, *model.ServerResponse) {
 	kubeClient, err := r.GetClient(clusterID)
 	if err != nil {
 		return nil, err
 	}
-	return kubeClient.ListEventsRelease(context.Background(), namespace, releaseName)
+	return kubeClient.RunReleaseAction(ctx, namespace, releaseName, action, timeout)
 }
@@ -19,22 +19,58 @@ package k8s
 import (
 	"context"
 	"io"
+	"net/http/httputil"
+
+	corev1 "k8s.io/api/core/v1"
 
 	"github.com/okdp/okdp-server/internal/model"
 )
 
-func (r K8S) GetPods(clusterID, namespace, releaseName string) ([]*model.PodInfo, *model.ServerResponse) {
+func (r K8S) GetPods(ctx context.Context, clusterID, namespace, releaseName string) ([]*model.PodInfo, *model.ServerResponse) {
+	kubeClient, err := r.GetClient(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	return kubeClient.GetPods(ctx, namespace, releaseName)
+}
+
+func (r K8S) StreamLogs(ctx context.Context, clusterID, namespace, pod, container string, tailLines *int64, isSSE bool) (io.ReadCloser, *model.ServerResponse) {
+	kubeClient, err := r.GetClient(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	return kubeClient.StreamLogs(ctx, namespace, pod, container, tailLines, isSSE)
+}
+
+func (r K8S) ListPods(ctx context.Context, clusterID string, namespaces ...string) ([]*corev1.Pod, *model.ServerResponse) {
+	kubeClient, err := r.GetClient(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	return kubeClient.ListPods(ctx, namespaces...)
+}
+
+// Exec runs the command in the container of the pod until the command exits or the context is done
+func (r K8S) Exec(ctx context.Context, clusterID, namespace, pod, container string, options *model.ExecOptions) (int, *model.ServerResponse) {
+	kubeClient, err := r.GetClient(clusterID)
+	if err != nil {
+		return -1, err
+	}
+	return kubeClient.Exec(ctx, namespace, pod, container, options)
+}
+
+func (r K8S) GetReleaseProxyTarget(ctx context.Context, clusterID, namespace, releaseName, kind, name, port string) (*model.ProxyTarget, *model.ServerResponse) {
 	kubeClient, err := r.GetClient(clusterID)
 	if err != nil {
 		return nil, err
 	}
-	return kubeClient.GetPods(context.Background(), namespace, releaseName)
+	return kubeClient.GetReleaseProxyTarget(ctx, namespace, releaseName, kind, name, port)
 }
 
-func (r K8S) StreamLogs(clusterID, namespace, pod, container string, tailLines *int64, isSSE bool) (io.ReadCloser, *model.ServerResponse) {
+func (r K8S) NewReverseProxy(clusterID string, target *model.ProxyTarget, prefix string) (*httputil.ReverseProxy, *model.ServerResponse) {
 	kubeClient, err := r.GetClient(clusterID)
 	if err != nil {
 		return nil, err
 	}
-	return kubeClient.StreamLogs(context.Background(), namespace, pod, container, tailLines, isSSE)
+	return kubeClient.NewReverseProxy(target, prefix)
 }
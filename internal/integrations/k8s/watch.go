@@ -0,0 +1,47 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package k8s
+
+import (
+	"context"
+
+	"github.com/okdp/okdp-server/internal/model"
+)
+
+func (r K8S) WatchReleases(ctx context.Context, clusterID string, namespace string, resourceVersion string) (<-chan *model.WatchEvent, *model.ServerResponse) {
+	kubeClient, err := r.GetClient(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	return kubeClient.WatchReleases(ctx, namespace, resourceVersion)
+}
+
+func (r K8S) WatchPods(ctx context.Context, clusterID string, namespace string, releaseName string, resourceVersion string) (<-chan *model.WatchEvent, *model.ServerResponse) {
+	kubeClient, err := r.GetClient(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	return kubeClient.WatchPods(ctx, namespace, releaseName, resourceVersion)
+}
+
+func (r K8S) WatchEventsRelease(ctx context.Context, clusterID string, namespace string, releaseName string, resourceVersion string) (<-chan *model.WatchEvent, *model.ServerResponse) {
+	kubeClient, err := r.GetClient(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	return kubeClient.WatchEventsRelease(ctx, namespace, releaseName, resourceVersion)
+}
@@ -19,9 +19,7 @@ package client
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"io"
-	"net/http"
 	"strings"
 	"sync"
 
@@ -30,7 +28,6 @@ import (
 	"oras.land/oras-go/v2/content/memory"
 	"oras.land/oras-go/v2/registry/remote"
 	"oras.land/oras-go/v2/registry/remote/auth"
-	"oras.land/oras-go/v2/registry/remote/errcode"
 	"oras.land/oras-go/v2/registry/remote/retry"
 
 	log "github.com/okdp/okdp-server/internal/common/logging"
@@ -130,12 +127,30 @@ func GetPackage(catalogID string, name string) (*model.Package, *model.ServerRes
 	return nil, model.CatalogPackageNotFoundError(catalogID, name)
 }
 
-func GetPackageDefinition(catalogID string, name string, version string) (map[string]interface{}, *model.ServerResponse) {
+// FindPackage returns the catalog and the name of the package published in the OCI repository
+// (<catalog repoUrl>/<package name>), the first configured catalog wins.
+func FindPackage(repository string) (string, string, bool) {
+	repository = normalizeRepository(repository)
+	for _, catalog := range config.GetAppConfig().Catalogs {
+		for _, p := range catalog.Packages {
+			if strings.EqualFold(normalizeRepository(catalog.RepoURL)+"/"+p.Name, repository) {
+				return catalog.ID, p.Name, true
+			}
+		}
+	}
+	return "", "", false
+}
+
+func normalizeRepository(repository string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(repository, "oci://"), "/")
+}
+
+func GetPackageDefinition(ctx context.Context, catalogID string, name string, version string) (map[string]interface{}, *model.ServerResponse) {
 	repo, err := getRepoClient(catalogID, name)
 	if err != nil {
 		return nil, err
 	}
-	return repo.fetchDefinition(version)
+	return repo.fetchDefinition(ctx, version)
 }
 
 func getPackage(catalogID string, name string, repoURL string) (*model.Package, *model.ServerResponse) {
@@ -169,17 +184,9 @@ func (r RepositoryClient) listTags() ([]string, *model.ServerResponse) {
 			return nil
 		})
 
-		statusCode := http.StatusBadGateway
 		if er != nil {
 			if er != io.EOF {
-				var httpErr *errcode.ErrorResponse
-				if errors.As(er, &httpErr) {
-					err = model.
-						NewServerResponse(model.RegistryResponse).GenericError(httpErr.StatusCode, httpErr.Error())
-				} else {
-					err = model.
-						NewServerResponse(model.RegistryResponse).GenericError(statusCode, er.Error())
-				}
+				err = registryError(er)
 			}
 			break
 		}
@@ -188,21 +195,19 @@ func (r RepositoryClient) listTags() ([]string, *model.ServerResponse) {
 	return allTags, err
 }
 
-func (r RepositoryClient) fetchDefinition(version string) (map[string]interface{}, *model.ServerResponse) {
-	ctx := context.Background()
+func (r RepositoryClient) fetchDefinition(ctx context.Context, version string) (map[string]interface{}, *model.ServerResponse) {
 	// Pull to memory
 	memStore := memory.New()
 	desc, err := oras.Copy(ctx, r.Repository, version, memStore, version, oras.DefaultCopyOptions)
 	if err != nil {
-		log.Error("Failed to copy definition into the memstore: %v", err)
-		return nil, model.
-			NewServerResponse(model.OkdpServerResponse).UnprocessableEntity(err.Error())
+		log.Ctx(ctx).Error("Failed to copy definition into the memstore: %v", err)
+		return nil, registryError(err)
 	}
 
 	// Decode manifest
 	manifestReader, err := memStore.Fetch(ctx, desc)
 	if err != nil {
-		log.Error("Failed to fetch definition from the memstore: %v", err)
+		log.Ctx(ctx).Error("Failed to fetch definition from the memstore: %v", err)
 		return nil, model.
 			NewServerResponse(model.OkdpServerResponse).UnprocessableEntity(err.Error())
 	}
@@ -210,14 +215,14 @@ func (r RepositoryClient) fetchDefinition(version string) (map[string]interface{
 
 	var manifest ocispec.Manifest
 	if err := json.NewDecoder(manifestReader).Decode(&manifest); err != nil {
-		log.Error("Failed to decode definition: %v", err)
+		log.Ctx(ctx).Error("Failed to decode definition: %v", err)
 		return nil, model.
 			NewServerResponse(model.OkdpServerResponse).UnprocessableEntity(err.Error())
 	}
 
 	configReader, err := memStore.Fetch(ctx, manifest.Config)
 	if err != nil {
-		log.Error("Failed to fetch the definition content: %v", err)
+		log.Ctx(ctx).Error("Failed to fetch the definition content: %v", err)
 		return nil, model.
 			NewServerResponse(model.OkdpServerResponse).UnprocessableEntity(err.Error())
 	}
@@ -225,7 +230,7 @@ func (r RepositoryClient) fetchDefinition(version string) (map[string]interface{
 
 	var definition map[string]interface{}
 	if err := json.NewDecoder(configReader).Decode(&definition); err != nil {
-		log.Error("Failed to decode the definition content: %v", err)
+		log.Ctx(ctx).Error("Failed to decode the definition content: %v", err)
 		return nil, model.
 			NewServerResponse(model.OkdpServerResponse).UnprocessableEntity(err.Error())
 	}
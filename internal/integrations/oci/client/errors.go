@@ -0,0 +1,70 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package client
+
+import (
+	"errors"
+	"net/http"
+
+	"oras.land/oras-go/v2/errdef"
+	"oras.land/oras-go/v2/registry/remote/errcode"
+
+	"github.com/okdp/okdp-server/internal/model"
+)
+
+// registryError converts an OCI registry error into a server response with the matching HTTP status and error code.
+// The credentials rejected by the registry are returned as forbidden to not be confused with the user authentication.
+func registryError(err error, messages ...interface{}) *model.ServerResponse {
+	response := model.NewServerResponse(model.RegistryResponse)
+	if len(messages) == 0 {
+		messages = []interface{}{err.Error()}
+	}
+
+	if errors.Is(err, errdef.ErrNotFound) {
+		return response.WithCode(model.ErrRegistryNotFound).NotFoundError(messages...)
+	}
+
+	var httpErr *errcode.ErrorResponse
+	if !errors.As(err, &httpErr) {
+		return response.WithCode(model.ErrRegistry).GenericError(http.StatusBadGateway, messages...)
+	}
+
+	switch {
+	case httpErr.StatusCode == http.StatusUnauthorized || hasRegistryErrorCode(httpErr, errcode.ErrorCodeUnauthorized):
+		return response.WithCode(model.ErrRegistryUnauthorized).Forbidden(messages...)
+	case httpErr.StatusCode == http.StatusForbidden || hasRegistryErrorCode(httpErr, errcode.ErrorCodeDenied):
+		return response.WithCode(model.ErrRegistryDenied).Forbidden(messages...)
+	case httpErr.StatusCode == http.StatusNotFound ||
+		hasRegistryErrorCode(httpErr, errcode.ErrorCodeNameUnknown, errcode.ErrorCodeManifestUnknown, errcode.ErrorCodeBlobUnknown):
+		return response.WithCode(model.ErrRegistryNotFound).NotFoundError(messages...)
+	case httpErr.StatusCode == http.StatusTooManyRequests:
+		return response.WithCode(model.ErrRegistryTooManyRequests).GenericError(http.StatusTooManyRequests, messages...)
+	default:
+		return response.WithCode(model.ErrRegistry).GenericError(http.StatusBadGateway, messages...)
+	}
+}
+
+func hasRegistryErrorCode(httpErr *errcode.ErrorResponse, codes ...string) bool {
+	for _, e := range httpErr.Errors {
+		for _, code := range codes {
+			if e.Code == code {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -17,6 +17,8 @@
 package oci
 
 import (
+	"context"
+
 	"github.com/okdp/okdp-server/internal/integrations/oci/client"
 	"github.com/okdp/okdp-server/internal/model"
 )
@@ -47,6 +49,10 @@ func (r RepoCatalog) GetPackage(catalogID string, name string) (*model.Package,
 	return client.GetPackage(catalogID, name)
 }
 
-func (r RepoCatalog) GetPackageDefinition(catalogID string, name string, version string) (map[string]interface{}, *model.ServerResponse) {
-	return client.GetPackageDefinition(catalogID, name, version)
+func (r RepoCatalog) GetPackageDefinition(ctx context.Context, catalogID string, name string, version string) (map[string]interface{}, *model.ServerResponse) {
+	return client.GetPackageDefinition(ctx, catalogID, name, version)
+}
+
+func (r RepoCatalog) FindPackage(repository string) (string, string, bool) {
+	return client.FindPackage(repository)
 }
@@ -0,0 +1,53 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package model
+
+import (
+	"github.com/okdp/okdp-server/api/openapi/v3/_api"
+)
+
+type ActionResult _api.ActionResult
+type Action = _api.ActionResultAction
+
+const (
+	ActionSuspend   = _api.ActionResultActionSuspend
+	ActionResume    = _api.ActionResultActionResume
+	ActionReconcile = _api.ActionResultActionReconcile
+
+	ActionSuspended = _api.ActionResultOutcomeSuspended
+	ActionRequested = _api.ActionResultOutcomeRequested
+	ActionReady     = _api.ActionResultOutcomeReady
+	ActionNotReady  = _api.ActionResultOutcomeNotReady
+	ActionTimeout   = _api.ActionResultOutcomeTimeout
+)
+
+// NewActionResult returns the result of the action run on the resource, the outcome is set by the caller
+func NewActionResult(kind string, namespace string, name string, action Action) *ActionResult {
+	return &ActionResult{
+		Kind:      kind,
+		Namespace: namespace,
+		Name:      name,
+		Action:    action,
+	}
+}
+
+// ResourceSuspendedError is returned when the reconciliation of a suspended resource is requested
+func ResourceSuspendedError(kind string, namespace string, name string) *ServerResponse {
+	return NewServerResponse(OkdpServerResponse).
+		ConflictError("%s '%s/%s' is suspended, resume it to reconcile it", kind, namespace, name).
+		WithCode(ErrResourceSuspended)
+}
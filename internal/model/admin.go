@@ -0,0 +1,212 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package model
+
+import (
+	"fmt"
+	"time"
+
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/okdp/okdp-server/api/openapi/v3/_api"
+	"github.com/okdp/okdp-server/internal/utils"
+)
+
+type ReleaseSummary _api.ReleaseSummary
+type GitSource _api.GitSource
+type ProjectStats _api.ProjectStats
+type SystemInfo _api.SystemInfo
+
+// ClusterInfo is the reachability state of a cluster reported in SystemInfo
+type ClusterInfo = struct {
+	Env               *string `json:"env,omitempty"`
+	Id                string  `json:"id"`
+	KubernetesVersion *string `json:"kubernetesVersion,omitempty"`
+	Message           *string `json:"message,omitempty"`
+	Reachable         bool    `json:"reachable"`
+}
+
+// ReleaseSummaryComparators are the sort keys of the admin release summaries
+var ReleaseSummaryComparators = map[string]utils.Comparator[*ReleaseSummary]{
+	"clusterId":         utils.CompareStrings(func(r *ReleaseSummary) string { return r.ClusterId }),
+	"namespace":         utils.CompareStrings(func(r *ReleaseSummary) string { return r.Namespace }),
+	"name":              utils.CompareStrings(func(r *ReleaseSummary) string { return r.Name }),
+	"creationTimestamp": utils.CompareTimes(func(r *ReleaseSummary) time.Time { return orZero(r.CreationTimestamp) }),
+	"phase":             utils.CompareStrings(func(r *ReleaseSummary) string { return utils.OrEmpty(r.Phase) }),
+	"package":           utils.CompareStrings(func(r *ReleaseSummary) string { return r.PackageRepository }),
+	"tag":               utils.CompareStrings(func(r *ReleaseSummary) string { return r.PackageTag }),
+}
+
+// Matches checks whether the release summary matches the status (phase), package, tag and search filters
+func (r *ReleaseSummary) Matches(options *ListOptions) bool {
+	return utils.MatchesFilter(options.Status, utils.OrEmpty(r.Phase)) &&
+		utils.MatchesSearch(options.Package, r.PackageRepository) &&
+		utils.MatchesVersion(options.Tag, r.PackageTag) &&
+		utils.MatchesSearch(options.Search, r.Name, r.Namespace, utils.OrEmpty(r.Description))
+}
+
+// GitSourceComparators are the sort keys of the admin git sources
+var GitSourceComparators = map[string]utils.Comparator[*GitSource]{
+	"clusterId":    utils.CompareStrings(func(g *GitSource) string { return g.ClusterId }),
+	"namespace":    utils.CompareStrings(func(g *GitSource) string { return g.Namespace }),
+	"name":         utils.CompareStrings(func(g *GitSource) string { return g.Name }),
+	"lastSyncTime": utils.CompareTimes(func(g *GitSource) time.Time { return orZero(g.LastSyncTime) }),
+}
+
+// Matches checks whether the git source matches the status (ready or not ready) and search filters
+func (g *GitSource) Matches(options *ListOptions) bool {
+	status := "NotReady"
+	if g.Ready {
+		status = "Ready"
+	}
+	return utils.MatchesFilter(options.Status, status) &&
+		utils.MatchesSearch(options.Search, g.Name, g.Namespace, g.Url)
+}
+
+// ProjectStatsComparators are the sort keys of the admin projects
+var ProjectStatsComparators = map[string]utils.Comparator[*ProjectStats]{
+	"clusterId":         utils.CompareStrings(func(p *ProjectStats) string { return p.ClusterId }),
+	"name":              utils.CompareStrings(func(p *ProjectStats) string { return p.Name }),
+	"creationTimestamp": utils.CompareTimes(func(p *ProjectStats) time.Time { return orZero(p.CreationTimestamp) }),
+	"releases":          func(a, b *ProjectStats) int { return a.Releases - b.Releases },
+	"pods":              func(a, b *ProjectStats) int { return a.Pods - b.Pods },
+}
+
+// Matches checks whether the project matches the status and search filters
+func (p *ProjectStats) Matches(options *ListOptions) bool {
+	return utils.MatchesFilter(options.Status, utils.OrEmpty(p.Status)) &&
+		utils.MatchesSearch(options.Search, p.Name, utils.OrEmpty(p.DisplayName), utils.OrEmpty(p.Owner))
+}
+
+func orZero(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return *t
+}
+
+func (r *Release) ToSummary(clusterID string) *ReleaseSummary {
+	summary := &ReleaseSummary{
+		ClusterId:         clusterID,
+		Namespace:         r.Namespace,
+		Name:              r.Name,
+		Description:       utils.EmptyToNil(utils.DefaultIfEmpty(r.Spec.Description, r.Status.PrintDescription)),
+		PackageRepository: r.Spec.Package.Repository,
+		PackageTag:        r.Spec.Package.Tag,
+		TargetNamespace:   utils.EmptyToNil(r.Spec.TargetNamespace),
+		Phase:             utils.EmptyToNil(string(r.Status.Phase)),
+		ReadyReleases:     utils.EmptyToNil(r.Status.ReadyReleases),
+		Suspended:         &r.Spec.Suspended,
+		Protected:         &r.Status.Protected,
+	}
+	if !r.CreationTimestamp.IsZero() {
+		t := r.CreationTimestamp.Time
+		summary.CreationTimestamp = &t
+	}
+	return summary
+}
+
+// ToGitSource converts a Flux GitRepository and the Kustomizations referencing it
+func ToGitSource(clusterID string, repo *sourcev1.GitRepository, kustomizations []*kustomizev1.Kustomization) *GitSource {
+	source := &GitSource{
+		ClusterId: clusterID,
+		Namespace: repo.Namespace,
+		Name:      repo.Name,
+		Url:       repo.Spec.URL,
+		Suspended: &repo.Spec.Suspend,
+	}
+
+	if ref := repo.Spec.Reference; ref != nil {
+		source.Ref = utils.EmptyToNil(utils.FirstNonEmpty(ref.Branch, ref.Tag, ref.SemVer, ref.Name, ref.Commit))
+	}
+
+	if ready := apimeta.FindStatusCondition(repo.Status.Conditions, "Ready"); ready != nil {
+		source.Ready = ready.Status == "True"
+		source.Reason = utils.EmptyToNil(ready.Reason)
+		source.Message = utils.EmptyToNil(ready.Message)
+		t := ready.LastTransitionTime.Time
+		source.LastSyncTime = &t
+	}
+
+	if artifact := repo.Status.Artifact; artifact != nil {
+		source.Revision = utils.EmptyToNil(artifact.Revision)
+		t := artifact.LastUpdateTime.Time
+		source.LastSyncTime = &t
+	}
+
+	referencing := []string{}
+	for _, k := range kustomizations {
+		sourceRef := k.Spec.SourceRef
+		if sourceRef.Kind == sourcev1.GitRepositoryKind &&
+			sourceRef.Name == repo.Name &&
+			utils.DefaultIfEmpty(sourceRef.Namespace, k.Namespace) == repo.Namespace {
+			referencing = append(referencing, fmt.Sprintf("%s/%s", k.Namespace, k.Name))
+		}
+	}
+	source.Kustomizations = &referencing
+
+	return source
+}
+
+// ToProjectStats converts the namespace of a project along with its size statistics
+func (ns Namespace) ToProjectStats(clusterID string, releases int, pods []*corev1.Pod) *ProjectStats {
+	project := ns.ToProject()
+
+	var owner *string
+	if ns.Metadata.Annotations != nil {
+		if val, ok := (*ns.Metadata.Annotations)[ProjectOwnerAnnotation]; ok {
+			owner = &val
+		}
+	}
+
+	var status *_api.ProjectStatsStatus
+	if project.Status != nil {
+		s := _api.ProjectStatsStatus(*project.Status)
+		status = &s
+	}
+
+	cpu := resource.NewMilliQuantity(0, resource.DecimalSI)
+	memory := resource.NewQuantity(0, resource.BinarySI)
+	for _, pod := range pods {
+		for _, container := range pod.Spec.Containers {
+			if q, ok := container.Resources.Requests[corev1.ResourceCPU]; ok {
+				cpu.Add(q)
+			}
+			if q, ok := container.Resources.Requests[corev1.ResourceMemory]; ok {
+				memory.Add(q)
+			}
+		}
+	}
+
+	return &ProjectStats{
+		ClusterId:         clusterID,
+		Name:              project.Name,
+		DisplayName:       project.DisplayName,
+		Environment:       project.Environment,
+		Owner:             owner,
+		Status:            status,
+		CreationTimestamp: project.CreationTimestamp,
+		Releases:          releases,
+		Pods:              len(pods),
+		CpuRequests:       utils.EmptyToNil(cpu.String()),
+		MemoryRequests:    utils.EmptyToNil(memory.String()),
+	}
+}
@@ -0,0 +1,91 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package model
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/okdp/okdp-server/api/openapi/v3/_api"
+)
+
+type AuditEvent _api.AuditEvent
+type AuditEventAction = _api.AuditEventAction
+type AuditEventOutcome = _api.AuditEventOutcome
+
+const (
+	AuditCreate    = _api.AuditEventActionCreate
+	AuditUpdate    = _api.AuditEventActionUpdate
+	AuditPatch     = _api.AuditEventActionPatch
+	AuditDelete    = _api.AuditEventActionDelete
+	AuditExecStart = _api.AuditEventActionExecStart
+	AuditExecEnd   = _api.AuditEventActionExecEnd
+	AuditSuccess   = _api.AuditEventOutcomeSuccess
+	AuditFailure   = _api.AuditEventOutcomeFailure
+)
+
+// AuditFilter holds the criteria used to search the audit events
+type AuditFilter struct {
+	User      string
+	ClusterID string
+	Namespace string
+	Action    string
+	Outcome   string
+	From      *time.Time
+	To        *time.Time
+	Limit     int
+}
+
+// Matches returns true if the audit event satisfies all the criteria of the filter.
+// Empty criteria are ignored.
+func (e *AuditEvent) Matches(filter *AuditFilter) bool {
+	if filter == nil {
+		return true
+	}
+	if filter.User != "" &&
+		!strings.EqualFold(e.User.Subject, filter.User) &&
+		!strings.EqualFold(e.User.Email, filter.User) &&
+		(e.User.Login == nil || !strings.EqualFold(*e.User.Login, filter.User)) {
+		return false
+	}
+	if filter.ClusterID != "" && (e.ClusterId == nil || !strings.EqualFold(*e.ClusterId, filter.ClusterID)) {
+		return false
+	}
+	if filter.Namespace != "" && (e.Namespace == nil || *e.Namespace != filter.Namespace) {
+		return false
+	}
+	if filter.Action != "" && string(e.Action) != filter.Action {
+		return false
+	}
+	if filter.Outcome != "" && string(e.Outcome) != filter.Outcome {
+		return false
+	}
+	if filter.From != nil && e.Timestamp.Before(*filter.From) {
+		return false
+	}
+	if filter.To != nil && e.Timestamp.After(*filter.To) {
+		return false
+	}
+	return true
+}
+
+func AuditNotEnabledError() *ServerResponse {
+	return NewServerResponse(OkdpServerResponse).
+		GenericError(http.StatusNotImplemented, "No queryable audit sink (file or database) is enabled.").
+		WithCode(ErrAuditNotEnabled)
+}
@@ -20,6 +20,7 @@ import (
 	"strings"
 
 	"github.com/okdp/okdp-server/api/openapi/v3/_api"
+	"github.com/okdp/okdp-server/internal/utils"
 )
 
 type Catalog struct {
@@ -28,6 +29,17 @@ type Catalog struct {
 
 type Package = _api.Package
 
+// PackageComparators are the sort keys of the packages
+var PackageComparators = map[string]utils.Comparator[*Package]{
+	"name": utils.CompareStrings(func(p *Package) string { return p.Name }),
+}
+
+// MatchesPackage checks whether the package matches the tag (version) and search filters
+func MatchesPackage(p *Package, options *ListOptions) bool {
+	return (options.Tag == "" || utils.Contains(p.Versions, options.Tag)) &&
+		utils.MatchesSearch(options.Search, p.Name)
+}
+
 func (c Catalog) RepoHost() string {
 	parts := strings.SplitN(c.RepoURL, "/", 2)
 	if len(parts) > 0 {
@@ -36,16 +48,29 @@ func (c Catalog) RepoHost() string {
 	return ""
 }
 
+// PackageRepository returns the OCI repository of the package, as referenced by the KuboCD releases
+func (c Catalog) PackageRepository(name string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(c.RepoURL, "oci://"), "/") + "/" + name
+}
+
 func (c Catalog) IsAuthenticated() bool {
 	return c.Credentials != nil
 }
 
+// PackageJSONSchema is the schema of a package version converted into standard JSON Schema documents
+type PackageJSONSchema struct {
+	Parameters map[string]interface{} `json:"parameters"`
+	Context    map[string]interface{} `json:"context"`
+}
+
 func CatalogNotFoundError(catalogID string) *ServerResponse {
 	return NewServerResponse(OkdpServerResponse).
-		NotFoundError("The catalog with id %s not found.", catalogID)
+		NotFoundError("The catalog with id %s not found.", catalogID).
+		WithCode(ErrCatalogNotFound)
 }
 
 func CatalogPackageNotFoundError(catalogID string, packageName string) *ServerResponse {
 	return NewServerResponse(OkdpServerResponse).
-		NotFoundError("The package %s not found in the catalog ID %s.", packageName, catalogID)
+		NotFoundError("The package %s not found in the catalog ID %s.", packageName, catalogID).
+		WithCode(ErrPackageNotFound)
 }
@@ -25,7 +25,8 @@ type Cluster _api.Cluster
 
 func ClusterNotFoundError(clusterID string) *ServerResponse {
 	return NewServerResponse(OkdpServerResponse).
-		NotFoundError("The cluster with id %s not found.", clusterID)
+		NotFoundError("The cluster with id %s not found.", clusterID).
+		WithCode(ErrClusterNotFound)
 }
 
 func (m Cluster) AuthType() string {
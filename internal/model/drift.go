@@ -0,0 +1,112 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package model
+
+import (
+	"sort"
+
+	"github.com/okdp/okdp-server/internal/utils"
+)
+
+const (
+	DriftInSync           = "InSync"
+	DriftModified         = "Modified"
+	DriftMissingInCluster = "MissingInCluster"
+	DriftMissingInGit     = "MissingInGit"
+)
+
+// Drift compares the releases of a git repo (desired state) with the releases deployed on the cluster (live state)
+type Drift struct {
+	Kustomization       string          `json:"kustomization"`
+	Namespace           string          `json:"namespace"`
+	GitRevision         string          `json:"gitRevision"`
+	LastAppliedRevision string          `json:"lastAppliedRevision"`
+	RevisionInSync      bool            `json:"revisionInSync"`
+	InSync              bool            `json:"inSync"`
+	Releases            []*ReleaseDrift `json:"releases"`
+}
+
+// ReleaseDrift is the drift of a release, the differences go from the git repo to the cluster
+type ReleaseDrift struct {
+	Name        string            `json:"name"`
+	Namespace   string            `json:"namespace"`
+	Status      string            `json:"status"`
+	Differences []utils.FieldDiff `json:"differences,omitempty"`
+}
+
+// NewDrift pairs the releases of the git repo with the live ones by namespace/name.
+// The live releases are expected to be the ones applied by the kustomization.
+func NewDrift(kustomization string, namespace string, gitRevision string, lastAppliedRevision string, desired []*Release, live []*Release) (*Drift, *ServerResponse) {
+	type key struct{ namespace, name string }
+	liveByKey := map[key]*Release{}
+	for _, release := range live {
+		liveByKey[key{release.Namespace, release.Name}] = release
+	}
+
+	drift := &Drift{
+		Kustomization:       kustomization,
+		Namespace:           namespace,
+		GitRevision:         gitRevision,
+		LastAppliedRevision: lastAppliedRevision,
+		RevisionInSync:      gitRevision != "" && gitRevision == utils.RevisionCommit(lastAppliedRevision),
+		Releases:            []*ReleaseDrift{},
+	}
+
+	for _, release := range desired {
+		k := key{release.Namespace, release.Name}
+		liveRelease, found := liveByKey[k]
+		if !found {
+			drift.Releases = append(drift.Releases, &ReleaseDrift{Name: k.name, Namespace: k.namespace, Status: DriftMissingInCluster})
+			continue
+		}
+		delete(liveByKey, k)
+
+		diffs, err := utils.DiffObjects(specOf(release), specOf(liveRelease))
+		if err != nil {
+			return nil, NewServerResponse(OkdpServerResponse).
+				UnprocessableEntity("Unable to compare the release '%s/%s' with the cluster: %v", k.namespace, k.name, err)
+		}
+		status := DriftInSync
+		if len(diffs) > 0 {
+			status = DriftModified
+		}
+		drift.Releases = append(drift.Releases, &ReleaseDrift{Name: k.name, Namespace: k.namespace, Status: status, Differences: diffs})
+	}
+
+	for k := range liveByKey {
+		drift.Releases = append(drift.Releases, &ReleaseDrift{Name: k.name, Namespace: k.namespace, Status: DriftMissingInGit})
+	}
+
+	sort.Slice(drift.Releases, func(i, j int) bool {
+		if drift.Releases[i].Namespace != drift.Releases[j].Namespace {
+			return drift.Releases[i].Namespace < drift.Releases[j].Namespace
+		}
+		return drift.Releases[i].Name < drift.Releases[j].Name
+	})
+
+	drift.InSync = drift.RevisionInSync
+	for _, release := range drift.Releases {
+		drift.InSync = drift.InSync && release.Status == DriftInSync
+	}
+
+	return drift, nil
+}
+
+// specOf returns the spec of the release, nested under 'spec' for the paths of the differences
+func specOf(release *Release) map[string]interface{} {
+	return map[string]interface{}{"spec": release.Spec}
+}
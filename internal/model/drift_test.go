@@ -0,0 +1,139 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kubocdv1alpha1 "kubocd/api/v1alpha1"
+
+	"github.com/okdp/okdp-server/internal/utils"
+)
+
+func newDriftRelease(namespace string, name string, tag string) *Release {
+	return &Release{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec: kubocdv1alpha1.ReleaseSpec{
+			Package: kubocdv1alpha1.PackageSource{Repository: "quay.io/kubocd/packages/podinfo", Tag: tag},
+		},
+	}
+}
+
+func TestNewDrift(t *testing.T) {
+	tests := []struct {
+		name                string
+		gitRevision         string
+		lastAppliedRevision string
+		desired             []*Release
+		live                []*Release
+		statuses            map[string]string
+		revisionInSync      bool
+		inSync              bool
+	}{
+		{
+			name:                "in sync",
+			gitRevision:         "6c2709d1b2",
+			lastAppliedRevision: "main@sha1:6c2709d1b2",
+			desired:             []*Release{newDriftRelease("team-a", "podinfo", "6.7.1-p01")},
+			live:                []*Release{newDriftRelease("team-a", "podinfo", "6.7.1-p01")},
+			statuses:            map[string]string{"team-a/podinfo": DriftInSync},
+			revisionInSync:      true,
+			inSync:              true,
+		},
+		{
+			name:                "modified",
+			gitRevision:         "6c2709d1b2",
+			lastAppliedRevision: "main@sha1:6c2709d1b2",
+			desired:             []*Release{newDriftRelease("team-a", "podinfo", "6.7.1-p01")},
+			live:                []*Release{newDriftRelease("team-a", "podinfo", "6.7.0-p01")},
+			statuses:            map[string]string{"team-a/podinfo": DriftModified},
+			revisionInSync:      true,
+		},
+		{
+			name:                "missing in cluster and in git",
+			gitRevision:         "6c2709d1b2",
+			lastAppliedRevision: "main@sha1:6c2709d1b2",
+			desired:             []*Release{newDriftRelease("team-a", "podinfo", "6.7.1-p01")},
+			live:                []*Release{newDriftRelease("team-a", "redis", "7.2.4-p01")},
+			statuses:            map[string]string{"team-a/podinfo": DriftMissingInCluster, "team-a/redis": DriftMissingInGit},
+			revisionInSync:      true,
+		},
+		{
+			name:                "paired by namespace and name",
+			gitRevision:         "6c2709d1b2",
+			lastAppliedRevision: "main@sha1:6c2709d1b2",
+			desired:             []*Release{newDriftRelease("team-a", "podinfo", "6.7.1-p01"), newDriftRelease("default", "podinfo", "6.7.1-p01")},
+			live:                []*Release{newDriftRelease("default", "podinfo", "6.7.1-p01"), newDriftRelease("team-b", "podinfo", "6.7.1-p01")},
+			statuses: map[string]string{
+				"default/podinfo": DriftInSync,
+				"team-a/podinfo":  DriftMissingInCluster,
+				"team-b/podinfo":  DriftMissingInGit,
+			},
+			revisionInSync: true,
+		},
+		{
+			name:                "revision not applied",
+			gitRevision:         "9f0e1a2b3c",
+			lastAppliedRevision: "main@sha1:6c2709d1b2",
+			desired:             []*Release{newDriftRelease("team-a", "podinfo", "6.7.1-p01")},
+			live:                []*Release{newDriftRelease("team-a", "podinfo", "6.7.1-p01")},
+			statuses:            map[string]string{"team-a/podinfo": DriftInSync},
+		},
+		{
+			name:     "unknown revision",
+			statuses: map[string]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// When
+			drift, err := NewDrift("releases-system", "flux-system", tt.gitRevision, tt.lastAppliedRevision, tt.desired, tt.live)
+
+			// Then
+			require.Nil(t, err)
+			statuses := map[string]string{}
+			for _, release := range drift.Releases {
+				statuses[release.Namespace+"/"+release.Name] = release.Status
+			}
+			assert.Equal(t, tt.statuses, statuses)
+			assert.Equal(t, tt.revisionInSync, drift.RevisionInSync)
+			assert.Equal(t, tt.inSync, drift.InSync)
+		})
+	}
+}
+
+func TestNewDrift_Differences(t *testing.T) {
+	// Given
+	desired := []*Release{newDriftRelease("team-a", "podinfo", "6.7.1-p01"), newDriftRelease("default", "redis", "7.2.4-p01")}
+	live := []*Release{newDriftRelease("team-a", "podinfo", "6.7.0-p01")}
+
+	// When
+	drift, err := NewDrift("releases-system", "flux-system", "6c2709d1b2", "main@sha1:6c2709d1b2", desired, live)
+
+	// Then
+	require.Nil(t, err)
+	require.Len(t, drift.Releases, 2)
+	assert.Equal(t, "default", drift.Releases[0].Namespace, "the releases are sorted by namespace and name")
+	assert.Empty(t, drift.Releases[0].Differences)
+	assert.Equal(t, []utils.FieldDiff{
+		{Path: "spec.package.tag", Op: utils.DiffReplace, From: "6.7.1-p01", To: "6.7.0-p01"},
+	}, drift.Releases[1].Differences, "the differences go from the git repo to the cluster")
+}
@@ -0,0 +1,88 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package model
+
+// ErrorCode is a stable machine-readable error code returned in the server responses
+type ErrorCode string
+
+const (
+	ErrBadRequest         ErrorCode = "BAD_REQUEST"
+	ErrValidationFailed   ErrorCode = "VALIDATION_FAILED"
+	ErrUnauthorized       ErrorCode = "UNAUTHORIZED"
+	ErrForbidden          ErrorCode = "FORBIDDEN"
+	ErrNotFound           ErrorCode = "NOT_FOUND"
+	ErrConflict           ErrorCode = "CONFLICT"
+	ErrUnprocessable      ErrorCode = "UNPROCESSABLE_ENTITY"
+	ErrInternal           ErrorCode = "INTERNAL_ERROR"
+	ErrNotImplemented     ErrorCode = "NOT_IMPLEMENTED"
+	ErrTooManyRequests    ErrorCode = "TOO_MANY_REQUESTS"
+	ErrUnavailable        ErrorCode = "SERVICE_UNAVAILABLE"
+	ErrGatewayTimeout     ErrorCode = "GATEWAY_TIMEOUT"
+	ErrInvalidResponse    ErrorCode = "INVALID_RESPONSE"
+	ErrContinueExpired    ErrorCode = "CONTINUE_EXPIRED"
+	ErrPreconditionFailed ErrorCode = "PRECONDITION_FAILED"
+	ErrUnsupportedMedia   ErrorCode = "UNSUPPORTED_MEDIA_TYPE"
+	ErrPatchFailed        ErrorCode = "PATCH_FAILED"
+	ErrWatchExpired       ErrorCode = "WATCH_EXPIRED"
+
+	ErrClusterNotFound         ErrorCode = "CLUSTER_NOT_FOUND"
+	ErrNamespaceNotFound       ErrorCode = "NAMESPACE_NOT_FOUND"
+	ErrNamespaceAlreadyExists  ErrorCode = "NAMESPACE_ALREADY_EXISTS"
+	ErrCatalogNotFound         ErrorCode = "CATALOG_NOT_FOUND"
+	ErrPackageNotFound         ErrorCode = "PACKAGE_NOT_FOUND"
+	ErrReleaseNotFound         ErrorCode = "RELEASE_NOT_FOUND"
+	ErrReleaseAlreadyExists    ErrorCode = "RELEASE_ALREADY_EXISTS"
+	ErrReleaseConflict         ErrorCode = "RELEASE_CONFLICT"
+	ErrReleaseInvalid          ErrorCode = "RELEASE_INVALID"
+	ErrRevisionNotFound        ErrorCode = "REVISION_NOT_FOUND"
+	ErrResourceSuspended       ErrorCode = "RESOURCE_SUSPENDED"
+	ErrGitRepoNotFound         ErrorCode = "GIT_REPO_NOT_FOUND"
+	ErrGitPushRejected         ErrorCode = "GIT_PUSH_REJECTED"
+	ErrGitAuthFailed           ErrorCode = "GIT_AUTH_FAILED"
+	ErrGit                     ErrorCode = "GIT_ERROR"
+	ErrKubernetes              ErrorCode = "KUBERNETES_ERROR"
+	ErrRegistryUnauthorized    ErrorCode = "REGISTRY_UNAUTHORIZED"
+	ErrRegistryDenied          ErrorCode = "REGISTRY_DENIED"
+	ErrRegistryNotFound        ErrorCode = "REGISTRY_NOT_FOUND"
+	ErrRegistryTooManyRequests ErrorCode = "REGISTRY_TOO_MANY_REQUESTS"
+	ErrRegistry                ErrorCode = "REGISTRY_ERROR"
+	ErrAuditNotEnabled         ErrorCode = "AUDIT_NOT_ENABLED"
+)
+
+// ErrorDetail is a field-level detail of an error, it has the same definition as the _api.ServerResponse details items
+type ErrorDetail = struct {
+	// Field Path of the invalid field
+	Field *string `json:"field,omitempty"`
+
+	// Message Human readable description of the error on the field
+	Message string `json:"message"`
+
+	// Reason Machine-readable reason of the error on the field
+	Reason *string `json:"reason,omitempty"`
+}
+
+// NewErrorDetail creates a field-level error detail, the field and the reason are optional
+func NewErrorDetail(field, reason, message string) ErrorDetail {
+	detail := ErrorDetail{Message: message}
+	if field != "" {
+		detail.Field = &field
+	}
+	if reason != "" {
+		detail.Reason = &reason
+	}
+	return detail
+}
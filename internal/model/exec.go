@@ -0,0 +1,62 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package model
+
+import (
+	"io"
+)
+
+// The types of the messages exchanged over the pod exec WebSocket
+const (
+	ExecStdin  = "stdin"
+	ExecResize = "resize"
+	ExecStdout = "stdout"
+	ExecStderr = "stderr"
+	ExecExit   = "exit"
+	ExecError  = "error"
+)
+
+// DefaultExecCommand is the command run by the exec sessions when none is given
+var DefaultExecCommand = []string{"/bin/sh"}
+
+// ExecMessage is a message of the pod exec WebSocket: the client sends the stdin data and the terminal sizes,
+// the server sends the stdout and stderr data, then the exit code of the command or the error which ended the session
+type ExecMessage struct {
+	Type    string `json:"type"`
+	Data    string `json:"data,omitempty"`
+	Cols    uint16 `json:"cols,omitempty"`
+	Rows    uint16 `json:"rows,omitempty"`
+	Code    *int   `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// TerminalSize is the size of the terminal of a TTY exec session
+type TerminalSize struct {
+	Cols uint16
+	Rows uint16
+}
+
+// ExecOptions are the command and the streams attached to an exec session.
+// The stdin is not attached when nil, the resize channel is closed at the end of the session.
+type ExecOptions struct {
+	Command []string
+	TTY     bool
+	Stdin   io.Reader
+	Stdout  io.Writer
+	Stderr  io.Writer
+	Resize  <-chan TerminalSize
+}
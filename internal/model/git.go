@@ -35,25 +35,64 @@ type GitContent struct {
 	Content *[]byte
 	Path    string
 	URL     string
+	// SHA is the git blob hash of the content, used as ETag
+	SHA string
+}
+
+// GitFileChange is the new content of a file, IfMatch is the expected git blob hash of its current content
+type GitFileChange struct {
+	Path    string
+	Content string
+	IfMatch string
+}
+
+// GitFileRevision is the content of a file as committed by a commit of the git repo
+type GitFileRevision struct {
+	GitContent
+	Commit    string
+	Author    string
+	Email     string
+	Message   string
+	Timestamp time.Time
+}
+
+// ToReleaseRevision converts the file revision into the given revision of the release
+func (r GitFileRevision) ToReleaseRevision(revision int) (*ReleaseRevision, *ServerResponse) {
+	release, err := r.ToRelease()
+	if err != nil {
+		return nil, err
+	}
+	return &ReleaseRevision{
+		Revision:  revision,
+		Timestamp: r.Timestamp,
+		Author:    r.Author,
+		Email:     r.Email,
+		Message:   r.Message,
+		Commit:    r.Commit,
+		Release:   release.SanitizeMetadata().SanitizeStatus(),
+	}, nil
 }
 
 func (c GitContent) ToRelease() (*Release, *ServerResponse) {
 	var release Release
 	if err := yaml.Unmarshal(*c.Content, &release); err != nil {
 		return nil, NewServerResponse(GitRepoResponse).
-			UnprocessableEntity("Failed to convert yaml file content '%s' into KuboCD Release: %v", c.Path, err)
+			UnprocessableEntity("Failed to convert yaml file content '%s' into KuboCD Release: %v", c.Path, err).
+			WithCode(ErrReleaseInvalid)
 	}
 	return &release, nil
 }
 
 func RepoNotFoundError(clusterID string, namespace string, fluxrepo string) *ServerResponse {
 	return NewServerResponse(OkdpServerResponse).
-		NotFoundError("The git repo %s not found on namespace %s with cluster id %s.", fluxrepo, namespace, clusterID)
+		NotFoundError("The git repo %s not found on namespace %s with cluster id %s.", fluxrepo, namespace, clusterID).
+		WithCode(ErrGitRepoNotFound)
 }
 
 func KuboCDGitReleaseNotFoundError(clusterID string, namespace string, fluxrepo string, releaseName string) *ServerResponse {
 	return NewServerResponse(OkdpServerResponse).
-		NotFoundError("Unable to find KuboCD release '%s' in the git repo referenced by fluxcd repo %s/%s (clusterID: %s).", releaseName, namespace, fluxrepo, clusterID)
+		NotFoundError("Unable to find KuboCD release '%s' in the git repo referenced by fluxcd repo %s/%s (clusterID: %s).", releaseName, namespace, fluxrepo, clusterID).
+		WithCode(ErrReleaseNotFound)
 }
 
 func NewGitCommitOptions(message string) *GitCommit {
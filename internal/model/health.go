@@ -0,0 +1,154 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package model
+
+import (
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	HealthHealthy     = "Healthy"
+	HealthProgressing = "Progressing"
+	HealthDegraded    = "Degraded"
+	HealthFailed      = "Failed"
+	HealthSuspended   = "Suspended"
+)
+
+// MaxHealthEvents is the number of most recent warning events reported in the health of a release
+const MaxHealthEvents = 5
+
+// healthSeverity orders the health statuses from the best to the worst
+var healthSeverity = map[string]int{
+	HealthHealthy:     0,
+	HealthSuspended:   1,
+	HealthProgressing: 2,
+	HealthDegraded:    3,
+	HealthFailed:      4,
+}
+
+// ReleaseHealth rolls the phase, the pods, the events and the last reconcile of a release up into a single status
+type ReleaseHealth struct {
+	Name                string         `json:"name"`
+	Namespace           string         `json:"namespace"`
+	Status              string         `json:"status"`
+	Phase               string         `json:"phase"`
+	Reasons             []string       `json:"reasons"`
+	Pods                int            `json:"pods"`
+	ReadyPods           int            `json:"readyPods"`
+	Restarts            int32          `json:"restarts"`
+	UnreadyPods         []*UnreadyPod  `json:"unreadyPods"`
+	WarningEvents       []*HealthEvent `json:"warningEvents"`
+	LastReconciled      *time.Time     `json:"lastReconciled,omitempty"`
+	SinceLastReconciled string         `json:"sinceLastReconciled,omitempty"`
+}
+
+// UnreadyPod is a pod of the release which is not ready, the reason is the first waiting or terminated container reason
+type UnreadyPod struct {
+	Name     string `json:"name"`
+	Health   string `json:"health"`
+	Reason   string `json:"reason,omitempty"`
+	Restarts int32  `json:"restarts"`
+}
+
+// HealthEvent is a warning event involving the release or one of its pods
+type HealthEvent struct {
+	Kind     string    `json:"kind"`
+	Name     string    `json:"name"`
+	Reason   string    `json:"reason"`
+	Message  string    `json:"message"`
+	Count    int32     `json:"count"`
+	LastSeen time.Time `json:"lastSeen"`
+}
+
+// ProjectHealth is the health of the releases of a project, the status is the worst of the releases
+type ProjectHealth struct {
+	Project  string           `json:"project"`
+	Status   string           `json:"status"`
+	Releases []*ReleaseHealth `json:"releases"`
+}
+
+func NewReleaseHealth(release *Release) *ReleaseHealth {
+	return &ReleaseHealth{
+		Name:          release.Name,
+		Namespace:     release.Namespace,
+		Status:        HealthHealthy,
+		Phase:         string(release.Status.Phase),
+		Reasons:       []string{},
+		UnreadyPods:   []*UnreadyPod{},
+		WarningEvents: []*HealthEvent{},
+	}
+}
+
+func NewHealthEvent(e *corev1.Event) *HealthEvent {
+	return &HealthEvent{
+		Kind:     e.InvolvedObject.Kind,
+		Name:     e.InvolvedObject.Name,
+		Reason:   e.Reason,
+		Message:  e.Message,
+		Count:    e.Count,
+		LastSeen: eventTime(e),
+	}
+}
+
+// WithWarningEvents keeps the most recent warning events
+func (h *ReleaseHealth) WithWarningEvents(events []*HealthEvent) *ReleaseHealth {
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].LastSeen.After(events[j].LastSeen)
+	})
+	if len(events) > MaxHealthEvents {
+		events = events[:MaxHealthEvents]
+	}
+	h.WarningEvents = events
+	return h
+}
+
+// WithLastReconciled sets the time of the last successful reconcile and the elapsed time since
+func (h *ReleaseHealth) WithLastReconciled(lastReconciled time.Time, now time.Time) *ReleaseHealth {
+	if lastReconciled.IsZero() {
+		return h
+	}
+	h.LastReconciled = &lastReconciled
+	h.SinceLastReconciled = now.Sub(lastReconciled).Round(time.Second).String()
+	return h
+}
+
+// NewProjectHealth returns the health of the project releases sorted by name
+func NewProjectHealth(project string, releases []*ReleaseHealth) *ProjectHealth {
+	sort.Slice(releases, func(i, j int) bool {
+		return releases[i].Name < releases[j].Name
+	})
+	status := HealthHealthy
+	for _, release := range releases {
+		status = WorstHealth(status, release.Status)
+	}
+	return &ProjectHealth{
+		Project:  project,
+		Status:   status,
+		Releases: releases,
+	}
+}
+
+// WorstHealth returns the most severe of the two health statuses
+func WorstHealth(a string, b string) string {
+	if healthSeverity[b] > healthSeverity[a] {
+		return b
+	}
+	return a
+}
@@ -0,0 +1,94 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package model
+
+import (
+	"slices"
+	"sort"
+
+	"github.com/okdp/okdp-server/api/openapi/v3/_api"
+	"github.com/okdp/okdp-server/internal/utils"
+)
+
+type PackageVersion _api.PackageVersion
+
+// Inventory is the multi-cluster inventory of the releases, projects or package versions
+type Inventory struct {
+	Clusters []*ClusterInventory `json:"clusters"`
+	Failures int                 `json:"failures"`
+}
+
+// ClusterInventory is the inventory of a cluster, only one of releases, projects or packages is set
+type ClusterInventory struct {
+	ClusterId string            `json:"clusterId"`
+	Env       *string           `json:"env,omitempty"`
+	Reachable bool              `json:"reachable"`
+	Error     *ServerResponse   `json:"error,omitempty"`
+	Releases  []*ReleaseSummary `json:"releases,omitempty"`
+	Projects  []*ProjectStats   `json:"projects,omitempty"`
+	Packages  []*PackageVersion `json:"packages,omitempty"`
+}
+
+// NewInventory groups the inventories of the clusters by environment and cluster ID
+func NewInventory(clusters []*ClusterInventory) *Inventory {
+	sort.SliceStable(clusters, func(i, j int) bool {
+		envI, envJ := utils.OrEmpty(clusters[i].Env), utils.OrEmpty(clusters[j].Env)
+		if envI != envJ {
+			return envI < envJ
+		}
+		return clusters[i].ClusterId < clusters[j].ClusterId
+	})
+
+	inventory := &Inventory{Clusters: clusters}
+	for _, cluster := range clusters {
+		if !cluster.Reachable {
+			inventory.Failures++
+		}
+	}
+	return inventory
+}
+
+// ToPackageVersions counts the releases (and their namespaces) by package repository and tag
+func ToPackageVersions(releases []*ReleaseSummary) []*PackageVersion {
+	type key struct{ repository, tag string }
+	versions := map[key]*PackageVersion{}
+	for _, release := range releases {
+		k := key{release.PackageRepository, release.PackageTag}
+		version, found := versions[k]
+		if !found {
+			version = &PackageVersion{PackageRepository: k.repository, PackageTag: k.tag, Namespaces: []string{}}
+			versions[k] = version
+		}
+		version.Releases++
+		if !slices.Contains(version.Namespaces, release.Namespace) {
+			version.Namespaces = append(version.Namespaces, release.Namespace)
+		}
+	}
+
+	result := make([]*PackageVersion, 0, len(versions))
+	for _, version := range versions {
+		slices.Sort(version.Namespaces)
+		result = append(result, version)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].PackageRepository != result[j].PackageRepository {
+			return result[i].PackageRepository < result[j].PackageRepository
+		}
+		return result[i].PackageTag < result[j].PackageTag
+	})
+	return result
+}
@@ -17,11 +17,15 @@
 package model
 
 import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/yaml"
 
 	kubocdv1alpha1 "kubocd/api/v1alpha1"
 
 	"github.com/okdp/okdp-server/api/openapi/v3/_api"
+	"github.com/okdp/okdp-server/internal/utils"
 )
 
 type Release kubocdv1alpha1.Release
@@ -63,9 +67,69 @@ func (r *Release) ToYAML() (string, error) {
 	return string(data), nil
 }
 
+// ReleaseComparators are the sort keys of the releases
+var ReleaseComparators = map[string]utils.Comparator[*Release]{
+	"name":              utils.CompareStrings(func(r *Release) string { return r.Name }),
+	"namespace":         utils.CompareStrings(func(r *Release) string { return r.Namespace }),
+	"creationTimestamp": utils.CompareTimes(func(r *Release) time.Time { return r.CreationTimestamp.Time }),
+	"phase":             utils.CompareStrings(func(r *Release) string { return string(r.Status.Phase) }),
+	"package":           utils.CompareStrings(func(r *Release) string { return r.Spec.Package.Repository }),
+	"tag":               utils.CompareStrings(func(r *Release) string { return r.Spec.Package.Tag }),
+}
+
+// Matches checks whether the release matches the label selector, the status (phase), package, tag and search filters
+func (r *Release) Matches(options *ListOptions) bool {
+	return options.MatchesLabels(r.Labels) &&
+		utils.MatchesFilter(options.Status, string(r.Status.Phase)) &&
+		utils.MatchesSearch(options.Package, r.Spec.Package.Repository) &&
+		utils.MatchesVersion(options.Tag, r.Spec.Package.Tag) &&
+		utils.MatchesSearch(options.Search, r.Name, r.Spec.Description, r.Status.PrintDescription)
+}
+
+// ReleaseInfoComparators are the sort keys of the releases of a git repository
+var ReleaseInfoComparators = map[string]utils.Comparator[*ReleaseInfo]{
+	"name":      utils.CompareStrings(func(r *ReleaseInfo) string { return r.Name }),
+	"namespace": utils.CompareStrings(func(r *ReleaseInfo) string { return utils.OrEmpty(r.Namespace) }),
+	"package":   utils.CompareStrings(func(r *ReleaseInfo) string { return r.Package.Repository }),
+	"tag":       utils.CompareStrings(func(r *ReleaseInfo) string { return r.Package.Tag }),
+}
+
+// Matches checks whether the git repository release matches the package, tag and search filters
+func (r *ReleaseInfo) Matches(options *ListOptions) bool {
+	return utils.MatchesSearch(options.Package, r.Package.Repository) &&
+		utils.MatchesVersion(options.Tag, r.Package.Tag) &&
+		utils.MatchesSearch(options.Search, r.Name, utils.OrEmpty(r.Description))
+}
+
+// EventComparators are the sort keys of the release events
+var EventComparators = map[string]utils.Comparator[*corev1.Event]{
+	"lastTimestamp": utils.CompareTimes(eventTime),
+	"type":          utils.CompareStrings(func(e *corev1.Event) string { return e.Type }),
+	"reason":        utils.CompareStrings(func(e *corev1.Event) string { return e.Reason }),
+}
+
+// MatchesEvent checks whether the event matches the type (status) and search filters
+func MatchesEvent(event *corev1.Event, options *ListOptions) bool {
+	return utils.MatchesFilter(options.Status, event.Type) &&
+		utils.MatchesSearch(options.Search, event.Reason, event.Message)
+}
+
+// eventTime returns the last time the event occurred
+func eventTime(e *corev1.Event) time.Time {
+	switch {
+	case !e.LastTimestamp.IsZero():
+		return e.LastTimestamp.Time
+	case !e.EventTime.IsZero():
+		return e.EventTime.Time
+	default:
+		return e.FirstTimestamp.Time
+	}
+}
+
 func KuboCDReleaseNotFoundError(clusterID string, namespace string, releaseName string) *ServerResponse {
 	return NewServerResponse(OkdpServerResponse).
-		NotFoundError("Unable to find KuboCD release '%s' in the kubernetes cluster '%s' on the namespace '%s'.", releaseName, clusterID, namespace)
+		NotFoundError("Unable to find KuboCD release '%s' in the kubernetes cluster '%s' on the namespace '%s'.", releaseName, clusterID, namespace).
+		WithCode(ErrReleaseNotFound)
 }
 
 func KuboCDReleaseCreated(clusterID string, namespace string, releaseName string) *ServerResponse {
@@ -0,0 +1,115 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package model
+
+import (
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/okdp/okdp-server/internal/utils"
+)
+
+// ListOptions are the pagination, filtering and sorting options of the list endpoints
+type ListOptions struct {
+	// Limit is the maximum number of items of a page, all the items are returned when not positive
+	Limit int
+	// Cursor is the position of the requested page
+	Cursor utils.Cursor
+	// Sort is the sort key, prefixed by '-' for a descending order
+	Sort string
+	// Search is a case insensitive text search
+	Search string
+	// Selector is the Kubernetes label selector, never nil
+	Selector labels.Selector
+	// Status, Package and Tag are the resource specific field filters
+	Status  string
+	Package string
+	Tag     string
+}
+
+// ListResult is a page of a list along with the total number of the items matching the filters
+type ListResult[T any] struct {
+	Items []T
+	// Total is nil when unknown (paginated by the Kubernetes API server without remaining item count)
+	Total *int
+	// Continue is the cursor of the next page, empty on the last page
+	Continue string
+}
+
+func NewListOptions(limit *int, continueToken, sort, search, labelSelector string) (*ListOptions, *ServerResponse) {
+	cursor, err := utils.DecodeCursor(continueToken)
+	if err != nil {
+		return nil, InvalidListOptionError("query.continue", err.Error())
+	}
+
+	selector, err := labels.Parse(labelSelector)
+	if err != nil {
+		return nil, InvalidListOptionError("query.labelSelector", err.Error())
+	}
+
+	options := &ListOptions{
+		Cursor:   cursor,
+		Sort:     sort,
+		Search:   search,
+		Selector: selector,
+	}
+	if limit != nil {
+		options.Limit = *limit
+	}
+	return options, nil
+}
+
+// IsFiltered reports whether the options need the complete list of the items to be applied,
+// i.e. the list can not be paginated by the Kubernetes API server
+func (o *ListOptions) IsFiltered() bool {
+	return o.Sort != "" || o.Search != "" || o.Status != "" || o.Package != "" || o.Tag != ""
+}
+
+// List filters, sorts and paginates the items in memory
+func List[T any](items []T, options *ListOptions, match func(T) bool, comparators map[string]utils.Comparator[T]) (*ListResult[T], *ServerResponse) {
+	filtered := make([]T, 0, len(items))
+	for _, item := range items {
+		if match == nil || match(item) {
+			filtered = append(filtered, item)
+		}
+	}
+
+	if err := utils.SortBy(filtered, options.Sort, comparators); err != nil {
+		return nil, InvalidListOptionError("query.sort", err.Error())
+	}
+
+	total := len(filtered)
+	page, next := utils.Paginate(filtered, options.Cursor, options.Limit)
+	result := &ListResult[T]{
+		Items: page,
+		Total: &total,
+	}
+	if next != nil {
+		result.Continue = next.Encode()
+	}
+	return result, nil
+}
+
+// MatchesLabels checks whether the labels match the label selector of the options
+func (o *ListOptions) MatchesLabels(objectLabels map[string]string) bool {
+	return o.Selector == nil || o.Selector.Matches(labels.Set(objectLabels))
+}
+
+func InvalidListOptionError(field string, message string) *ServerResponse {
+	return NewServerResponse(OkdpServerResponse).
+		BadRequest("Invalid list option '%s': %s", field, message).
+		WithDetails(NewErrorDetail(field, "invalid", message))
+}
@@ -22,6 +22,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 
 	"github.com/okdp/okdp-server/api/openapi/v3/_api"
+	"github.com/okdp/okdp-server/internal/utils"
 )
 
 type Namespace _api.Namespace
@@ -58,6 +59,48 @@ func (ns Namespace) ToProject() *Project {
 	}
 }
 
+// NamespaceComparators are the sort keys of the namespaces (projects)
+var NamespaceComparators = map[string]utils.Comparator[*Namespace]{
+	"name":              utils.CompareStrings(func(ns *Namespace) string { return ns.Metadata.Name }),
+	"creationTimestamp": utils.CompareTimes(func(ns *Namespace) time.Time { return orZero(ns.Metadata.CreationTimestamp) }),
+}
+
+// Matches checks whether the namespace matches the label selector, the status (phase) and the search
+// filters. The search applies on the name and on the project display name and description.
+func (ns *Namespace) Matches(options *ListOptions) bool {
+	annotations := map[string]string{}
+	if ns.Metadata.Annotations != nil {
+		annotations = *ns.Metadata.Annotations
+	}
+	var phase string
+	if ns.Status != nil && ns.Status.Phase != nil {
+		phase = string(*ns.Status.Phase)
+	}
+	return options.MatchesLabels(ns.GetLabels()) &&
+		utils.MatchesFilter(options.Status, phase) &&
+		utils.MatchesSearch(options.Search, ns.Metadata.Name, annotations["okdp.io/display-name"], annotations["okdp.io/description"])
+}
+
+// GetLabels returns the labels of the namespace, nil if none
+func (ns *Namespace) GetLabels() map[string]string {
+	if ns.Metadata.Labels == nil {
+		return nil
+	}
+	return *ns.Metadata.Labels
+}
+
+// WithOwner sets the owner annotation of the project namespace
+func (ns *Namespace) WithOwner(owner string) *Namespace {
+	if owner == "" {
+		return ns
+	}
+	if ns.Metadata.Annotations == nil {
+		ns.Metadata.Annotations = &map[string]string{}
+	}
+	(*ns.Metadata.Annotations)[ProjectOwnerAnnotation] = owner
+	return ns
+}
+
 func ToNamespace(ns corev1.Namespace) *Namespace {
 	meta := ns.ObjectMeta
 
@@ -69,8 +112,10 @@ func ToNamespace(ns corev1.Namespace) *Namespace {
 			CreationTimestamp *time.Time         `json:"creationTimestamp,omitempty"`
 			Labels            *map[string]string `json:"labels,omitempty"`
 			Name              string             `json:"name"`
+			ResourceVersion   *string            `json:"resourceVersion,omitempty"`
 		}{
-			Name: meta.Name,
+			Name:            meta.Name,
+			ResourceVersion: utils.EmptyToNil(meta.ResourceVersion),
 		},
 	}
 
@@ -0,0 +1,65 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package model
+
+import (
+	"net/http"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/okdp/okdp-server/internal/utils"
+)
+
+// Patch is a JSON Patch (RFC 6902) or a JSON Merge Patch (RFC 7386) document
+type Patch struct {
+	Type types.PatchType
+	Data []byte
+}
+
+// NewPatch returns the patch document sent with the given content type
+func NewPatch(contentType string, data []byte) (*Patch, *ServerResponse) {
+	patchType := types.PatchType(contentType)
+	if !utils.IsSupportedPatch(patchType) {
+		return nil, NewServerResponse(OkdpServerResponse).
+			GenericError(http.StatusUnsupportedMediaType, "Unsupported patch content type '%s', expected '%s' or '%s'", contentType, types.JSONPatchType, types.MergePatchType)
+	}
+	if len(data) == 0 {
+		return nil, NewServerResponse(OkdpServerResponse).
+			BadRequest("The patch document is empty")
+	}
+	return &Patch{Type: patchType, Data: data}, nil
+}
+
+// ApplyTo applies the patch to the JSON representation of obj and unmarshals the result into out
+func (p *Patch) ApplyTo(obj interface{}, out interface{}) *ServerResponse {
+	if err := utils.ApplyPatch(obj, p.Type, p.Data, out); err != nil {
+		return NewServerResponse(OkdpServerResponse).
+			UnprocessableEntity("Unable to apply the patch (%s): %v", p.Type, err).
+			WithCode(ErrPatchFailed)
+	}
+	return nil
+}
+
+// WithResourceVersion returns a copy of the patch conditioned by the Kubernetes resource version
+func (p *Patch) WithResourceVersion(resourceVersion string) (*Patch, *ServerResponse) {
+	data, err := utils.WithResourceVersion(p.Type, p.Data, resourceVersion)
+	if err != nil {
+		return nil, NewServerResponse(OkdpServerResponse).
+			BadRequest("Invalid patch document (%s): %v", p.Type, err)
+	}
+	return &Patch{Type: p.Type, Data: data}, nil
+}
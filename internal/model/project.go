@@ -24,6 +24,9 @@ import (
 
 type Project _api.Project
 
+// ProjectOwnerAnnotation holds the email (or login) of the user who created the project
+const ProjectOwnerAnnotation = "okdp.io/owner"
+
 func (p Project) ToNamespace() *Namespace {
 	labels := map[string]string{
 		"okdp.io/project": "true",
@@ -48,6 +51,7 @@ func (p Project) ToNamespace() *Namespace {
 			CreationTimestamp *time.Time         `json:"creationTimestamp,omitempty"`
 			Labels            *map[string]string `json:"labels,omitempty"`
 			Name              string             `json:"name"`
+			ResourceVersion   *string            `json:"resourceVersion,omitempty"`
 		}{
 			Name:              p.Name,
 			Labels:            &labels,
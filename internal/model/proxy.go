@@ -0,0 +1,31 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package model
+
+// The kinds of the targets of the release proxy, as named in the proxy route
+const (
+	ProxyServices = "services"
+	ProxyPods     = "pods"
+)
+
+// ProxyTarget is the port (number) of a service or a pod of a release reached through the API server proxy
+type ProxyTarget struct {
+	Kind      string
+	Namespace string
+	Name      string
+	Port      string
+}
@@ -0,0 +1,87 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package model
+
+import (
+	"sort"
+	"time"
+)
+
+// ResourceTree is the graph of the Kubernetes resources created by a release, the nodes reference their parents
+type ResourceTree struct {
+	Release ResourceRef     `json:"release"`
+	Nodes   []*ResourceNode `json:"nodes"`
+}
+
+// ResourceRef identifies a Kubernetes resource
+type ResourceRef struct {
+	Group     string `json:"group"`
+	Version   string `json:"version"`
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	UID       string `json:"uid"`
+}
+
+// ResourceNode is a resource of the tree, the health is not set for the resources without health (ConfigMaps, Secrets, ...)
+type ResourceNode struct {
+	ResourceRef
+	Health     string         `json:"health,omitempty"`
+	Status     string         `json:"status,omitempty"`
+	CreatedAt  time.Time      `json:"createdAt"`
+	ParentRefs []*ResourceRef `json:"parentRefs"`
+}
+
+// resourceKindOrder orders the nodes from the release down to the pods
+var resourceKindOrder = map[string]int{
+	"Release":               0,
+	"HelmRelease":           1,
+	"Secret":                2,
+	"ConfigMap":             3,
+	"PersistentVolumeClaim": 4,
+	"Service":               5,
+	"Ingress":               6,
+	"CronJob":               7,
+	"Deployment":            8,
+	"StatefulSet":           9,
+	"DaemonSet":             10,
+	"Job":                   11,
+	"ReplicaSet":            12,
+	"Pod":                   13,
+}
+
+func NewResourceTree(release ResourceRef) *ResourceTree {
+	return &ResourceTree{
+		Release: release,
+		Nodes:   []*ResourceNode{},
+	}
+}
+
+// Sort sorts the nodes by kind, from the release down to the pods, then by namespace and name
+func (t *ResourceTree) Sort() *ResourceTree {
+	sort.SliceStable(t.Nodes, func(i, j int) bool {
+		a, b := t.Nodes[i], t.Nodes[j]
+		if resourceKindOrder[a.Kind] != resourceKindOrder[b.Kind] {
+			return resourceKindOrder[a.Kind] < resourceKindOrder[b.Kind]
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+	return t
+}
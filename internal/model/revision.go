@@ -0,0 +1,88 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// MaxReleaseRevisions is the number of revisions kept in the history of the releases deployed on the clusters
+const MaxReleaseRevisions = 10
+
+// ReleaseRevision is a previous (or the current) spec of a release
+type ReleaseRevision struct {
+	Revision  int       `json:"revision"`
+	Timestamp time.Time `json:"timestamp"`
+	Author    string    `json:"author,omitempty"`
+	Email     string    `json:"email,omitempty"`
+	Message   string    `json:"message,omitempty"`
+	Commit    string    `json:"commit,omitempty"`
+	Release   *Release  `json:"release,omitempty"`
+}
+
+// NewReleaseRevision returns a revision of the release, the revision number is set when it is recorded
+func NewReleaseRevision(release *Release, author string, email string, message string) *ReleaseRevision {
+	return &ReleaseRevision{
+		Timestamp: time.Now().UTC(),
+		Author:    author,
+		Email:     email,
+		Message:   message,
+		Release:   release.Snapshot(),
+	}
+}
+
+// Snapshot returns a copy of the release limited to its identity, labels, annotations and spec
+func (r *Release) Snapshot() *Release {
+	return &Release{
+		TypeMeta: r.TypeMeta,
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        r.Name,
+			Namespace:   r.Namespace,
+			Labels:      r.Labels,
+			Annotations: r.Annotations,
+		},
+		Spec: r.Spec,
+	}
+}
+
+// RollbackPatch returns the JSON Patch replacing the spec of the release by the spec of the revision
+func (r *ReleaseRevision) RollbackPatch() (*Patch, *ServerResponse) {
+	data, err := json.Marshal([]map[string]interface{}{
+		{"op": "replace", "path": "/spec", "value": r.Release.Spec},
+	})
+	if err != nil {
+		return nil, NewServerResponse(OkdpServerResponse).
+			UnprocessableEntity("Unable to build the rollback to revision %d: %v", r.Revision, err)
+	}
+	return &Patch{Type: types.JSONPatchType, Data: data}, nil
+}
+
+// FindRevision returns the revision with the given number
+func FindRevision(revisions []*ReleaseRevision, namespace string, releaseName string, revision int) (*ReleaseRevision, *ServerResponse) {
+	for _, r := range revisions {
+		if r.Revision == revision {
+			return r, nil
+		}
+	}
+	return nil, NewServerResponse(OkdpServerResponse).
+		NotFoundError("Revision %d of the release '%s/%s' not found", revision, namespace, releaseName).
+		WithCode(ErrRevisionNotFound)
+}
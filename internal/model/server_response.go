@@ -17,10 +17,15 @@
 package model
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
 
+	"github.com/go-playground/validator/v10"
+
 	"github.com/okdp/okdp-server/api/openapi/v3/_api"
+	"github.com/okdp/okdp-server/internal/common/requestid"
 )
 
 type ServerResponse _api.ServerResponse
@@ -39,38 +44,59 @@ func NewServerResponse(errorType ServerResponseType) *ServerResponse {
 	}
 }
 
+// ForRequest tags the response with the ID of the request carried by the context (request or gin context), if any
+func (s *ServerResponse) ForRequest(ctx context.Context) *ServerResponse {
+	if requestID := requestid.FromContext(ctx); requestID != "" {
+		s.RequestId = &requestID
+	}
+	return s
+}
+
 func (s *ServerResponse) NotFoundError(messages ...interface{}) *ServerResponse {
 	s.Message = toError(messages...)
+	s.defaultCode(ErrNotFound)
 	s.Status = http.StatusNotFound
 	return s
 }
 
 func (s *ServerResponse) BadRequest(messages ...interface{}) *ServerResponse {
 	s.Message = toError(messages...)
+	s.defaultCode(ErrBadRequest)
 	s.Status = http.StatusBadRequest
 	return s
 }
 
 func (s *ServerResponse) Unauthorized(messages ...interface{}) *ServerResponse {
 	s.Message = toError(messages...)
+	s.defaultCode(ErrUnauthorized)
 	s.Status = http.StatusUnauthorized
 	return s
 }
 
 func (s *ServerResponse) Forbidden(messages ...interface{}) *ServerResponse {
 	s.Message = toError(messages...)
+	s.defaultCode(ErrForbidden)
 	s.Status = http.StatusForbidden
 	return s
 }
 
 func (s *ServerResponse) ConflictError(messages ...interface{}) *ServerResponse {
 	s.Message = toError(messages...)
+	s.defaultCode(ErrConflict)
 	s.Status = http.StatusConflict
 	return s
 }
 
+func (s *ServerResponse) PreconditionFailed(messages ...interface{}) *ServerResponse {
+	s.Message = toError(messages...)
+	s.defaultCode(ErrPreconditionFailed)
+	s.Status = http.StatusPreconditionFailed
+	return s
+}
+
 func (s *ServerResponse) UnprocessableEntity(messages ...interface{}) *ServerResponse {
 	s.Message = toError(messages...)
+	s.defaultCode(s.unprocessableCode())
 	s.Status = http.StatusUnprocessableEntity
 	return s
 }
@@ -78,6 +104,7 @@ func (s *ServerResponse) UnprocessableEntity(messages ...interface{}) *ServerRes
 func (s *ServerResponse) GenericError(statusCode int, messages ...interface{}) *ServerResponse {
 	s.Message = toError(messages...)
 	s.Status = statusCode
+	s.defaultCode(statusCode2Code(statusCode))
 	return s
 }
 
@@ -99,10 +126,105 @@ func (s *ServerResponse) Deleted(messages ...interface{}) *ServerResponse {
 	return s
 }
 
+// BindingError converts a request body binding error into a bad request response,
+// the validation errors are returned as field-level details.
+func BindingError(err error) *ServerResponse {
+	response := NewServerResponse(OkdpServerResponse)
+	var validationErrors validator.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		response.WithCode(ErrValidationFailed)
+		for _, fieldErr := range validationErrors {
+			response.WithDetails(NewErrorDetail(fieldErr.Namespace(), fieldErr.Tag(), fieldErr.Error()))
+		}
+	}
+	return response.BadRequest("%+v", err.Error())
+}
+
+// IsError returns true if the response is an error response
+func (s *ServerResponse) IsError() bool {
+	return s.Status >= http.StatusBadRequest
+}
+
 func (s *ServerResponse) IsNotfound() bool {
 	return s.Status == http.StatusNotFound
 }
 
+// WithCode sets the machine-readable error code, it overrides the default code derived from the status
+func (s *ServerResponse) WithCode(code ErrorCode) *ServerResponse {
+	value := string(code)
+	s.Code = &value
+	return s
+}
+
+// WithDetails appends field-level details to the error
+func (s *ServerResponse) WithDetails(details ...ErrorDetail) *ServerResponse {
+	if len(details) == 0 {
+		return s
+	}
+	if s.Details == nil {
+		s.Details = &[]ErrorDetail{}
+	}
+	*s.Details = append(*s.Details, details...)
+	return s
+}
+
+// HasCode returns true if the response carries the given error code
+func (s *ServerResponse) HasCode(code ErrorCode) bool {
+	return s.Code != nil && *s.Code == string(code)
+}
+
+func (s *ServerResponse) defaultCode(code ErrorCode) {
+	if s.Code == nil {
+		s.WithCode(code)
+	}
+}
+
+func (s *ServerResponse) unprocessableCode() ErrorCode {
+	switch ServerResponseType(s.Type) {
+	case K8sClusterResponse:
+		return ErrKubernetes
+	case GitRepoResponse:
+		return ErrGit
+	case RegistryResponse:
+		return ErrRegistry
+	default:
+		return ErrUnprocessable
+	}
+}
+
+func statusCode2Code(statusCode int) ErrorCode {
+	switch statusCode {
+	case http.StatusBadRequest:
+		return ErrBadRequest
+	case http.StatusUnauthorized:
+		return ErrUnauthorized
+	case http.StatusForbidden:
+		return ErrForbidden
+	case http.StatusNotFound:
+		return ErrNotFound
+	case http.StatusConflict:
+		return ErrConflict
+	case http.StatusGone:
+		return ErrContinueExpired
+	case http.StatusPreconditionFailed:
+		return ErrPreconditionFailed
+	case http.StatusUnsupportedMediaType:
+		return ErrUnsupportedMedia
+	case http.StatusUnprocessableEntity:
+		return ErrUnprocessable
+	case http.StatusTooManyRequests:
+		return ErrTooManyRequests
+	case http.StatusNotImplemented:
+		return ErrNotImplemented
+	case http.StatusServiceUnavailable:
+		return ErrUnavailable
+	case http.StatusGatewayTimeout:
+		return ErrGatewayTimeout
+	default:
+		return ErrInternal
+	}
+}
+
 func toError(messages ...interface{}) string {
 	if len(messages) == 1 {
 		return fmt.Errorf("%+v", messages...).Error()
@@ -0,0 +1,76 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kubocdv1alpha1 "kubocd/api/v1alpha1"
+)
+
+// DefaultPackageInterval is the interval at which KuboCD checks the package repository for updates
+const DefaultPackageInterval = 5 * time.Minute
+
+// ReleaseSkeleton is a ready-to-edit release of a package version
+type ReleaseSkeleton struct {
+	Release            *Release `json:"release"`
+	RequiredParameters []string `json:"requiredParameters"`
+	MissingParameters  []string `json:"missingParameters"`
+}
+
+// NewReleaseSkeleton returns a release deploying the package (OCI repository and tag) with the given parameters,
+// the required and missing parameters are the paths of the required parameters and the ones to be filled in.
+func NewReleaseSkeleton(repository string, tag string, name string, namespace string, targetNamespace string,
+	parameters interface{}, required []string, missing []string) (*ReleaseSkeleton, *ServerResponse) {
+	release := &Release{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: kubocdv1alpha1.GroupVersion.String(),
+			Kind:       "Release",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: kubocdv1alpha1.ReleaseSpec{
+			Package: kubocdv1alpha1.PackageSource{
+				Repository: repository,
+				Tag:        tag,
+				Interval:   metav1.Duration{Duration: DefaultPackageInterval},
+			},
+			TargetNamespace: targetNamespace,
+		},
+	}
+
+	if parameters != nil {
+		raw, err := json.Marshal(parameters)
+		if err != nil {
+			return nil, NewServerResponse(OkdpServerResponse).
+				UnprocessableEntity("Unable to serialize the default parameters of the package %s:%s: %v", repository, tag, err)
+		}
+		release.Spec.Parameters = &apiextensionsv1.JSON{Raw: raw}
+	}
+
+	return &ReleaseSkeleton{
+		Release:            release,
+		RequiredParameters: required,
+		MissingParameters:  missing,
+	}, nil
+}
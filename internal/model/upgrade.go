@@ -0,0 +1,216 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package model
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	kubocdv1alpha1 "kubocd/api/v1alpha1"
+
+	"github.com/okdp/okdp-server/api/openapi/v3/_api"
+	"github.com/okdp/okdp-server/internal/utils"
+)
+
+const (
+	UpgradeTargetCluster = "cluster"
+	UpgradeTargetGit     = "git"
+)
+
+const (
+	UpgradePlanned  = "Planned"
+	UpgradeUpgraded = "Upgraded"
+	UpgradeInvalid  = "Invalid"
+	UpgradeFailed   = "Failed"
+)
+
+type UpgradeRequest = _api.UpgradeRequest
+
+// Upgrade is the outcome of the upgrade of the releases of a package to a new version
+type Upgrade struct {
+	Package  string            `json:"package"`
+	Version  string            `json:"version"`
+	Target   string            `json:"target"`
+	DryRun   bool              `json:"dryRun"`
+	Releases []*ReleaseUpgrade `json:"releases"`
+	Skipped  []*UpgradeSkip    `json:"skipped,omitempty"`
+}
+
+// UpgradeSkip is a cluster, or a git repo of a cluster, whose releases could not be read and are not upgraded
+type UpgradeSkip struct {
+	ClusterID string          `json:"clusterId"`
+	GitRepo   string          `json:"gitRepo,omitempty"`
+	Error     *ServerResponse `json:"error"`
+}
+
+// ReleaseUpgrade is the upgrade of a release, the git repo and path are set for the releases upgraded in git
+type ReleaseUpgrade struct {
+	ClusterID   string          `json:"clusterId"`
+	Namespace   string          `json:"namespace"`
+	Name        string          `json:"name"`
+	GitRepo     string          `json:"gitRepo,omitempty"`
+	Path        string          `json:"path,omitempty"`
+	FromVersion string          `json:"fromVersion"`
+	ToVersion   string          `json:"toVersion"`
+	Status      string          `json:"status"`
+	Error       *ServerResponse `json:"error,omitempty"`
+}
+
+func NewUpgrade(request *UpgradeRequest, target string, dryRun bool) *Upgrade {
+	return &Upgrade{
+		Package:  request.Package,
+		Version:  request.Version,
+		Target:   target,
+		DryRun:   dryRun,
+		Releases: []*ReleaseUpgrade{},
+	}
+}
+
+// NewReleaseUpgrade returns the upgrade of the release to the version, planned until it is applied
+func NewReleaseUpgrade(clusterID string, release *Release, version string) *ReleaseUpgrade {
+	return &ReleaseUpgrade{
+		ClusterID:   clusterID,
+		Namespace:   release.Namespace,
+		Name:        release.Name,
+		FromVersion: release.Spec.Package.Tag,
+		ToVersion:   version,
+		Status:      UpgradePlanned,
+	}
+}
+
+// Fail sets the status of the upgrade along with its error
+func (u *ReleaseUpgrade) Fail(status string, err *ServerResponse) {
+	u.Status = status
+	u.Error = err
+}
+
+// Skip reports the cluster, or the git repo of the cluster, whose releases could not be read
+func (u *Upgrade) Skip(clusterID string, gitRepo string, err *ServerResponse) {
+	u.Skipped = append(u.Skipped, &UpgradeSkip{ClusterID: clusterID, GitRepo: gitRepo, Error: err})
+}
+
+// HasErrors reports whether some of the releases are invalid or could not be upgraded, or some clusters or git repos
+// were skipped
+func (u *Upgrade) HasErrors() bool {
+	if len(u.Skipped) > 0 {
+		return true
+	}
+	for _, release := range u.Releases {
+		if release.Error != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// Sort sorts the releases by cluster, namespace and name, and the skipped clusters and git repos by cluster and git repo
+func (u *Upgrade) Sort() {
+	sort.SliceStable(u.Skipped, func(i, j int) bool {
+		a, b := u.Skipped[i], u.Skipped[j]
+		if a.ClusterID != b.ClusterID {
+			return a.ClusterID < b.ClusterID
+		}
+		return a.GitRepo < b.GitRepo
+	})
+	sort.SliceStable(u.Releases, func(i, j int) bool {
+		a, b := u.Releases[i], u.Releases[j]
+		if a.ClusterID != b.ClusterID {
+			return a.ClusterID < b.ClusterID
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+}
+
+// IsPackage checks whether the release deploys the package (OCI repository), with or without the oci:// scheme
+func (r *Release) IsPackage(repository string) bool {
+	normalize := func(repository string) string {
+		return strings.TrimSuffix(strings.TrimPrefix(repository, "oci://"), "/")
+	}
+	return strings.EqualFold(normalize(r.Spec.Package.Repository), normalize(repository))
+}
+
+// WithVersion returns a copy of the release deploying the given version of its package
+func (r *Release) WithVersion(version string) *Release {
+	upgraded := Release(*(*kubocdv1alpha1.Release)(r).DeepCopy())
+	upgraded.Spec.Package.Tag = version
+	return &upgraded
+}
+
+// UpgradePatch returns the JSON Patch setting the version of the package of the release
+func UpgradePatch(version string) (*Patch, *ServerResponse) {
+	data, err := json.Marshal([]map[string]interface{}{
+		{"op": "replace", "path": "/spec/package/tag", "value": version},
+	})
+	if err != nil {
+		return nil, NewServerResponse(OkdpServerResponse).
+			UnprocessableEntity("Unable to build the upgrade to version %s: %v", version, err)
+	}
+	return &Patch{Type: types.JSONPatchType, Data: data}, nil
+}
+
+// OutdatedRelease compares the package version deployed by a release with the versions of the package in the catalog
+type OutdatedRelease struct {
+	ClusterID      string            `json:"clusterId"`
+	Namespace      string            `json:"namespace"`
+	Name           string            `json:"name"`
+	Package        string            `json:"package"`
+	CatalogID      string            `json:"catalogId"`
+	PackageName    string            `json:"packageName"`
+	CurrentVersion string            `json:"currentVersion"`
+	LatestPatch    string            `json:"latestPatch,omitempty"`
+	LatestMinor    string            `json:"latestMinor,omitempty"`
+	LatestMajor    string            `json:"latestMajor,omitempty"`
+	Outdated       bool              `json:"outdated"`
+	TagMissing     bool              `json:"tagMissing"`
+	SchemaChanges  []utils.FieldDiff `json:"schemaChanges,omitempty"`
+}
+
+// NewOutdatedRelease matches the release with the versions of its package in the catalog
+func NewOutdatedRelease(clusterID string, release *Release, catalogID string, pkg *Package) *OutdatedRelease {
+	current := release.Spec.Package.Tag
+	patch, minor, major := utils.LatestVersions(current, pkg.Versions)
+	return &OutdatedRelease{
+		ClusterID:      clusterID,
+		Namespace:      release.Namespace,
+		Name:           release.Name,
+		Package:        release.Spec.Package.Repository,
+		CatalogID:      catalogID,
+		PackageName:    pkg.Name,
+		CurrentVersion: current,
+		LatestPatch:    patch,
+		LatestMinor:    minor,
+		LatestMajor:    major,
+		Outdated:       major != "",
+		TagMissing:     !utils.Contains(pkg.Versions, current),
+	}
+}
+
+// SortOutdatedReleases sorts the releases by namespace and name
+func SortOutdatedReleases(releases []*OutdatedRelease) {
+	sort.SliceStable(releases, func(i, j int) bool {
+		if releases[i].Namespace != releases[j].Namespace {
+			return releases[i].Namespace < releases[j].Namespace
+		}
+		return releases[i].Name < releases[j].Name
+	})
+}
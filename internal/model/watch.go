@@ -0,0 +1,40 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package model
+
+const (
+	WatchAdded    = "ADDED"
+	WatchModified = "MODIFIED"
+	WatchDeleted  = "DELETED"
+	WatchBookmark = "BOOKMARK"
+	WatchError    = "ERROR"
+)
+
+// WatchEvent is a notification pushed by the watch endpoints
+type WatchEvent struct {
+	Type            string      `json:"type"`
+	ResourceVersion string      `json:"resourceVersion,omitempty"`
+	Object          interface{} `json:"object,omitempty"`
+}
+
+// WatchErrorEvent returns the notification ending a watch on error
+func WatchErrorEvent(err *ServerResponse) *WatchEvent {
+	return &WatchEvent{
+		Type:   WatchError,
+		Object: err,
+	}
+}
@@ -0,0 +1,148 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+// Package negotiation supports the YAML representation of the API resources.
+//
+// The YAML request bodies are converted into JSON, and the JSON responses are converted into YAML
+// when the client accepts it, so that the validation and the controllers only deal with JSON.
+package negotiation
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/yaml"
+
+	"github.com/okdp/okdp-server/internal/common/constants"
+	"github.com/okdp/okdp-server/internal/model"
+)
+
+const (
+	MIMEJSONPatchYAML  = "application/json-patch+yaml"
+	MIMEMergePatchYAML = "application/merge-patch+yaml"
+	MIMEJSONPatch      = "application/json-patch+json"
+	MIMEMergePatch     = "application/merge-patch+json"
+	MIMETextYAML       = "text/yaml"
+)
+
+// YAML converts the YAML request bodies into JSON and the JSON responses into YAML
+// when the 'Accept' header prefers 'application/yaml'.
+// A multi-document YAML body is converted into a JSON array.
+// The requests proxied to the applications of the releases are forwarded as is.
+func YAML() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if strings.HasSuffix(c.FullPath(), constants.ReleaseProxyRoute) {
+			c.Next()
+			return
+		}
+
+		var writer *yamlWriter
+		if format := c.NegotiateFormat(binding.MIMEJSON, binding.MIMEYAML2, binding.MIMEYAML); format == binding.MIMEYAML2 || format == binding.MIMEYAML {
+			writer = &yamlWriter{ResponseWriter: c.Writer, status: http.StatusOK}
+			c.Writer = writer
+		}
+
+		if err := convertRequest(c); err != nil {
+			resp := model.NewServerResponse(model.OkdpServerResponse).ForRequest(c).
+				BadRequest("Invalid YAML request body: %v", err)
+			c.AbortWithStatusJSON(resp.Status, resp.ForRequest(c))
+		} else {
+			c.Next()
+		}
+
+		if writer != nil {
+			c.Writer = writer.ResponseWriter
+			writer.flush(c)
+		}
+	}
+}
+
+// convertRequest replaces the YAML request body with its JSON representation
+func convertRequest(c *gin.Context) error {
+	var contentType string
+	switch c.ContentType() {
+	case binding.MIMEYAML2, binding.MIMEYAML, MIMETextYAML:
+		contentType = binding.MIMEJSON
+		if c.Request.Method == http.MethodPatch {
+			contentType = MIMEMergePatch
+		}
+	case MIMEMergePatchYAML:
+		contentType = MIMEMergePatch
+	case MIMEJSONPatchYAML:
+		contentType = MIMEJSONPatch
+	default:
+		return nil
+	}
+
+	data, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		return err
+	}
+	converted, err := ToJSON(data)
+	if err != nil {
+		return err
+	}
+
+	c.Request.Body = io.NopCloser(bytes.NewReader(converted))
+	c.Request.ContentLength = int64(len(converted))
+	c.Request.Header.Set("Content-Type", contentType)
+	return nil
+}
+
+// ToJSON converts a YAML document into JSON.
+// A multi-document YAML is converted into a JSON array, the empty documents are ignored.
+func ToJSON(data []byte) ([]byte, error) {
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(data)))
+	docs := []json.RawMessage{}
+	for {
+		doc, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		converted, err := yaml.YAMLToJSON(doc)
+		if err != nil {
+			return nil, err
+		}
+		if len(bytes.TrimSpace(doc)) == 0 || string(converted) == "null" {
+			continue
+		}
+		docs = append(docs, converted)
+	}
+
+	switch len(docs) {
+	case 0:
+		return nil, errors.New("empty YAML document")
+	case 1:
+		return docs[0], nil
+	default:
+		return json.Marshal(docs)
+	}
+}
+
+func isJSON(contentType string) bool {
+	return strings.HasPrefix(contentType, binding.MIMEJSON)
+}
@@ -0,0 +1,150 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package negotiation
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/okdp/okdp-server/internal/common/constants"
+	log "github.com/okdp/okdp-server/internal/common/logging"
+	"github.com/okdp/okdp-server/internal/config"
+)
+
+func newTestRouter() *gin.Engine {
+	log.SetupGlobalLogger(config.Logging{})
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(YAML())
+	echo := func(c *gin.Context) {
+		body, _ := io.ReadAll(c.Request.Body)
+		c.Header("X-Content-Type", c.ContentType())
+		c.Data(http.StatusOK, "application/json", body)
+	}
+	r.POST("/echo", echo)
+	r.PATCH("/echo", echo)
+	r.GET("/release", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"metadata": gin.H{"name": "podinfo"}, "spec": gin.H{"replicas": 2}})
+	})
+	r.Any("/api/v1"+constants.ReleaseProxyRoute, echo)
+	r.GET("/logs", func(c *gin.Context) {
+		c.String(http.StatusOK, "line1\nline2\n")
+	})
+	return r
+}
+
+func serve(r *gin.Engine, method, path, contentType, accept, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestYAML_Request(t *testing.T) {
+	// Given
+	r := newTestRouter()
+	// When
+	w := serve(r, http.MethodPost, "/echo", "application/yaml", "", "metadata:\n  name: podinfo\n")
+	// Then
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/json", w.Header().Get("X-Content-Type"))
+	assert.JSONEq(t, `{"metadata": {"name": "podinfo"}}`, w.Body.String())
+}
+
+func TestYAML_ProxiedRequest(t *testing.T) {
+	// Given
+	r := newTestRouter()
+	body := "dag_id: etl\n"
+	// When
+	w := serve(r, http.MethodPost, "/api/v1/clusters/c1/namespaces/team-a/releases/airflow/proxy/services/airflow-web/http/api/dags",
+		"application/yaml", "application/yaml", body)
+	// Then
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/yaml", w.Header().Get("X-Content-Type"))
+	assert.Equal(t, body, w.Body.String())
+}
+
+func TestYAML_MultiDocumentRequest(t *testing.T) {
+	// Given
+	r := newTestRouter()
+	body := "---\nname: release1\n---\n# comment only\n---\nname: release2\n"
+	// When
+	w := serve(r, http.MethodPost, "/echo", "application/x-yaml", "", body)
+	// Then
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `[{"name": "release1"}, {"name": "release2"}]`, w.Body.String())
+}
+
+func TestYAML_PatchRequest(t *testing.T) {
+	// Given
+	r := newTestRouter()
+	// When
+	merge := serve(r, http.MethodPatch, "/echo", "application/yaml", "", "spec:\n  replicas: 3\n")
+	jsonPatch := serve(r, http.MethodPatch, "/echo", "application/json-patch+yaml", "", "- op: remove\n  path: /spec/replicas\n")
+	// Then
+	assert.Equal(t, "application/merge-patch+json", merge.Header().Get("X-Content-Type"))
+	assert.JSONEq(t, `{"spec": {"replicas": 3}}`, merge.Body.String())
+	assert.Equal(t, "application/json-patch+json", jsonPatch.Header().Get("X-Content-Type"))
+	assert.JSONEq(t, `[{"op": "remove", "path": "/spec/replicas"}]`, jsonPatch.Body.String())
+}
+
+func TestYAML_InvalidRequest(t *testing.T) {
+	// Given
+	r := newTestRouter()
+	// When
+	w := serve(r, http.MethodPost, "/echo", "application/yaml", "application/yaml", "name: [unclosed\n")
+	// Then
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Header().Get("Content-Type"), "application/yaml")
+	assert.Contains(t, w.Body.String(), "code: BAD_REQUEST")
+}
+
+func TestYAML_Response(t *testing.T) {
+	// Given
+	r := newTestRouter()
+	// When
+	yamlResp := serve(r, http.MethodGet, "/release", "", "application/yaml", "")
+	jsonResp := serve(r, http.MethodGet, "/release", "", "application/json, application/yaml", "")
+	// Then
+	assert.Equal(t, http.StatusOK, yamlResp.Code)
+	assert.Equal(t, "application/yaml; charset=utf-8", yamlResp.Header().Get("Content-Type"))
+	assert.Equal(t, "metadata:\n  name: podinfo\nspec:\n  replicas: 2\n", yamlResp.Body.String())
+	assert.Contains(t, jsonResp.Header().Get("Content-Type"), "application/json")
+	assert.JSONEq(t, `{"metadata": {"name": "podinfo"}, "spec": {"replicas": 2}}`, jsonResp.Body.String())
+}
+
+func TestYAML_NonJSONResponse(t *testing.T) {
+	// Given
+	r := newTestRouter()
+	// When
+	w := serve(r, http.MethodGet, "/logs", "", "application/yaml", "")
+	// Then
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "line1\nline2\n", w.Body.String())
+}
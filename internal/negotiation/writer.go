@@ -0,0 +1,113 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package negotiation
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"sigs.k8s.io/yaml"
+
+	log "github.com/okdp/okdp-server/internal/common/logging"
+)
+
+// yamlWriter buffers the JSON responses so that they can be converted into YAML.
+// The other responses (logs, streams, etc) are written as is.
+type yamlWriter struct {
+	gin.ResponseWriter
+	body     bytes.Buffer
+	status   int
+	buffered *bool
+}
+
+func (w *yamlWriter) WriteHeader(status int) {
+	w.status = status
+}
+
+func (w *yamlWriter) WriteHeaderNow() {
+}
+
+func (w *yamlWriter) Write(data []byte) (int, error) {
+	if w.isBuffered() {
+		return w.body.Write(data)
+	}
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *yamlWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+func (w *yamlWriter) Status() int {
+	return w.status
+}
+
+func (w *yamlWriter) Size() int {
+	if w.buffered != nil && *w.buffered {
+		return w.body.Len()
+	}
+	return w.ResponseWriter.Size()
+}
+
+func (w *yamlWriter) Written() bool {
+	return w.buffered != nil
+}
+
+func (w *yamlWriter) Flush() {
+	if w.buffered != nil && !*w.buffered {
+		w.ResponseWriter.Flush()
+	}
+}
+
+// isBuffered decides on the first write whether the response is a JSON document to convert
+func (w *yamlWriter) isBuffered() bool {
+	if w.buffered == nil {
+		buffered := isJSON(w.Header().Get("Content-Type"))
+		w.buffered = &buffered
+		if !buffered {
+			w.ResponseWriter.WriteHeader(w.status)
+		}
+	}
+	return *w.buffered
+}
+
+// flush converts the buffered JSON response into YAML and writes it to the client
+func (w *yamlWriter) flush(ctx context.Context) {
+	if w.buffered == nil {
+		w.ResponseWriter.WriteHeader(w.status)
+		w.ResponseWriter.WriteHeaderNow()
+		return
+	}
+	if !*w.buffered {
+		return
+	}
+
+	data := w.body.Bytes()
+	if converted, err := yaml.JSONToYAML(data); err != nil {
+		log.Ctx(ctx).Warn("Unable to convert the JSON response into YAML, the JSON response is returned: %v", err)
+	} else {
+		data = converted
+		w.Header().Set("Content-Type", binding.MIMEYAML2+"; charset=utf-8")
+	}
+	w.Header().Del("Content-Length")
+	w.ResponseWriter.WriteHeader(w.status)
+	if _, err := w.ResponseWriter.Write(data); err != nil {
+		log.Ctx(ctx).Error("Unable to write the YAML response: %v", err)
+	}
+}
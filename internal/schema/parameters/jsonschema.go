@@ -0,0 +1,83 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package schema
+
+import (
+	"sort"
+)
+
+// JSONSchemaDraft is the JSON Schema dialect of the converted schemas
+const JSONSchemaDraft = "https://json-schema.org/draft/2020-12/schema"
+
+// ToJSONSchema converts the schema item into a standard JSON Schema (draft 2020-12) document.
+// The required flags of the properties become the 'required' list of their object and, like KuboCD,
+// the objects reject the unknown properties unless 'additionalProperties' is set on them or on a parent.
+func (s *KuboSchemaItem) ToJSONSchema() map[string]interface{} {
+	document := s.toJSONSchema(false)
+	document["$schema"] = JSONSchemaDraft
+	return document
+}
+
+func (s *KuboSchemaItem) toJSONSchema(additionalProperties bool) map[string]interface{} {
+	if s.AdditionalProperties != nil {
+		additionalProperties = *s.AdditionalProperties
+	}
+
+	jsonSchema := map[string]interface{}{}
+	typ := s.typeOf()
+	if typ != "" {
+		jsonSchema["type"] = typ
+	}
+	if s.Description != "" {
+		jsonSchema["description"] = s.Description
+	}
+	if s.Default != nil {
+		jsonSchema["default"] = s.Default
+	}
+	if len(s.Enum) > 0 {
+		jsonSchema["enum"] = s.Enum
+	}
+
+	switch typ {
+	case "object":
+		properties := map[string]interface{}{}
+		required := []string{}
+		for name, property := range s.Properties {
+			properties[name] = property.toJSONSchema(additionalProperties)
+			if property.Required {
+				required = append(required, name)
+			}
+		}
+		sort.Strings(required)
+		if len(properties) > 0 {
+			jsonSchema["properties"] = properties
+		}
+		if len(required) > 0 {
+			jsonSchema["required"] = required
+		}
+		if s.AdditionalPropertiesSchema != nil {
+			jsonSchema["additionalProperties"] = s.AdditionalPropertiesSchema.toJSONSchema(additionalProperties)
+		} else {
+			jsonSchema["additionalProperties"] = additionalProperties
+		}
+	case "array":
+		if s.Items != nil {
+			jsonSchema["items"] = s.Items.toJSONSchema(additionalProperties)
+		}
+	}
+	return jsonSchema
+}
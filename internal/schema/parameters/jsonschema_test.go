@@ -0,0 +1,86 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package schema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToJSONSchema(t *testing.T) {
+	// Given
+	var item KuboSchemaItem
+	err := json.Unmarshal([]byte(`{
+		"properties": {
+			"fqdn": {"type": "string", "required": true, "description": "Ingress host"},
+			"replicas": {"type": "integer", "default": 2},
+			"mode": {"type": "string", "enum": ["dev", "prod"]},
+			"hosts": {"type": "array", "items": {"type": "object", "properties": {"name": {"type": "string", "required": true}}}}
+		}
+	}`), &item)
+	assert.NoError(t, err)
+
+	// When
+	document := item.ToJSONSchema()
+
+	// Then
+	expected := `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "object",
+		"additionalProperties": false,
+		"required": ["fqdn"],
+		"properties": {
+			"fqdn": {"type": "string", "description": "Ingress host"},
+			"replicas": {"type": "integer", "default": 2},
+			"mode": {"type": "string", "enum": ["dev", "prod"]},
+			"hosts": {
+				"type": "array",
+				"items": {
+					"type": "object",
+					"additionalProperties": false,
+					"required": ["name"],
+					"properties": {"name": {"type": "string"}}
+				}
+			}
+		}
+	}`
+	actual, err := json.Marshal(document)
+	assert.NoError(t, err)
+	assert.JSONEq(t, expected, string(actual))
+}
+
+func TestToJSONSchemaInheritsAdditionalProperties(t *testing.T) {
+	// Given
+	allowed := true
+	item := &KuboSchemaItem{
+		AdditionalProperties: &allowed,
+		Properties: map[string]*KuboSchemaItem{
+			"extra": {Type: "object"},
+		},
+	}
+
+	// When
+	document := item.ToJSONSchema()
+
+	// Then
+	assert.Equal(t, true, document["additionalProperties"])
+	extra := document["properties"].(map[string]interface{})["extra"].(map[string]interface{})
+	assert.Equal(t, true, extra["additionalProperties"])
+	assert.NotContains(t, extra, "$schema")
+}
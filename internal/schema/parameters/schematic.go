@@ -16,16 +16,114 @@
 
 package schema
 
+import (
+	"encoding/json"
+	"fmt"
+)
+
 type KuboSchema struct {
 	ParametersSchema *KuboSchemaItem `json:"parametersSchema"`
 	ContextSchema    *KuboSchemaItem `json:"contextSchema"`
 }
 
 type KuboSchemaItem struct {
-	Description string                     `json:"description,omitempty"`
-	Type        string                     `json:"type,omitempty"`
-	Properties  map[string]*KuboSchemaItem `json:"properties,omitempty"`
-	Items       *KuboSchemaItem            `json:"items,omitempty"`
-	Required    bool                       `json:"required,omitempty"`
-	Default     interface{}                `json:"default,omitempty"`
+	Description          string                     `json:"description,omitempty"`
+	Type                 string                     `json:"type,omitempty"`
+	Properties           map[string]*KuboSchemaItem `json:"properties,omitempty"`
+	Items                *KuboSchemaItem            `json:"items,omitempty"`
+	Required             bool                       `json:"required,omitempty"`
+	Default              interface{}                `json:"default,omitempty"`
+	Enum                 []interface{}              `json:"enum,omitempty"`
+	AdditionalProperties *bool                      `json:"-"`
+	// AdditionalPropertiesSchema is the schema of the additional properties ('additionalProperties' object form)
+	AdditionalPropertiesSchema *KuboSchemaItem `json:"-"`
+}
+
+// UnmarshalJSON supports both the KuboCD 'required' flag of the properties and the JSON Schema 'required'
+// list of property names, used by the packages defining their schema in the OpenAPI format.
+// Likewise, 'additionalProperties' is either a boolean or the schema of the additional properties. A schema declaring
+// '$schema' is a JSON Schema, validated by KuboCD with gojsonschema: its additional properties are allowed by default.
+func (s *KuboSchemaItem) UnmarshalJSON(data []byte) error {
+	type item KuboSchemaItem
+	var raw struct {
+		item
+		Schema               string          `json:"$schema,omitempty"`
+		Required             json.RawMessage `json:"required,omitempty"`
+		AdditionalProperties json.RawMessage `json:"additionalProperties,omitempty"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	*s = KuboSchemaItem(raw.item)
+	if err := s.unmarshalAdditionalProperties(raw.AdditionalProperties); err != nil {
+		return err
+	}
+	if s.AdditionalProperties == nil && raw.Schema != "" {
+		allowed := true
+		s.AdditionalProperties = &allowed
+	}
+	if len(raw.Required) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(raw.Required, &s.Required); err == nil {
+		return nil
+	}
+	var names []string
+	if err := json.Unmarshal(raw.Required, &names); err != nil {
+		return fmt.Errorf("'required' must be a boolean or a list of property names: %w", err)
+	}
+	for _, name := range names {
+		if property, found := s.Properties[name]; found {
+			property.Required = true
+		}
+	}
+	return nil
+}
+
+func (s *KuboSchemaItem) unmarshalAdditionalProperties(data json.RawMessage) error {
+	if len(data) == 0 || string(data) == "null" {
+		return nil
+	}
+	var allowed bool
+	if err := json.Unmarshal(data, &allowed); err == nil {
+		s.AdditionalProperties = &allowed
+		return nil
+	}
+	var schema KuboSchemaItem
+	if err := json.Unmarshal(data, &schema); err != nil {
+		return fmt.Errorf("'additionalProperties' must be a boolean or a schema: %w", err)
+	}
+	allowed = true
+	s.AdditionalProperties = &allowed
+	s.AdditionalPropertiesSchema = &schema
+	return nil
+}
+
+// NewKuboSchema reads the schema of a KuboCD package definition, the parameters and context schemas
+// are found under 'schema.parameters' and 'schema.context'.
+func NewKuboSchema(definition map[string]interface{}) (*KuboSchema, error) {
+	schema := &KuboSchema{}
+	section, found := definition["schema"].(map[string]interface{})
+	if !found {
+		return schema, nil
+	}
+
+	for key, target := range map[string]**KuboSchemaItem{
+		"parameters": &schema.ParametersSchema,
+		"context":    &schema.ContextSchema,
+	} {
+		value, found := section[key]
+		if !found || value == nil {
+			continue
+		}
+		data, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(data, target); err != nil {
+			return nil, fmt.Errorf("invalid 'schema.%s': %w", key, err)
+		}
+	}
+	return schema, nil
 }
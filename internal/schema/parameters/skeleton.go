@@ -0,0 +1,71 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package schema
+
+import (
+	"sort"
+)
+
+// Defaults returns the default values of the schema item: the default of the item if any, otherwise the defaults
+// of the properties of an object. It returns nil when there is no default.
+func (s *KuboSchemaItem) Defaults() interface{} {
+	if s.Default != nil || s.typeOf() != "object" {
+		return s.Default
+	}
+	defaults := map[string]interface{}{}
+	for name, property := range s.Properties {
+		if value := property.Defaults(); value != nil {
+			defaults[name] = value
+		}
+	}
+	if len(defaults) == 0 {
+		return nil
+	}
+	return defaults
+}
+
+// RequiredFields returns the paths of the required properties, and among them the missing ones, which have no
+// default value and must be provided. The nested properties are reported when their parent object is required
+// or has defaults, the paths are sorted and start with `path`.
+func (s *KuboSchemaItem) RequiredFields(path string) ([]string, []string) {
+	required, missing := []string{}, []string{}
+	s.requiredFields(path, &required, &missing)
+	return required, missing
+}
+
+func (s *KuboSchemaItem) requiredFields(path string, required *[]string, missing *[]string) {
+	names := make([]string, 0, len(s.Properties))
+	for name := range s.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		property := s.Properties[name]
+		propertyPath := joinPath(path, name)
+		defaults := property.Defaults()
+		if property.Required {
+			*required = append(*required, propertyPath)
+			if defaults == nil {
+				*missing = append(*missing, propertyPath)
+			}
+		}
+		if property.typeOf() == "object" && (property.Required || defaults != nil) {
+			property.requiredFields(propertyPath, required, missing)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package schema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func skeletonSchema(t *testing.T) *KuboSchemaItem {
+	var item KuboSchemaItem
+	err := json.Unmarshal([]byte(`{
+		"properties": {
+			"fqdn": {"type": "string", "required": true},
+			"replicas": {"type": "integer", "default": 2},
+			"ingress": {
+				"type": "object",
+				"properties": {
+					"enabled": {"type": "boolean", "default": true},
+					"className": {"type": "string", "required": true, "default": "nginx"}
+				}
+			},
+			"tls": {
+				"type": "object",
+				"properties": {
+					"issuer": {"type": "string", "required": true}
+				}
+			}
+		}
+	}`), &item)
+	assert.NoError(t, err)
+	return &item
+}
+
+func TestDefaults(t *testing.T) {
+	// Given
+	item := skeletonSchema(t)
+
+	// When
+	defaults := item.Defaults()
+
+	// Then
+	assert.Equal(t, map[string]interface{}{
+		"replicas": float64(2),
+		"ingress": map[string]interface{}{
+			"enabled":   true,
+			"className": "nginx",
+		},
+	}, defaults)
+}
+
+func TestDefaultsWithoutDefaultValue(t *testing.T) {
+	// Given
+	item := &KuboSchemaItem{Properties: map[string]*KuboSchemaItem{
+		"fqdn": {Type: "string"},
+	}}
+
+	// When
+	defaults := item.Defaults()
+
+	// Then
+	assert.Nil(t, defaults)
+}
+
+func TestRequiredFields(t *testing.T) {
+	// Given
+	item := skeletonSchema(t)
+
+	// When
+	required, missing := item.RequiredFields("spec.parameters")
+
+	// Then
+	assert.Equal(t, []string{"spec.parameters.fqdn", "spec.parameters.ingress.className"}, required)
+	assert.Equal(t, []string{"spec.parameters.fqdn"}, missing)
+}
+
+func TestRequiredFieldsOfRequiredObject(t *testing.T) {
+	// Given
+	item := skeletonSchema(t)
+	item.Properties["tls"].Required = true
+
+	// When
+	required, missing := item.RequiredFields("")
+
+	// Then
+	assert.Equal(t, []string{"fqdn", "ingress.className", "tls", "tls.issuer"}, required)
+	assert.Equal(t, []string{"fqdn", "tls", "tls.issuer"}, missing)
+}
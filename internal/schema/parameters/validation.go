@@ -0,0 +1,179 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package schema
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+)
+
+const (
+	ReasonRequired = "required"
+	ReasonType     = "type"
+	ReasonEnum     = "enum"
+	ReasonUnknown  = "unknown"
+)
+
+// FieldError is a value not matching the schema
+type FieldError struct {
+	Field   string
+	Reason  string
+	Message string
+}
+
+// Validate checks the value against the schema: the types, the required properties (unless they have a default),
+// the enums and the unknown properties, in the nested objects and array items too.
+// Like KuboCD, the unknown properties are rejected unless 'additionalProperties' is set, the setting applies to the
+// nested objects. When 'additionalProperties' is a schema, the unknown properties are validated against it.
+// The errors are sorted by field, the fields are dot separated paths starting with `path`.
+func (s *KuboSchemaItem) Validate(path string, value interface{}) []FieldError {
+	errs := []FieldError{}
+	s.validate(path, value, false, &errs)
+	sort.SliceStable(errs, func(i, j int) bool {
+		return errs[i].Field < errs[j].Field
+	})
+	return errs
+}
+
+func (s *KuboSchemaItem) validate(path string, value interface{}, additionalProperties bool, errs *[]FieldError) {
+	if s.AdditionalProperties != nil {
+		additionalProperties = *s.AdditionalProperties
+	}
+
+	typ := s.typeOf()
+	if !matchesType(typ, value) {
+		*errs = append(*errs, FieldError{Field: path, Reason: ReasonType, Message: fmt.Sprintf("'%s' must be of type %s, got %s", path, typ, typeName(value))})
+		return
+	}
+	if len(s.Enum) > 0 && !containsValue(s.Enum, value) {
+		*errs = append(*errs, FieldError{Field: path, Reason: ReasonEnum, Message: fmt.Sprintf("'%s' must be one of %v", path, s.Enum)})
+	}
+
+	switch typ {
+	case "object":
+		object := value.(map[string]interface{})
+		for name, property := range s.Properties {
+			v, found := object[name]
+			if !found {
+				if property.Required && property.Default == nil {
+					*errs = append(*errs, FieldError{Field: joinPath(path, name), Reason: ReasonRequired, Message: fmt.Sprintf("'%s' is required", joinPath(path, name))})
+				}
+				continue
+			}
+			property.validate(joinPath(path, name), v, additionalProperties, errs)
+		}
+		if additionalProperties && s.AdditionalPropertiesSchema == nil {
+			return
+		}
+		for name, v := range object {
+			if _, found := s.Properties[name]; found {
+				continue
+			}
+			if s.AdditionalPropertiesSchema != nil {
+				s.AdditionalPropertiesSchema.validate(joinPath(path, name), v, additionalProperties, errs)
+				continue
+			}
+			*errs = append(*errs, FieldError{Field: joinPath(path, name), Reason: ReasonUnknown, Message: fmt.Sprintf("'%s' is not defined in the package schema", joinPath(path, name))})
+		}
+	case "array":
+		if s.Items == nil {
+			return
+		}
+		for i, item := range value.([]interface{}) {
+			s.Items.validate(joinPath(path, strconv.Itoa(i)), item, additionalProperties, errs)
+		}
+	}
+}
+
+// typeOf returns the type of the item, the objects and arrays may be defined only by their properties and items
+func (s *KuboSchemaItem) typeOf() string {
+	switch {
+	case s.Type != "":
+		return s.Type
+	case s.Properties != nil:
+		return "object"
+	case s.Items != nil:
+		return "array"
+	}
+	return ""
+}
+
+func matchesType(typ string, value interface{}) bool {
+	switch typ {
+	case "":
+		return true
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "number":
+		_, ok := value.(float64)
+		return ok
+	case "integer":
+		number, ok := value.(float64)
+		return ok && number == math.Trunc(number)
+	case "object":
+		_, ok := value.(map[string]interface{})
+		return ok
+	case "array":
+		_, ok := value.([]interface{})
+		return ok
+	}
+	return false
+}
+
+func typeName(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case float64:
+		if v == math.Trunc(v) {
+			return "integer"
+		}
+		return "number"
+	case map[string]interface{}:
+		return "object"
+	case []interface{}:
+		return "array"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+func containsValue(values []interface{}, value interface{}) bool {
+	for _, v := range values {
+		if reflect.DeepEqual(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func joinPath(path string, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
@@ -0,0 +1,204 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package schema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func parseSchema(t *testing.T, data string) *KuboSchemaItem {
+	var s KuboSchemaItem
+	require.NoError(t, json.Unmarshal([]byte(data), &s))
+	return &s
+}
+
+func parseValue(t *testing.T, data string) interface{} {
+	var v interface{}
+	require.NoError(t, json.Unmarshal([]byte(data), &v))
+	return v
+}
+
+const redisSchema = `{
+	"properties": {
+		"password": { "type": "string", "required": true },
+		"replicaCount": { "type": "integer", "default": 1 },
+		"mode": { "type": "string", "enum": ["standalone", "cluster"], "default": "standalone" },
+		"tls": { "type": "boolean", "required": true, "default": false },
+		"ingress": {
+			"properties": {
+				"host": { "type": "string", "required": true }
+			}
+		},
+		"users": {
+			"items": {
+				"properties": {
+					"name": { "type": "string", "required": true }
+				}
+			}
+		},
+		"extraValues": { "type": "object", "properties": {}, "additionalProperties": true }
+	}
+}`
+
+func TestValidate_Valid(t *testing.T) {
+	// Given
+	s := parseSchema(t, redisSchema)
+	value := parseValue(t, `{
+		"password": "secret",
+		"replicaCount": 3,
+		"mode": "cluster",
+		"ingress": { "host": "redis.example.com" },
+		"users": [ { "name": "admin" } ],
+		"extraValues": { "any": { "thing": 1 } }
+	}`)
+
+	// When
+	errs := s.Validate("spec.parameters", value)
+
+	// Then
+	assert.Empty(t, errs, "the required properties with a default value may be omitted")
+}
+
+func TestValidate_Errors(t *testing.T) {
+	// Given
+	s := parseSchema(t, redisSchema)
+	value := parseValue(t, `{
+		"replicaCount": 1.5,
+		"mode": "sentinel",
+		"passwrd": "typo",
+		"ingress": { "hostname": "redis.example.com" },
+		"users": [ { "name": "admin" }, { "name": 1 } ]
+	}`)
+
+	// When
+	errs := s.Validate("spec.parameters", value)
+
+	// Then
+	assert.Equal(t, []FieldError{
+		{Field: "spec.parameters.ingress.host", Reason: ReasonRequired, Message: "'spec.parameters.ingress.host' is required"},
+		{Field: "spec.parameters.ingress.hostname", Reason: ReasonUnknown, Message: "'spec.parameters.ingress.hostname' is not defined in the package schema"},
+		{Field: "spec.parameters.mode", Reason: ReasonEnum, Message: "'spec.parameters.mode' must be one of [standalone cluster]"},
+		{Field: "spec.parameters.password", Reason: ReasonRequired, Message: "'spec.parameters.password' is required"},
+		{Field: "spec.parameters.passwrd", Reason: ReasonUnknown, Message: "'spec.parameters.passwrd' is not defined in the package schema"},
+		{Field: "spec.parameters.replicaCount", Reason: ReasonType, Message: "'spec.parameters.replicaCount' must be of type integer, got number"},
+		{Field: "spec.parameters.users.1.name", Reason: ReasonType, Message: "'spec.parameters.users.1.name' must be of type string, got integer"},
+	}, errs)
+}
+
+func TestValidate_NoSchema(t *testing.T) {
+	// Given
+	s := &KuboSchemaItem{Type: "object"}
+
+	// When
+	errs := s.Validate("spec.parameters", parseValue(t, `{ "replicas": 1 }`))
+
+	// Then
+	require.Len(t, errs, 1, "a package without parameters schema accepts no parameter")
+	assert.Equal(t, ReasonUnknown, errs[0].Reason)
+}
+
+func TestUnmarshal_RequiredList(t *testing.T) {
+	// Given
+	data := `{
+		"$schema": "http://json-schema.org/schema#",
+		"type": "object",
+		"additionalProperties": false,
+		"required": ["host"],
+		"properties": {
+			"host": { "type": "string" },
+			"port": { "type": "integer" }
+		}
+	}`
+
+	// When
+	s := parseSchema(t, data)
+
+	// Then
+	assert.False(t, s.Required)
+	assert.True(t, s.Properties["host"].Required)
+	assert.False(t, s.Properties["port"].Required)
+	require.NotNil(t, s.AdditionalProperties)
+	assert.False(t, *s.AdditionalProperties)
+}
+
+func TestValidate_AdditionalPropertiesSchema(t *testing.T) {
+	// Given
+	s := parseSchema(t, `{
+		"properties": {
+			"replicas": { "type": "integer" },
+			"labels": { "type": "object", "additionalProperties": { "type": "string" } }
+		}
+	}`)
+
+	// When
+	errs := s.Validate("spec.parameters", parseValue(t, `{ "replicas": 1, "labels": { "app": "redis", "tier": 2 } }`))
+
+	// Then
+	require.NotNil(t, s.Properties["labels"].AdditionalProperties)
+	assert.True(t, *s.Properties["labels"].AdditionalProperties)
+	require.Len(t, errs, 1, "the additional properties are validated against the schema")
+	assert.Equal(t, "spec.parameters.labels.tier", errs[0].Field)
+	assert.Equal(t, ReasonType, errs[0].Reason)
+	assert.Equal(t, map[string]interface{}{"type": "string"}, s.ToJSONSchema()["properties"].(map[string]interface{})["labels"].(map[string]interface{})["additionalProperties"])
+}
+
+func TestValidate_JSONSchemaAllowsAdditionalProperties(t *testing.T) {
+	// Given
+	s := parseSchema(t, `{
+		"$schema": "http://json-schema.org/schema#",
+		"type": "object",
+		"properties": {
+			"redis": { "type": "object", "properties": { "password": { "type": "string" } } },
+			"ingress": { "type": "object", "additionalProperties": false, "properties": { "host": { "type": "string" } } }
+		}
+	}`)
+
+	// When
+	errs := s.Validate("spec.parameters", parseValue(t, `{ "extra": 1, "redis": { "port": 6379 }, "ingress": { "tls": true } }`))
+
+	// Then
+	require.NotNil(t, s.AdditionalProperties)
+	assert.True(t, *s.AdditionalProperties, "gojsonschema allows the unknown properties by default")
+	require.Len(t, errs, 1, "only the object disallowing them rejects the unknown properties")
+	assert.Equal(t, "spec.parameters.ingress.tls", errs[0].Field)
+	assert.Equal(t, ReasonUnknown, errs[0].Reason)
+}
+
+func TestNewKuboSchema(t *testing.T) {
+	// Given
+	definition := parseValue(t, `{
+		"name": "redis",
+		"schema": {
+			"parameters": { "properties": { "password": { "type": "string", "required": true } } },
+			"context": { "properties": { "ingress": { "properties": { "className": { "type": "string" } } } } }
+		}
+	}`).(map[string]interface{})
+
+	// When
+	s, err := NewKuboSchema(definition)
+
+	// Then
+	require.NoError(t, err)
+	require.NotNil(t, s.ParametersSchema)
+	assert.True(t, s.ParametersSchema.Properties["password"].Required)
+	require.NotNil(t, s.ContextSchema)
+	assert.Contains(t, s.ContextSchema.Properties, "ingress")
+}
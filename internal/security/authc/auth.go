@@ -63,9 +63,9 @@ func ensureUserAuthenticated() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		_, found := c.Get(constants.OAuth2UserInfo)
 		if !found {
-			log.Warn("Failed to authenticate user")
+			log.Ctx(c).Warn("Failed to authenticate user")
 			c.AbortWithStatusJSON(http.StatusUnauthorized, model.
-				NewServerResponse(model.OkdpServerResponse).GenericError(http.StatusUnauthorized, "Authentication failed"))
+				NewServerResponse(model.OkdpServerResponse).ForRequest(c).GenericError(http.StatusUnauthorized, "Authentication failed"))
 			return
 		}
 	}
@@ -70,37 +70,33 @@ func (p *Provider) authenticate() gin.HandlerFunc {
 
 		authorization := c.Request.Header.Get("Authorization")
 		accessToken := strings.TrimPrefix(authorization, "Bearer ")
-		err := p.verifyAccessToken(accessToken)
+		idToken, err := p.verifyAccessToken(accessToken)
 		if err != nil {
-			log.Warn("Failed to verify access Token: %w", err)
+			log.Ctx(c).Warn("Failed to verify access Token: %w", err)
 			c.AbortWithStatusJSON(http.StatusUnauthorized, model.
-				NewServerResponse(model.OkdpServerResponse).GenericError(http.StatusUnauthorized, "Failed to verify access Token: "+err.Error()))
+				NewServerResponse(model.OkdpServerResponse).ForRequest(c).GenericError(http.StatusUnauthorized, "Failed to verify access Token: "+err.Error()))
 			return
 		}
 
 		userInfo, err = p.getUserInfo(accessToken)
 		if err != nil {
-			log.Warn("Unable to get user roles/groups from access token: %w", err)
+			log.Ctx(c).Warn("Unable to get user roles/groups from access token: %w", err)
 			c.AbortWithStatusJSON(http.StatusUnauthorized, model.
-				NewServerResponse(model.OkdpServerResponse).GenericError(http.StatusUnauthorized, "Unable to get user roles/groups from access token: "+err.Error()))
+				NewServerResponse(model.OkdpServerResponse).ForRequest(c).GenericError(http.StatusUnauthorized, "Unable to get user roles/groups from access token: "+err.Error()))
 			return
 		}
-		log.Debug("Successfully authenticated user : %s", userInfo.AsJSONString())
+		log.Ctx(c).Debug("Successfully authenticated user : %s", userInfo.AsJSONString())
 		c.Set(constants.OAuth2UserInfo, &userInfo)
+		c.Set(constants.OAuth2Expiry, idToken.Expiry)
 		c.Next()
 	}
 }
 
-func (p *Provider) verifyAccessToken(accessToken string) error {
+func (p *Provider) verifyAccessToken(accessToken string) (*oidc.IDToken, error) {
 	if len(strings.TrimSpace(accessToken)) == 0 {
-		return fmt.Errorf("no access token found in the Authorization header")
+		return nil, fmt.Errorf("no access token found in the Authorization header")
 	}
-	_, err := p.Verify(p.Context, accessToken)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return p.Verify(p.Context, accessToken)
 }
 
 func (p *Provider) getUserInfo(accessToken string) (authc.UserInfo, error) {
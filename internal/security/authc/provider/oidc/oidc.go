@@ -82,13 +82,13 @@ func (p *Provider) AuthLogin(c *gin.Context) {
 	state, err := utils.RandomString()
 	if err != nil {
 		c.JSON(http.StatusUnauthorized, model.
-			NewServerResponse(model.OkdpServerResponse).GenericError(http.StatusUnauthorized, "Failed to to create OAuth2 state"))
+			NewServerResponse(model.OkdpServerResponse).ForRequest(c).GenericError(http.StatusUnauthorized, "Failed to to create OAuth2 state"))
 		return
 	}
 	nonce, err := utils.RandomString()
 	if err != nil {
 		c.JSON(http.StatusUnauthorized, model.
-			NewServerResponse(model.OkdpServerResponse).GenericError(http.StatusUnauthorized, "Failed to to create OAuth2 nonce"))
+			NewServerResponse(model.OkdpServerResponse).ForRequest(c).GenericError(http.StatusUnauthorized, "Failed to to create OAuth2 nonce"))
 		return
 	}
 	url := p.Config.AuthCodeURL(state, oidc.Nonce(nonce))
@@ -97,7 +97,7 @@ func (p *Provider) AuthLogin(c *gin.Context) {
 	session.Set(constants.OAuth2Nonce, nonce)
 	if err = session.Save(); err != nil {
 		c.JSON(http.StatusInternalServerError, model.
-			NewServerResponse(model.OkdpServerResponse).GenericError(http.StatusInternalServerError, "Failed to save user session in cookie"))
+			NewServerResponse(model.OkdpServerResponse).ForRequest(c).GenericError(http.StatusInternalServerError, "Failed to save user session in cookie"))
 	}
 	c.Redirect(http.StatusTemporaryRedirect, url)
 }
@@ -121,54 +121,54 @@ func (p *Provider) authenticate() gin.HandlerFunc {
 		maybeUserInfo := session.Get(constants.OAuth2UserInfo)
 		if userInfo, ok = maybeUserInfo.(authc.UserInfo); ok {
 			c.Set(constants.OAuth2UserInfo, userInfo)
-			log.Debug("The user (Email: %s, Subject: %s) was already authenticated", userInfo.Email, userInfo.Subject)
+			log.Ctx(c).Debug("The user (Email: %s, Subject: %s) was already authenticated", userInfo.Email, userInfo.Subject)
 			c.Next()
 			return
 		}
 
 		state := session.Get(constants.OAuth2State)
 		if c.Query("state") != state {
-			log.Warn("Invalid authentication OAuth2 state")
+			log.Ctx(c).Warn("Invalid authentication OAuth2 state")
 			c.AbortWithStatusJSON(http.StatusBadRequest, model.
-				NewServerResponse(model.OkdpServerResponse).GenericError(http.StatusBadRequest, "Invalid authentication OAuth2 'state': "+state.(string)))
+				NewServerResponse(model.OkdpServerResponse).ForRequest(c).GenericError(http.StatusBadRequest, "Invalid authentication OAuth2 'state': "+state.(string)))
 			return
 		}
 		// Exchange the authorization code for an access token
 		token, err := p.Config.Exchange(p.Context, c.Query("code"))
 		if err != nil {
-			log.Warn("Failed to exchange the authorization code with an access token: %w", err)
+			log.Ctx(c).Warn("Failed to exchange the authorization code with an access token: %w", err)
 			c.AbortWithStatusJSON(http.StatusUnauthorized, model.
-				NewServerResponse(model.OkdpServerResponse).GenericError(http.StatusUnauthorized, "Failed to exchange authorization code with an access token: "+err.Error()))
+				NewServerResponse(model.OkdpServerResponse).ForRequest(c).GenericError(http.StatusUnauthorized, "Failed to exchange authorization code with an access token: "+err.Error()))
 			return
 		}
 
 		rawIDToken, ok := token.Extra("id_token").(string)
 		if !ok {
-			log.Warn("No id_token field found in the OAuth2 token")
+			log.Ctx(c).Warn("No id_token field found in the OAuth2 token")
 			c.AbortWithStatusJSON(http.StatusUnauthorized, model.
-				NewServerResponse(model.OkdpServerResponse).GenericError(http.StatusUnauthorized, "No id_token field found in the OAuth2 token"))
+				NewServerResponse(model.OkdpServerResponse).ForRequest(c).GenericError(http.StatusUnauthorized, "No id_token field found in the OAuth2 token"))
 			return
 		}
 		idToken, err := p.Verify(p.Context, rawIDToken)
 		if err != nil {
-			log.Warn("Failed to verify the ID Token: %w", err)
+			log.Ctx(c).Warn("Failed to verify the ID Token: %w", err)
 			c.AbortWithStatusJSON(http.StatusUnauthorized, model.
-				NewServerResponse(model.OkdpServerResponse).GenericError(http.StatusUnauthorized, "Failed to verify the ID Token: "+err.Error()))
+				NewServerResponse(model.OkdpServerResponse).ForRequest(c).GenericError(http.StatusUnauthorized, "Failed to verify the ID Token: "+err.Error()))
 			return
 		}
 
 		nonce := session.Get(constants.OAuth2Nonce)
 		if idToken.Nonce != nonce {
-			log.Warn("Invalid authentication OAuth2 'nonce': %s", nonce.(string))
+			log.Ctx(c).Warn("Invalid authentication OAuth2 'nonce': %s", nonce.(string))
 			c.AbortWithStatusJSON(http.StatusUnauthorized, model.
-				NewServerResponse(model.OkdpServerResponse).GenericError(http.StatusUnauthorized, "Invalid authentication OAuth2 'nonce': "+nonce.(string)))
+				NewServerResponse(model.OkdpServerResponse).ForRequest(c).GenericError(http.StatusUnauthorized, "Invalid authentication OAuth2 'nonce': "+nonce.(string)))
 			return
 		}
 		userInfo, err = p.getUserInfo(token.AccessToken)
 		if err != nil {
-			log.Warn("Unable to get user roles/groups from the access token: %w", err)
+			log.Ctx(c).Warn("Unable to get user roles/groups from the access token: %w", err)
 			c.AbortWithStatusJSON(http.StatusUnauthorized, model.
-				NewServerResponse(model.OkdpServerResponse).GenericError(http.StatusUnauthorized, "Unable to get user roles/groups from access token: "+err.Error()))
+				NewServerResponse(model.OkdpServerResponse).ForRequest(c).GenericError(http.StatusUnauthorized, "Unable to get user roles/groups from access token: "+err.Error()))
 			return
 		}
 		// Retrieve the user information from the access token
@@ -189,7 +189,7 @@ func (p *Provider) authenticate() gin.HandlerFunc {
 		// }
 		c.Set(constants.OAuth2UserInfo, userInfo)
 		session.Set(constants.OAuth2UserInfo, userInfo)
-		log.Debug("Successfully authenticated user : %s", userInfo.AsJSONString())
+		log.Ctx(c).Debug("Successfully authenticated user : %s", userInfo.AsJSONString())
 		c.Next()
 	}
 }
@@ -23,6 +23,8 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/casbin/casbin/v2"
 	"github.com/gin-gonic/gin"
@@ -35,11 +37,18 @@ import (
 )
 
 type Enforcer struct {
-	*casbin.Enforcer
+	*casbin.SyncedEnforcer
+	// policyPath is the policy file of the file provider, reloaded when it changes
+	policyPath    string
+	policyModTime time.Time
+	mu            sync.Mutex
 }
 
+// recheckKey is the context key of the function re-evaluating the authorization of the current request
+const recheckKey = "authz.recheck"
+
 func newEnforcer(authZConf config.AuthZ) (*Enforcer, error) {
-	var e *casbin.Enforcer
+	var e *casbin.SyncedEnforcer
 	var err error
 	authzProvider := strings.ToLower(authZConf.Provider)
 	switch authzProvider {
@@ -49,17 +58,22 @@ func newEnforcer(authZConf config.AuthZ) (*Enforcer, error) {
 			return nil, er
 		}
 		log.Info("Loading casbin configuration files, Model file: %s, Policy file: %s", modelFilePath, policyFilePath)
-		e, err = casbin.NewEnforcer(modelFilePath, policyFilePath)
+		e, err = casbin.NewSyncedEnforcer(modelFilePath, policyFilePath)
 	case "file":
 		file := authZConf.File
 		log.Info("Loading casbin configuration files, Model file: %s, Policy file: %s", file.ModelPath, file.PolicyPath)
-		e, err = casbin.NewEnforcer(file.ModelPath, file.PolicyPath)
+		info, er := os.Stat(file.PolicyPath)
+		if er != nil {
+			return nil, er
+		}
+		e, err = casbin.NewSyncedEnforcer(file.ModelPath, file.PolicyPath)
+		return &Enforcer{SyncedEnforcer: e, policyPath: file.PolicyPath, policyModTime: info.ModTime()}, err
 	case "database":
 		return nil, fmt.Errorf("database provider option not implemented")
 	default:
 		return nil, fmt.Errorf("provider option '%s' not recognized, valid ones: inline or file", authZConf.Provider)
 	}
-	return &Enforcer{e}, err
+	return &Enforcer{SyncedEnforcer: e}, err
 }
 
 // Authorizer returns a middleware that will authorize the user to access resources based on the policy and model configuration.
@@ -79,50 +93,120 @@ func (e *Enforcer) authorize() gin.HandlerFunc {
 		)
 		userInfo, ok := c.Get(constants.OAuth2UserInfo)
 		if !ok {
-			log.Warn("Unable to authorize user, no user informtaion found in context")
+			log.Ctx(c).Warn("Unable to authorize user, no user informtaion found in context")
 			c.AbortWithStatusJSON(http.StatusUnauthorized, model.
-				NewServerResponse(model.OkdpServerResponse).GenericError(http.StatusUnauthorized, "Unable to authorize user, no user informtaion found in context"))
+				NewServerResponse(model.OkdpServerResponse).ForRequest(c).GenericError(http.StatusUnauthorized, "Unable to authorize user, no user informtaion found in context"))
 			return
 		}
-		email := userInfo.(*authc.UserInfo).Email
-		sub := userInfo.(*authc.UserInfo).Subject
+		user := userInfo.(*authc.UserInfo)
+		email := user.Email
+		sub := user.Subject
 
-		rSub := userInfo.(*authc.UserInfo).Roles
 		rObj := c.Request.URL.Path
-		rAct := c.Request.Method
+		rAct := requestAction(c)
 
-		roles := utils.Map(rSub, func(s string) string {
-			return constants.CasbinRolePrefix + s
-		})
-
-		// Check the role is allowed to access the path with the action
-		for _, role := range roles {
-			allowed1, err1 := e.Enforce(role, rObj, rAct)
-			allowed = allowed || allowed1
-			err = errr.Join(err, err1)
-			if allowed {
-				break
-			}
-		}
+		e.reloadPolicy()
+		allowed, err = e.isAllowed(user, rObj, rAct)
 
 		if err != nil {
-			log.Warn("Unable to authorize user (%s/%s): %s", email, sub, err.Error())
+			log.Ctx(c).Warn("Unable to authorize user (%s/%s): %s", email, sub, err.Error())
 			c.AbortWithStatusJSON(http.StatusUnauthorized, model.
-				NewServerResponse(model.OkdpServerResponse).GenericError(http.StatusUnauthorized, err.Error()))
+				NewServerResponse(model.OkdpServerResponse).ForRequest(c).GenericError(http.StatusUnauthorized, err.Error()))
 			return
 		}
 		if !allowed {
-			log.Warn("User (%s/%s) not allowed to execute the action", email, sub)
+			log.Ctx(c).Warn("User (%s/%s) not allowed to execute the action", email, sub)
 			c.AbortWithStatusJSON(http.StatusUnauthorized, model.
-				NewServerResponse(model.OkdpServerResponse).GenericError(http.StatusUnauthorized, "Unauthorized action"))
+				NewServerResponse(model.OkdpServerResponse).ForRequest(c).GenericError(http.StatusUnauthorized, "Unauthorized action"))
 			return
 		}
 
+		expiry := c.GetTime(constants.OAuth2Expiry)
+		c.Set(recheckKey, func() bool {
+			if !expiry.IsZero() && time.Now().After(expiry) {
+				return false
+			}
+			e.reloadPolicy()
+			allowed, err := e.isAllowed(user, rObj, rAct)
+			return allowed && err == nil
+		})
 		c.Next()
 	}
 
 }
 
+// requestAction returns the casbin action of the request: the dedicated EXEC and PROXY actions for the pod exec sessions
+// and the release proxy, the HTTP method otherwise. The route is used rather than the path so that a resource named
+// 'exec' or 'proxy' is not confused.
+func requestAction(c *gin.Context) string {
+	route := c.FullPath()
+	switch {
+	case strings.HasSuffix(route, constants.PodExecRouteSuffix):
+		return constants.CasbinExecAction
+	case strings.HasSuffix(route, constants.ReleaseProxyRoute):
+		return constants.CasbinProxyAction
+	default:
+		return c.Request.Method
+	}
+}
+
+// isAllowed checks whether one of the roles of the user is allowed to access the path with the action
+func (e *Enforcer) isAllowed(user *authc.UserInfo, obj string, act string) (bool, error) {
+	var (
+		allowed = false
+		err     error
+	)
+	roles := utils.Map(user.Roles, func(s string) string {
+		return constants.CasbinRolePrefix + s
+	})
+
+	for _, role := range roles {
+		allowed1, err1 := e.Enforce(role, obj, act)
+		allowed = allowed || allowed1
+		err = errr.Join(err, err1)
+		if allowed {
+			break
+		}
+	}
+	return allowed, err
+}
+
+// reloadPolicy reloads the policy file of the file provider when it was modified since it was loaded.
+// The inline policy is part of the configuration and does not change while the server runs.
+func (e *Enforcer) reloadPolicy() {
+	if e.policyPath == "" {
+		return
+	}
+	info, err := os.Stat(e.policyPath)
+	if err != nil {
+		log.Warn("Keeping the loaded casbin policy, unable to read the policy file %s: %s", e.policyPath, err.Error())
+		return
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if info.ModTime().Equal(e.policyModTime) {
+		return
+	}
+	if err := e.LoadPolicy(); err != nil {
+		log.Warn("Keeping the loaded casbin policy, unable to reload the policy file %s: %s", e.policyPath, err.Error())
+		return
+	}
+	log.Info("Reloaded the casbin policy file %s", e.policyPath)
+	e.policyModTime = info.ModTime()
+}
+
+// StillAllowed re-evaluates the authorization of the current request, it is used by the long-lived requests (streams)
+// to check the user is still allowed on each heartbeat. The user is no longer allowed once the bearer token expired,
+// or when the policy file (file provider) was modified and no longer grants the access.
+func StillAllowed(c *gin.Context) bool {
+	recheck, ok := c.Get(recheckKey)
+	if !ok {
+		return true
+	}
+	return recheck.(func() bool)()
+}
+
 func writeFilesToTmp(modelStr, policyStr string) (modelFilePath, policyFilePath string, err error) {
 	modelFilePath = filepath.Join("/tmp", "authz-model.conf")
 	policyFilePath = filepath.Join("/tmp", "authz-policy.csv")
@@ -19,13 +19,17 @@ package authz
 import (
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/okdp/okdp-server/internal/common/constants"
 	log "github.com/okdp/okdp-server/internal/common/logging"
 	"github.com/okdp/okdp-server/internal/config"
 	"github.com/okdp/okdp-server/internal/security/authc/model"
+	"github.com/okdp/okdp-server/internal/utils"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -137,6 +141,149 @@ func Test_AuthZ_InLine(t *testing.T) {
 	assert.False(t, called, "The endpoint should not be called")
 }
 
+func Test_AuthZ_StillAllowed(t *testing.T) {
+	// Given
+	log.SetupGlobalLogger(config.Logging{})
+	e, err := newEnforcer(config.AuthZ{Provider: "file",
+		File: config.FileAuthZ{
+			ModelPath:  "testdata/authz-model.conf",
+			PolicyPath: "testdata/authz-policy.csv",
+		},
+	})
+	assert.NoError(t, err)
+	e.EnableAutoSave(false)
+	var before, after bool
+	resp := httptest.NewRecorder()
+	gin.SetMode(gin.TestMode)
+	c, router := gin.CreateTestContext(resp)
+	router.Use(Set(constants.OAuth2UserInfo, &model.UserInfo{Roles: []string{"developers"}}))
+	router.Use(e.authorize())
+	router.GET("/api/v1/spaces/1/composition/1/deployment", func(c *gin.Context) {
+		before = StillAllowed(c)
+		_, _ = e.RemovePolicy("role:developers", "/api/v1/spaces/1/composition/*/deployment", "*")
+		_, _ = e.RemovePolicy("role:viewers", "/api/v1/spaces/1/composition/*/deployment", "GET")
+		after = StillAllowed(c)
+	})
+
+	// When
+	c.Request, _ = http.NewRequest(http.MethodGet, "/api/v1/spaces/1/composition/1/deployment", nil)
+	router.HandleContext(c)
+
+	// Then - Ensure the authorization is re-evaluated against the updated policy
+	assert.Equal(t, http.StatusOK, resp.Code, "The response code should be 200")
+	assert.True(t, before, "The user should be allowed before the policy change")
+	assert.False(t, after, "The user should no longer be allowed after the policy change")
+}
+
+func Test_AuthZ_StillAllowed_TokenExpired(t *testing.T) {
+	// Given
+	log.SetupGlobalLogger(config.Logging{})
+	var allowed bool
+	resp := httptest.NewRecorder()
+	gin.SetMode(gin.TestMode)
+	c, router := gin.CreateTestContext(resp)
+	router.Use(Set(constants.OAuth2UserInfo, &model.UserInfo{Roles: []string{"developers"}}))
+	router.Use(Set(constants.OAuth2Expiry, time.Now().Add(50*time.Millisecond)))
+	router.Use(Authorizer(config.AuthZ{Provider: "file",
+		File: config.FileAuthZ{
+			ModelPath:  "testdata/authz-model.conf",
+			PolicyPath: "testdata/authz-policy.csv",
+		},
+	}))
+	router.GET("/api/v1/spaces/1/composition/1/deployment", func(c *gin.Context) {
+		time.Sleep(100 * time.Millisecond)
+		allowed = StillAllowed(c)
+	})
+
+	// When
+	c.Request, _ = http.NewRequest(http.MethodGet, "/api/v1/spaces/1/composition/1/deployment", nil)
+	router.HandleContext(c)
+
+	// Then - Ensure the user is no longer allowed once the token expired
+	assert.Equal(t, http.StatusOK, resp.Code, "The response code should be 200")
+	assert.False(t, allowed, "The user should no longer be allowed after the token expiry")
+}
+
+func Test_AuthZ_StillAllowed_PolicyFileModified(t *testing.T) {
+	// Given
+	log.SetupGlobalLogger(config.Logging{})
+	policyPath := filepath.Join(t.TempDir(), "authz-policy.csv")
+	policy, err := os.ReadFile("testdata/authz-policy.csv")
+	assert.NoError(t, err)
+	assert.NoError(t, os.WriteFile(policyPath, policy, 0644))
+	var before, after bool
+	resp := httptest.NewRecorder()
+	gin.SetMode(gin.TestMode)
+	c, router := gin.CreateTestContext(resp)
+	router.Use(Set(constants.OAuth2UserInfo, &model.UserInfo{Roles: []string{"developers"}}))
+	router.Use(Authorizer(config.AuthZ{Provider: "file",
+		File: config.FileAuthZ{
+			ModelPath:  "testdata/authz-model.conf",
+			PolicyPath: policyPath,
+		},
+	}))
+	router.GET("/api/v1/spaces/1/composition/1/deployment", func(c *gin.Context) {
+		before = StillAllowed(c)
+		assert.NoError(t, os.WriteFile(policyPath, []byte("p, role:admins, /api/v1/spaces/*/composition, *\n"), 0644))
+		assert.NoError(t, os.Chtimes(policyPath, time.Now().Add(time.Minute), time.Now().Add(time.Minute)))
+		after = StillAllowed(c)
+	})
+
+	// When
+	c.Request, _ = http.NewRequest(http.MethodGet, "/api/v1/spaces/1/composition/1/deployment", nil)
+	router.HandleContext(c)
+
+	// Then - Ensure the modified policy file is reloaded
+	assert.Equal(t, http.StatusOK, resp.Code, "The response code should be 200")
+	assert.True(t, before, "The user should be allowed before the policy change")
+	assert.False(t, after, "The user should no longer be allowed after the policy file change")
+}
+
+func Test_AuthZ_DedicatedActions(t *testing.T) {
+	proxyRoute := "/api/v1" + constants.ReleaseProxyRoute
+	proxyPath := "/api/v1/clusters/1/namespaces/team-a/releases/spark/proxy/services/spark-history/http/jobs/"
+	tests := []struct {
+		name   string
+		role   string
+		method string
+		route  string
+		path   string
+		status int
+	}{
+		{name: "exec granted by the EXEC action", role: "admins", route: "/api/v1/spaces/1/pods/:pod/exec", path: "/api/v1/spaces/1/pods/spark-0/exec", status: http.StatusOK},
+		{name: "exec not granted by the wildcard action", role: "developers", route: "/api/v1/spaces/1/pods/:pod/exec", path: "/api/v1/spaces/1/pods/spark-0/exec", status: http.StatusUnauthorized},
+		{name: "other routes granted by the wildcard action", role: "developers", route: "/api/v1/spaces/1/pods/:pod", path: "/api/v1/spaces/1/pods/exec", status: http.StatusOK},
+		{name: "proxy granted by the PROXY action", role: "admins", route: proxyRoute, path: proxyPath, status: http.StatusOK},
+		{name: "proxy granted by the PROXY action whatever the method", role: "admins", method: http.MethodPost, route: proxyRoute, path: proxyPath, status: http.StatusOK},
+		{name: "proxy not granted by the wildcard action", role: "developers", route: proxyRoute, path: proxyPath, status: http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			log.SetupGlobalLogger(config.Logging{})
+			authzConfig := config.AuthZ{Provider: "file",
+				File: config.FileAuthZ{
+					ModelPath:  "testdata/authz-model.conf",
+					PolicyPath: "testdata/authz-policy.csv",
+				},
+			}
+			resp := httptest.NewRecorder()
+			gin.SetMode(gin.TestMode)
+			c, router := gin.CreateTestContext(resp)
+			router.Use(Set(constants.OAuth2UserInfo, &model.UserInfo{Roles: []string{tt.role}}))
+			router.Use(Authorizer(authzConfig))
+			router.Any(tt.route, func(_ *gin.Context) {})
+
+			// When
+			c.Request, _ = http.NewRequest(utils.DefaultIfEmpty(tt.method, http.MethodGet), tt.path, nil)
+			router.HandleContext(c)
+
+			// Then
+			assert.Equal(t, tt.status, resp.Code)
+		})
+	}
+}
+
 func Set(key string, value any) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		c.Set(key, value)
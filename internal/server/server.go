@@ -17,25 +17,33 @@
 package server
 
 import (
+	"context"
 	"fmt"
+	"net"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 
+	"github.com/okdp/okdp-server/internal/audit"
 	"github.com/okdp/okdp-server/internal/common/constants"
 	log "github.com/okdp/okdp-server/internal/common/logging"
 	"github.com/okdp/okdp-server/internal/config"
 	"github.com/okdp/okdp-server/internal/controllers"
+	"github.com/okdp/okdp-server/internal/integrations/k8s"
+	"github.com/okdp/okdp-server/internal/negotiation"
 	"github.com/okdp/okdp-server/internal/security"
 	"github.com/okdp/okdp-server/internal/security/authc"
 	"github.com/okdp/okdp-server/internal/security/authz"
+	"github.com/okdp/okdp-server/internal/validation"
 )
 
-func NewOKDPServer(config *config.ApplicationConfig) *http.Server {
+// NewOKDPServer returns the server, the informers and the requests live as long as ctx
+func NewOKDPServer(ctx context.Context, config *config.ApplicationConfig) *http.Server {
 
+	k8s.SetServerContext(ctx)
 	gin.SetMode(config.Server.Mode)
-	r := &controllers.Router{gin.New()}                               //nolint:all
-	apiV1 := &controllers.Group{r.Group(constants.OkdpServerBaseURL)} //nolint:all
+	r := &controllers.Router{Engine: gin.New()}
+	apiV1 := &controllers.Group{RouterGroup: r.Group(constants.OkdpServerBaseURL)}
 
 	r.Use(log.Logger()...)
 	r.Use(gin.Recovery())
@@ -45,10 +53,17 @@ func NewOKDPServer(config *config.ApplicationConfig) *http.Server {
 	// https://github.com/gin-gonic/gin/issues/3546
 	apiV1.Use(security.HTTPSecurity(config.Security)...)
 
+	// YAML request bodies and responses (content negotiation)
+	apiV1.Use(negotiation.YAML())
+
 	// Authentication
 	apiV1.Use(authc.Authenticator(config.Security.AuthN)...)
+	// Audit of the mutating operations (including the denied ones)
+	apiV1.Use(audit.Recorder())
 	// Authorization
 	apiV1.Use(authz.Authorizer(config.Security.AuthZ))
+	// Validation of the requests and responses against the OpenAPI specification
+	apiV1.Use(validation.OpenAPIValidator(config.Validation))
 
 	// Register Controllers
 	apiV1.RegisterControllers()
@@ -56,8 +71,9 @@ func NewOKDPServer(config *config.ApplicationConfig) *http.Server {
 	r.RegisterHealth()
 
 	server := &http.Server{
-		Handler: r,
-		Addr:    fmt.Sprintf("%s:%d", config.Server.ListenAddress, config.Server.Port),
+		Handler:     r,
+		Addr:        fmt.Sprintf("%s:%d", config.Server.ListenAddress, config.Server.Port),
+		BaseContext: func(net.Listener) context.Context { return ctx },
 	}
 
 	return server
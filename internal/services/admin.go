@@ -0,0 +1,222 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package services
+
+import (
+	"context"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/okdp/okdp-server/internal/audit"
+	log "github.com/okdp/okdp-server/internal/common/logging"
+	"github.com/okdp/okdp-server/internal/common/version"
+	"github.com/okdp/okdp-server/internal/config"
+	"github.com/okdp/okdp-server/internal/integrations/k8s"
+	"github.com/okdp/okdp-server/internal/model"
+	"github.com/okdp/okdp-server/internal/utils"
+)
+
+// AdminService provides the cross-namespace and cross-cluster views of the admin API.
+// The unreachable clusters are skipped (with a warning) from the cross-cluster listings
+// so that a single failing cluster does not hide the others.
+type AdminService struct {
+	k8s *k8s.K8S
+}
+
+func NewAdminService() *AdminService {
+	return &AdminService{
+		k8s: k8s.NewK8S(),
+	}
+}
+
+// ListClusterReleases returns the releases of all the namespaces of a cluster
+func (s AdminService) ListClusterReleases(ctx context.Context, clusterID string, options *model.ListOptions) (*model.ListResult[*model.Release], *model.ServerResponse) {
+	return s.k8s.ListReleasesPage(ctx, clusterID, "", options)
+}
+
+func (s AdminService) ListReleases(ctx context.Context, clusterID *string, options *model.ListOptions) (*model.ListResult[*model.ReleaseSummary], *model.ServerResponse) {
+	clusters, err := selectClusters(s.k8s, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	summaries := collectClusters(ctx, clusters, "releases", func(clusterID string) ([]*model.ReleaseSummary, *model.ServerResponse) {
+		releases, err := s.k8s.ListReleases(ctx, clusterID)
+		if err != nil {
+			return nil, err
+		}
+		summaries := []*model.ReleaseSummary{}
+		for _, release := range releases {
+			if options.MatchesLabels(release.Labels) {
+				summaries = append(summaries, release.ToSummary(clusterID))
+			}
+		}
+		return summaries, nil
+	})
+
+	return model.List(summaries, options, func(r *model.ReleaseSummary) bool {
+		return r.Matches(options)
+	}, model.ReleaseSummaryComparators)
+}
+
+func (s AdminService) ListGitSources(ctx context.Context, clusterID *string, options *model.ListOptions) (*model.ListResult[*model.GitSource], *model.ServerResponse) {
+	clusters, err := selectClusters(s.k8s, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	sources := collectClusters(ctx, clusters, "git sources", func(clusterID string) ([]*model.GitSource, *model.ServerResponse) {
+		repos, err := s.k8s.ListGitRepositories(ctx, clusterID)
+		if err != nil {
+			return nil, err
+		}
+		kustomizations, err := s.k8s.ListKustomizations(ctx, clusterID)
+		if err != nil {
+			return nil, err
+		}
+		sources := []*model.GitSource{}
+		for _, repo := range repos {
+			sources = append(sources, model.ToGitSource(clusterID, repo, kustomizations))
+		}
+		return sources, nil
+	})
+
+	return model.List(sources, options, func(g *model.GitSource) bool {
+		return g.Matches(options)
+	}, model.GitSourceComparators)
+}
+
+func (s AdminService) ListProjects(ctx context.Context, clusterID *string, options *model.ListOptions) (*model.ListResult[*model.ProjectStats], *model.ServerResponse) {
+	clusters, err := selectClusters(s.k8s, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	projects := collectClusters(ctx, clusters, "projects", func(clusterID string) ([]*model.ProjectStats, *model.ServerResponse) {
+		namespaces, err := s.k8s.ListNamespaces(ctx, clusterID)
+		if err != nil {
+			return nil, err
+		}
+		releases, err := s.k8s.ListReleases(ctx, clusterID)
+		if err != nil {
+			return nil, err
+		}
+		pods, err := s.k8s.ListPods(ctx, clusterID)
+		if err != nil {
+			return nil, err
+		}
+		return projectStats(clusterID, namespaces, releases, pods, options), nil
+	})
+
+	return model.List(projects, options, func(p *model.ProjectStats) bool {
+		return p.Matches(options)
+	}, model.ProjectStatsComparators)
+}
+
+// collectClusters lists the items of the clusters concurrently, in the order of the clusters. The clusters which
+// cannot be listed are skipped with a warning.
+func collectClusters[T any](ctx context.Context, clusters []*model.Cluster, what string, list func(clusterID string) ([]T, *model.ServerResponse)) []T {
+	results := make([][]T, len(clusters))
+	var wg sync.WaitGroup
+	for i, cluster := range clusters {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			items, err := list(cluster.ID)
+			if err != nil {
+				log.Ctx(ctx).Warn("Skipping %s of cluster '%s', details: %+v", what, cluster.ID, err)
+				return
+			}
+			results[i] = items
+		}()
+	}
+	wg.Wait()
+
+	items := []T{}
+	for _, result := range results {
+		items = append(items, result...)
+	}
+	return items
+}
+
+func (s AdminService) GetSystemInfo() *model.SystemInfo {
+	appConfig := config.GetAppConfig()
+
+	clusters := []model.ClusterInfo{}
+	for _, cluster := range s.k8s.ListClusters() {
+		info := model.ClusterInfo{
+			Id:  cluster.ID,
+			Env: utils.EmptyToNil(cluster.Env),
+		}
+		kubernetesVersion, err := s.k8s.ServerVersion(cluster.ID)
+		if err != nil {
+			info.Message = &err.Message
+		} else {
+			info.Reachable = true
+			info.KubernetesVersion = &kubernetesVersion
+		}
+		clusters = append(clusters, info)
+	}
+
+	catalogs := len(appConfig.Catalogs)
+	auditEnabled := audit.GetAuditor().Enabled()
+
+	return &model.SystemInfo{
+		Version:      version.Get(),
+		GoVersion:    version.GoVersion(),
+		StartTime:    version.StartTime(),
+		Uptime:       version.Uptime().String(),
+		Catalogs:     &catalogs,
+		AuditEnabled: &auditEnabled,
+		Clusters:     clusters,
+	}
+}
+
+// projectStats returns the statistics of the projects (namespaces) matching the label selector
+func projectStats(clusterID string, namespaces []*model.Namespace, releases []*model.Release, pods []*corev1.Pod, options *model.ListOptions) []*model.ProjectStats {
+	releasesByNamespace := map[string]int{}
+	for _, release := range releases {
+		releasesByNamespace[release.Namespace]++
+	}
+	podsByNamespace := map[string][]*corev1.Pod{}
+	for _, pod := range pods {
+		podsByNamespace[pod.Namespace] = append(podsByNamespace[pod.Namespace], pod)
+	}
+
+	projects := []*model.ProjectStats{}
+	for _, ns := range namespaces {
+		if !options.MatchesLabels(ns.GetLabels()) {
+			continue
+		}
+		name := ns.Metadata.Name
+		projects = append(projects, ns.ToProjectStats(clusterID, releasesByNamespace[name], podsByNamespace[name]))
+	}
+	return projects
+}
+
+// selectClusters returns the requested cluster, or all the configured clusters if none is requested
+func selectClusters(k *k8s.K8S, clusterID *string) ([]*model.Cluster, *model.ServerResponse) {
+	if clusterID == nil || *clusterID == "" {
+		return k.ListClusters(), nil
+	}
+	cluster, err := k.GetCluster(*clusterID)
+	if err != nil {
+		return nil, err
+	}
+	return []*model.Cluster{cluster}, nil
+}
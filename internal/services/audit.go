@@ -0,0 +1,36 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package services
+
+import (
+	"github.com/okdp/okdp-server/internal/audit"
+	"github.com/okdp/okdp-server/internal/model"
+)
+
+type AuditService struct {
+	auditor *audit.Auditor
+}
+
+func NewAuditService() *AuditService {
+	return &AuditService{
+		auditor: audit.GetAuditor(),
+	}
+}
+
+func (s AuditService) ListEvents(filter *model.AuditFilter) ([]*model.AuditEvent, *model.ServerResponse) {
+	return s.auditor.Query(filter)
+}
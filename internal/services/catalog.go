@@ -17,8 +17,12 @@
 package services
 
 import (
+	"context"
+
 	"github.com/okdp/okdp-server/internal/integrations/oci"
 	"github.com/okdp/okdp-server/internal/model"
+	schema "github.com/okdp/okdp-server/internal/schema/parameters"
+	"github.com/okdp/okdp-server/internal/utils"
 )
 
 type CatalogService struct {
@@ -43,10 +47,76 @@ func (s CatalogService) GetPackages(catalogID string) ([]*model.Package, *model.
 	return s.catalog.GetPackages(catalogID)
 }
 
+func (s CatalogService) ListPackages(catalogID string, options *model.ListOptions) (*model.ListResult[*model.Package], *model.ServerResponse) {
+	packages, err := s.catalog.GetPackages(catalogID)
+	if err != nil {
+		return nil, err
+	}
+	return model.List(packages, options, func(p *model.Package) bool {
+		return model.MatchesPackage(p, options)
+	}, model.PackageComparators)
+}
+
 func (s CatalogService) GetPackage(catalogID string, name string) (*model.Package, *model.ServerResponse) {
 	return s.catalog.GetPackage(catalogID, name)
 }
 
-func (s CatalogService) GetPackageDefinition(catalogID string, name string, version string) (map[string]interface{}, *model.ServerResponse) {
-	return s.catalog.GetPackageDefinition(catalogID, name, version)
+func (s CatalogService) GetPackageDefinition(ctx context.Context, catalogID string, name string, version string) (map[string]interface{}, *model.ServerResponse) {
+	return s.catalog.GetPackageDefinition(ctx, catalogID, name, version)
+}
+
+// GetReleaseSkeleton returns a release of the package version with the parameters populated from the schema defaults.
+// The release name defaults to the package name.
+func (s CatalogService) GetReleaseSkeleton(ctx context.Context, catalogID string, name string, version string, releaseName string, namespace string, targetNamespace string) (*model.ReleaseSkeleton, *model.ServerResponse) {
+	catalog, err := s.catalog.GetCatalog(catalogID)
+	if err != nil {
+		return nil, err
+	}
+	kuboSchema, err := s.getKuboSchema(ctx, catalogID, name, version)
+	if err != nil {
+		return nil, err
+	}
+
+	var parameters interface{}
+	required, missing := []string{}, []string{}
+	if kuboSchema.ParametersSchema != nil {
+		parameters = kuboSchema.ParametersSchema.Defaults()
+		required, missing = kuboSchema.ParametersSchema.RequiredFields("spec.parameters")
+	}
+
+	return model.NewReleaseSkeleton(catalog.PackageRepository(name), version, utils.DefaultIfEmpty(releaseName, name), namespace, targetNamespace, parameters, required, missing)
+}
+
+// GetPackageJSONSchema returns the parameters and context schemas of the package version as JSON Schema documents.
+// Like KuboCD, a package without parameters schema accepts no parameter while a missing context schema accepts any context.
+func (s CatalogService) GetPackageJSONSchema(ctx context.Context, catalogID string, name string, version string) (*model.PackageJSONSchema, *model.ServerResponse) {
+	kuboSchema, err := s.getKuboSchema(ctx, catalogID, name, version)
+	if err != nil {
+		return nil, err
+	}
+	parametersSchema, contextSchema := kuboSchema.ParametersSchema, kuboSchema.ContextSchema
+	if parametersSchema == nil {
+		parametersSchema = &schema.KuboSchemaItem{Type: "object"}
+	}
+	if contextSchema == nil {
+		allowed := true
+		contextSchema = &schema.KuboSchemaItem{Type: "object", AdditionalProperties: &allowed}
+	}
+	return &model.PackageJSONSchema{
+		Parameters: parametersSchema.ToJSONSchema(),
+		Context:    contextSchema.ToJSONSchema(),
+	}, nil
+}
+
+func (s CatalogService) getKuboSchema(ctx context.Context, catalogID string, name string, version string) (*schema.KuboSchema, *model.ServerResponse) {
+	definition, err := s.catalog.GetPackageDefinition(ctx, catalogID, name, version)
+	if err != nil {
+		return nil, err
+	}
+	kuboSchema, er := schema.NewKuboSchema(definition)
+	if er != nil {
+		return nil, model.NewServerResponse(model.OkdpServerResponse).
+			UnprocessableEntity("Unable to read the schema of the package '%s:%s' in catalog '%s': %v", name, version, catalogID, er)
+	}
+	return kuboSchema, nil
 }
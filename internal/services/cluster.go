@@ -17,8 +17,11 @@
 package services
 
 import (
+	"context"
+
 	"github.com/okdp/okdp-server/internal/integrations/k8s"
 	"github.com/okdp/okdp-server/internal/model"
+	"github.com/okdp/okdp-server/internal/utils"
 )
 
 type ClusterService struct {
@@ -39,22 +42,86 @@ func (s ClusterService) GetCluster(clusterID string) (*model.Cluster, *model.Ser
 	return s.cluster.GetCluster(clusterID)
 }
 
-func (s ClusterService) ListNamespaces(clusterID string) ([]*model.Namespace, *model.ServerResponse) {
-	return s.cluster.ListNamespaces(clusterID)
+func (s ClusterService) ListNamespaces(ctx context.Context, clusterID string) ([]*model.Namespace, *model.ServerResponse) {
+	return s.cluster.ListNamespaces(ctx, clusterID)
+}
+
+func (s ClusterService) ListNamespacesPage(ctx context.Context, clusterID string, options *model.ListOptions) (*model.ListResult[*model.Namespace], *model.ServerResponse) {
+	namespaces, err := s.cluster.ListNamespaces(ctx, clusterID)
+	if err != nil {
+		return nil, err
+	}
+	return model.List(namespaces, options, func(ns *model.Namespace) bool {
+		return ns.Matches(options)
+	}, model.NamespaceComparators)
+}
+
+func (s ClusterService) GetNamespaceByName(ctx context.Context, clusterID string, namespace string) (*model.Namespace, *model.ServerResponse) {
+	return s.cluster.GetNamespaceByName(ctx, clusterID, namespace)
+}
+
+func (s ClusterService) CreateNamespace(ctx context.Context, clusterID string, namespace *model.Namespace) *model.ServerResponse {
+	return s.cluster.CreateNamespace(ctx, clusterID, namespace)
 }
 
-func (s ClusterService) GetNamespaceByName(clusterID string, namespace string) (*model.Namespace, *model.ServerResponse) {
-	return s.cluster.GetNamespaceByName(clusterID, namespace)
+func (s ClusterService) UpdateNamespace(ctx context.Context, clusterID string, namespace *model.Namespace, ifMatch string) *model.ServerResponse {
+	return s.cluster.UpdateNamespace(ctx, clusterID, namespace, ifMatch)
 }
 
-func (s ClusterService) CreateNamespace(clusterID string, namespace *model.Namespace) *model.ServerResponse {
-	return s.cluster.CreateNamespace(clusterID, namespace)
+// PatchProject applies the patch to the project representation of the namespace and returns the updated namespace.
+// The update is conditioned by the version of the namespace the patch was applied to.
+func (s ClusterService) PatchProject(ctx context.Context, clusterID string, projectName string, patch *model.Patch, dryRun bool, ifMatch string) (*model.Namespace, *model.ServerResponse) {
+	ns, err := s.cluster.GetNamespaceByName(ctx, clusterID, projectName)
+	if err != nil {
+		return nil, err
+	}
+	version := utils.OrEmpty(ns.Metadata.ResourceVersion)
+	if !utils.MatchesETag(ifMatch, version) {
+		return nil, model.NewServerResponse(model.OkdpServerResponse).
+			PreconditionFailed("Project '%s' has been modified since version '%s', get it again and retry", projectName, ifMatch)
+	}
+
+	var project model.Project
+	if err := patch.ApplyTo(ns.ToProject(), &project); err != nil {
+		return nil, err
+	}
+	if project.Name != projectName {
+		return nil, model.NewServerResponse(model.OkdpServerResponse).
+			BadRequest("The project name '%s' cannot be patched", projectName).
+			WithCode(model.ErrPatchFailed)
+	}
+
+	patched := project.ToNamespace()
+	if dryRun {
+		patched.Metadata.ResourceVersion = ns.Metadata.ResourceVersion
+		return patched, nil
+	}
+
+	// the annotations of the fields removed by the patch are removed from the namespace
+	var removed []string
+	for key := range *ns.ToProject().ToNamespace().Metadata.Annotations {
+		if _, ok := (*patched.Metadata.Annotations)[key]; !ok {
+			removed = append(removed, key)
+		}
+	}
+	if resp := s.cluster.UpdateNamespace(ctx, clusterID, patched, version, removed...); resp.IsError() {
+		return nil, resp
+	}
+	return s.cluster.GetNamespaceByName(ctx, clusterID, projectName)
 }
 
-func (s ClusterService) UpdateNamespace(clusterID string, namespace *model.Namespace) *model.ServerResponse {
-	return s.cluster.UpdateNamespace(clusterID, namespace)
+func (s ClusterService) DeleteNamespace(ctx context.Context, clusterID string, namespace string, ifMatch string) *model.ServerResponse {
+	return s.cluster.DeleteNamespace(ctx, clusterID, namespace, ifMatch)
 }
 
-func (s ClusterService) DeleteNamespace(clusterID string, namespace string) *model.ServerResponse {
-	return s.cluster.DeleteNamespace(clusterID, namespace)
+// GetProjectHealth returns the health of the releases of the project, the project status is the worst of the releases
+func (s ClusterService) GetProjectHealth(ctx context.Context, clusterID string, projectName string) (*model.ProjectHealth, *model.ServerResponse) {
+	if _, err := s.cluster.GetNamespaceByName(ctx, clusterID, projectName); err != nil {
+		return nil, err
+	}
+	releases, err := s.cluster.ListReleasesHealth(ctx, clusterID, projectName)
+	if err != nil {
+		return nil, err
+	}
+	return model.NewProjectHealth(projectName, releases), nil
 }
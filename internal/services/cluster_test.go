@@ -0,0 +1,100 @@
+/*
+ *    Copyright 2024 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/okdp/okdp-server/internal/integrations/k8s"
+	"github.com/okdp/okdp-server/internal/integrations/k8s/client"
+	"github.com/okdp/okdp-server/internal/model"
+)
+
+func newProjectService(t *testing.T) (*ClusterService, ctrlclient.WithWatch) {
+	t.Helper()
+	direct := fake.NewClientBuilder().WithObjects(&corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "team-a",
+			Labels: map[string]string{"okdp.io/project": "true"},
+			Annotations: map[string]string{
+				"okdp.io/display-name": "Team A",
+				"okdp.io/description":  "The team A project",
+				"okdp.io/owner":        "jdoe",
+			},
+		},
+	}).Build()
+	service := &ClusterService{cluster: &k8s.K8S{KubeClients: client.NewKubeClients(map[string]ctrlclient.WithWatch{"test": direct})}}
+	return service, direct
+}
+
+func TestClusterService_PatchProject_RemovesField(t *testing.T) {
+	// Given
+	service, direct := newProjectService(t)
+	patch := &model.Patch{Type: types.MergePatchType, Data: []byte(`{"description":null,"environment":"dev"}`)}
+
+	// When
+	project, err := service.PatchProject(context.Background(), "test", "team-a", patch, false, "")
+
+	// Then
+	require.Nil(t, err)
+	var ns corev1.Namespace
+	require.NoError(t, direct.Get(context.Background(), ctrlclient.ObjectKey{Name: "team-a"}, &ns))
+	assert.Equal(t, map[string]string{
+		"okdp.io/display-name": "Team A",
+		"okdp.io/environment":  "dev",
+		"okdp.io/owner":        "jdoe",
+	}, ns.Annotations, "the removed field is removed from the namespace, the other annotations are kept")
+	assert.Equal(t, ns.Annotations, *project.Metadata.Annotations)
+}
+
+func TestClusterService_PatchProject_DryRun(t *testing.T) {
+	// Given
+	service, direct := newProjectService(t)
+	patch := &model.Patch{Type: types.JSONPatchType, Data: []byte(`[{"op":"remove","path":"/displayName"}]`)}
+
+	// When
+	project, err := service.PatchProject(context.Background(), "test", "team-a", patch, true, "")
+
+	// Then
+	require.Nil(t, err)
+	assert.Nil(t, project.ToProject().DisplayName)
+	var ns corev1.Namespace
+	require.NoError(t, direct.Get(context.Background(), ctrlclient.ObjectKey{Name: "team-a"}, &ns))
+	assert.Equal(t, "Team A", ns.Annotations["okdp.io/display-name"], "the namespace is not updated on dry run")
+}
+
+func TestClusterService_PatchProject_Name(t *testing.T) {
+	// Given
+	service, _ := newProjectService(t)
+	patch := &model.Patch{Type: types.MergePatchType, Data: []byte(`{"name":"team-b"}`)}
+
+	// When
+	_, err := service.PatchProject(context.Background(), "test", "team-a", patch, false, "")
+
+	// Then
+	require.NotNil(t, err)
+	assert.Equal(t, string(model.ErrPatchFailed), *err.Code)
+}
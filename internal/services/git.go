@@ -17,81 +17,117 @@
 package services
 
 import (
+	"context"
+	"time"
+
+	"github.com/okdp/okdp-server/internal/common/constants"
+	log "github.com/okdp/okdp-server/internal/common/logging"
 	"github.com/okdp/okdp-server/internal/integrations/git"
+	"github.com/okdp/okdp-server/internal/integrations/k8s"
+	"github.com/okdp/okdp-server/internal/integrations/oci"
 	"github.com/okdp/okdp-server/internal/model"
+	"github.com/okdp/okdp-server/internal/utils"
 )
 
 type GitRepoService struct {
-	git *git.Repository
+	git     *git.Repository
+	k8s     *k8s.K8S
+	catalog *oci.RepoCatalog
 }
 
 func NewGitRepoService() *GitRepoService {
 	return &GitRepoService{
-		git: git.NewRepository(),
+		git:     git.NewRepository(),
+		k8s:     k8s.NewK8S(),
+		catalog: oci.NewRepoCatalog(),
 	}
 }
 
-func (s GitRepoService) ListGitRepos(clusterID string, namespace string) ([]*model.GitRepository, *model.ServerResponse) {
-	return s.git.ListGitRepos(clusterID, namespace)
+func (s GitRepoService) ListGitRepos(ctx context.Context, clusterID string, namespace string) ([]*model.GitRepository, *model.ServerResponse) {
+	return s.git.ListGitRepos(ctx, clusterID, namespace)
 }
 
-func (s GitRepoService) GetGitRepo(clusterID string, namespace string, kustomizationName string) (*model.GitRepository, *model.ServerResponse) {
-	return s.git.GetGitRepo(clusterID, namespace, kustomizationName)
+func (s GitRepoService) GetGitRepo(ctx context.Context, clusterID string, namespace string, kustomizationName string) (*model.GitRepository, *model.ServerResponse) {
+	return s.git.GetGitRepo(ctx, clusterID, namespace, kustomizationName)
 }
 
-func (s GitRepoService) ListReleases(clusterID string, namespace string, kustomizationName string) ([]*model.ReleaseInfo, *model.ServerResponse) {
-	contents, err := s.git.GetContents(clusterID, namespace, kustomizationName)
+func (s GitRepoService) ListReleases(ctx context.Context, clusterID string, namespace string, kustomizationName string) ([]*model.ReleaseInfo, *model.ServerResponse) {
+	contents, err := s.git.GetContents(ctx, clusterID, namespace, kustomizationName)
 	if err != nil {
 		return nil, err
 	}
 	return s.toReleaseInfo(contents)
 }
 
-func (s GitRepoService) GetRelease(clusterID string, namespace string, kustomizationName string, releaseName string) (*model.Release, *model.ServerResponse) {
-	contents, err := s.git.GetContents(clusterID, namespace, kustomizationName)
+func (s GitRepoService) ListReleasesPage(ctx context.Context, clusterID string, namespace string, kustomizationName string, options *model.ListOptions) (*model.ListResult[*model.ReleaseInfo], *model.ServerResponse) {
+	releases, err := s.ListReleases(ctx, clusterID, namespace, kustomizationName)
 	if err != nil {
 		return nil, err
 	}
+	return model.List(releases, options, func(r *model.ReleaseInfo) bool {
+		return r.Matches(options)
+	}, model.ReleaseInfoComparators)
+}
+
+func (s GitRepoService) GetRelease(ctx context.Context, clusterID string, namespace string, kustomizationName string, releaseName string) (*model.Release, *model.ServerResponse) {
+	release, _, err := s.GetReleaseVersion(ctx, clusterID, namespace, kustomizationName, releaseName)
+	return release, err
+}
+
+// GetReleaseVersion returns the release along with the git blob hash of its file, used as ETag
+func (s GitRepoService) GetReleaseVersion(ctx context.Context, clusterID string, namespace string, kustomizationName string, releaseName string) (*model.Release, string, *model.ServerResponse) {
+	contents, err := s.git.GetContents(ctx, clusterID, namespace, kustomizationName)
+	if err != nil {
+		return nil, "", err
+	}
 
 	for _, content := range contents {
 		release, err := content.ToRelease()
 		if err != nil {
-			return nil, err
+			return nil, "", err
 		}
 
 		if release.ObjectMeta.Name == releaseName {
-			return release, nil
+			return release, content.SHA, nil
 		}
 	}
 
-	return nil, model.KuboCDGitReleaseNotFoundError(clusterID, namespace, kustomizationName, releaseName)
+	return nil, "", model.KuboCDGitReleaseNotFoundError(clusterID, namespace, kustomizationName, releaseName)
 }
 
-func (s GitRepoService) CreateGitRelease(clusterID string, namespace string, kustomizationName string, release *model.Release, commitOpts *model.GitCommit) (*model.Release, *model.ServerResponse) {
-	releaseInfo, er := s.getReleaseInfo(clusterID, namespace, kustomizationName, release.Namespace, release.Name)
+func (s GitRepoService) CreateGitRelease(ctx context.Context, clusterID string, namespace string, kustomizationName string, release *model.Release, commitOpts *model.GitCommit) (*model.Release, *model.ServerResponse) {
+	releaseInfo, er := s.getReleaseInfo(ctx, clusterID, namespace, kustomizationName, release.Namespace, release.Name)
 	if er != nil {
 		return nil, er
 	}
 	if releaseInfo == nil {
+		if err := validateParameters(ctx, s.catalog, release); err != nil {
+			return nil, err
+		}
 		content, err := release.SanitizeMetadata().SanitizeStatus().ToYAML()
 		if err != nil {
 			return nil, model.
 				NewServerResponse(model.OkdpServerResponse).
 				UnprocessableEntity("Unable to convert KuboCD release %s/%s into yaml", release.Namespace, release.Name)
 		}
-		return release, s.git.Write(clusterID, namespace, kustomizationName, content, commitOpts, release.Name+".yaml")
+		return release, s.git.Write(ctx, clusterID, namespace, kustomizationName, content, commitOpts, release.Name+".yaml", "")
 	}
 
-	return nil, model.NewServerResponse(model.OkdpServerResponse).ConflictError("Release '%s' already exists in the git repo %s (%s)", release.Name, releaseInfo.Git.Path, releaseInfo.Git.URL)
+	return nil, model.NewServerResponse(model.OkdpServerResponse).ConflictError("Release '%s' already exists in the git repo %s (%s)", release.Name, releaseInfo.Git.Path, releaseInfo.Git.URL).
+		WithCode(model.ErrReleaseAlreadyExists)
 }
 
-func (s GitRepoService) UpdateGitRelease(clusterID string, namespace string, kustomizationName string, release *model.Release, commitOpts *model.GitCommit) (*model.Release, *model.ServerResponse) {
-	releaseInfo, er := s.getReleaseInfo(clusterID, namespace, kustomizationName, release.Namespace, release.Name)
+func (s GitRepoService) UpdateGitRelease(ctx context.Context, clusterID string, namespace string, kustomizationName string, release *model.Release, commitOpts *model.GitCommit, ifMatch string) (*model.Release, *model.ServerResponse) {
+	releaseInfo, er := s.getReleaseInfo(ctx, clusterID, namespace, kustomizationName, release.Namespace, release.Name)
 	if er != nil {
 		return nil, er
 	}
 	if releaseInfo == nil {
-		return nil, model.NewServerResponse(model.OkdpServerResponse).NotFoundError("Release '%s' does not exist in the git repo", release.Name)
+		return nil, model.NewServerResponse(model.OkdpServerResponse).NotFoundError("Release '%s' does not exist in the git repo", release.Name).
+			WithCode(model.ErrReleaseNotFound)
+	}
+	if err := validateParameters(ctx, s.catalog, release); err != nil {
+		return nil, err
 	}
 
 	content, err := release.SanitizeMetadata().SanitizeStatus().ToYAML()
@@ -101,19 +137,191 @@ func (s GitRepoService) UpdateGitRelease(clusterID string, namespace string, kus
 			UnprocessableEntity("Unable to convert KuboCD release %s/%s into yaml", release.Namespace, release.Name)
 	}
 
-	return release, s.git.Write(clusterID, namespace, kustomizationName, content, commitOpts, releaseInfo.Git.Path)
+	return release, s.git.Write(ctx, clusterID, namespace, kustomizationName, content, commitOpts, releaseInfo.Git.Path, ifMatch)
+}
+
+// PatchGitRelease applies the patch to the release stored in the git repo and commits the result, unless dryRun is set.
+// The commit is conditioned by the version of the file the patch was applied to.
+func (s GitRepoService) PatchGitRelease(ctx context.Context, clusterID string, namespace string, kustomizationName string, releaseName string, patch *model.Patch, commitOpts *model.GitCommit, dryRun bool, ifMatch string) (*model.Release, string, *model.ServerResponse) {
+	release, version, err := s.GetReleaseVersion(ctx, clusterID, namespace, kustomizationName, releaseName)
+	if err != nil {
+		return nil, "", err
+	}
+	if !utils.MatchesETag(ifMatch, version) {
+		return nil, "", model.NewServerResponse(model.GitRepoResponse).
+			PreconditionFailed("Release '%s' has been modified since version '%s', get it again and retry", releaseName, ifMatch)
+	}
+
+	var patched model.Release
+	if err := patch.ApplyTo(release, &patched); err != nil {
+		return nil, "", err
+	}
+	if patched.Name != release.Name || patched.Namespace != release.Namespace {
+		return nil, "", model.NewServerResponse(model.OkdpServerResponse).
+			BadRequest("The name and namespace of the release '%s/%s' cannot be patched", release.Namespace, release.Name).
+			WithCode(model.ErrPatchFailed)
+	}
+	if err := validateParameters(ctx, s.catalog, &patched); err != nil {
+		return nil, "", err
+	}
+
+	if dryRun {
+		return &patched, version, nil
+	}
+
+	if _, err := s.UpdateGitRelease(ctx, clusterID, namespace, kustomizationName, &patched, commitOpts, version); err != nil {
+		return nil, "", err
+	}
+
+	content, er := patched.ToYAML()
+	if er != nil {
+		return &patched, "", nil
+	}
+	return &patched, utils.GitBlobSHA([]byte(content)), nil
 }
 
-func (s GitRepoService) DeleteGitRelease(clusterID string, namespace string, kustomizationName string, releaseName string, commitOpts *model.GitCommit) *model.ServerResponse {
-	releaseInfo, er := s.getReleaseInfo(clusterID, namespace, kustomizationName, "default", releaseName)
+func (s GitRepoService) DeleteGitRelease(ctx context.Context, clusterID string, namespace string, kustomizationName string, releaseName string, commitOpts *model.GitCommit, ifMatch string) *model.ServerResponse {
+	releaseInfo, er := s.getReleaseInfo(ctx, clusterID, namespace, kustomizationName, "default", releaseName)
 	if er != nil {
 		return er
 	}
-	if *releaseInfo == (model.ReleaseInfo{}) {
-		return model.NewServerResponse(model.OkdpServerResponse).NotFoundError("Release '%s' does not exist in the git repo", releaseName)
+	if releaseInfo == nil || *releaseInfo == (model.ReleaseInfo{}) {
+		return model.NewServerResponse(model.OkdpServerResponse).NotFoundError("Release '%s' does not exist in the git repo", releaseName).
+			WithCode(model.ErrReleaseNotFound)
+	}
+
+	return s.git.DeleteFile(ctx, clusterID, namespace, kustomizationName, commitOpts, releaseInfo.Git.Path, ifMatch)
+}
+
+// GetGitReleaseHistory returns the revisions of the release, derived from the commit log of its file in the git repo.
+// The revisions are numbered from 1 (the oldest commit).
+func (s GitRepoService) GetGitReleaseHistory(ctx context.Context, clusterID string, namespace string, kustomizationName string, releaseName string) ([]*model.ReleaseRevision, *model.ServerResponse) {
+	releaseInfo, err := s.findReleaseInfo(ctx, clusterID, namespace, kustomizationName, releaseName)
+	if err != nil {
+		return nil, err
+	}
+
+	fileRevisions, err := s.git.GetHistory(ctx, clusterID, namespace, kustomizationName, releaseInfo.Git.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions := []*model.ReleaseRevision{}
+	for i, fileRevision := range fileRevisions {
+		revision, err := fileRevision.ToReleaseRevision(i + 1)
+		if err != nil {
+			log.Ctx(ctx).Warn("Ignoring revision %s of release '%s' in the git repo '%s/%s', details: %+v", fileRevision.Commit, releaseName, namespace, kustomizationName, err)
+			continue
+		}
+		revisions = append(revisions, revision)
+	}
+	return revisions, nil
+}
+
+// RollbackGitRelease commits again the spec of the release as of the given revision, unless dryRun is set.
+// It returns the release along with the git blob hash of the committed file.
+func (s GitRepoService) RollbackGitRelease(ctx context.Context, clusterID string, namespace string, kustomizationName string, releaseName string, revision int, commitOpts *model.GitCommit, dryRun bool, ifMatch string) (*model.Release, string, *model.ServerResponse) {
+	revisions, err := s.GetGitReleaseHistory(ctx, clusterID, namespace, kustomizationName, releaseName)
+	if err != nil {
+		return nil, "", err
+	}
+	target, err := model.FindRevision(revisions, namespace, releaseName, revision)
+	if err != nil {
+		return nil, "", err
+	}
+	if err := validateParameters(ctx, s.catalog, target.Release); err != nil {
+		return nil, "", err
+	}
+	if dryRun {
+		return target.Release, "", nil
+	}
+
+	releaseInfo, err := s.findReleaseInfo(ctx, clusterID, namespace, kustomizationName, releaseName)
+	if err != nil {
+		return nil, "", err
+	}
+	content, er := target.Release.ToYAML()
+	if er != nil {
+		return nil, "", model.
+			NewServerResponse(model.OkdpServerResponse).
+			UnprocessableEntity("Unable to convert KuboCD release %s/%s into yaml", target.Release.Namespace, releaseName)
+	}
+	if err := s.git.Write(ctx, clusterID, namespace, kustomizationName, content, commitOpts, releaseInfo.Git.Path, ifMatch); err != nil {
+		return nil, "", err
+	}
+	return target.Release, utils.GitBlobSHA([]byte(content)), nil
+}
+
+// GetDrift compares the releases of the git repo with the releases deployed on the cluster, and the git HEAD
+// with the last revision applied by the fluxcd kustomization
+func (s GitRepoService) GetDrift(ctx context.Context, clusterID string, namespace string, kustomizationName string) (*model.Drift, *model.ServerResponse) {
+	kustomization, err := s.k8s.GetKustomization(ctx, clusterID, namespace, kustomizationName)
+	if err != nil {
+		return nil, err
+	}
+
+	head, err := s.git.GetHead(ctx, clusterID, namespace, kustomizationName)
+	if err != nil {
+		return nil, err
+	}
+
+	contents, err := s.git.GetContents(ctx, clusterID, namespace, kustomizationName)
+	if err != nil {
+		return nil, err
+	}
+	desired := []*model.Release{}
+	desiredKeys := map[string]bool{}
+	for _, content := range contents {
+		release, err := content.ToRelease()
+		if err != nil {
+			return nil, err
+		}
+		release.Namespace = appliedNamespace(kustomization.Spec.TargetNamespace, release)
+		desired = append(desired, release)
+		desiredKeys[release.Namespace+"/"+release.Name] = true
+	}
+
+	releases, err := s.k8s.ListReleases(ctx, clusterID)
+	if err != nil {
+		return nil, err
 	}
+	live := utils.Filter2(releases, func(release model.Release) bool {
+		return desiredKeys[release.Namespace+"/"+release.Name] ||
+			(release.Labels[constants.KustomizeNameLabel] == kustomizationName &&
+				release.Labels[constants.KustomizeNamespaceLabel] == namespace)
+	})
 
-	return s.git.DeleteFile(clusterID, namespace, kustomizationName, commitOpts, releaseInfo.Git.Path)
+	return model.NewDrift(kustomizationName, namespace, head, kustomization.Status.LastAppliedRevision, desired, live)
+}
+
+// appliedNamespace returns the namespace the release of the git repo is applied to by fluxcd
+func appliedNamespace(targetNamespace string, release *model.Release) string {
+	switch {
+	case targetNamespace != "":
+		return targetNamespace
+	case release.Namespace == "":
+		return "default"
+	}
+	return release.Namespace
+}
+
+// RunKustomizationAction suspends, resumes or reconciles the fluxcd kustomization, and waits for its reconciliation when timeout is set
+func (s GitRepoService) RunKustomizationAction(ctx context.Context, clusterID string, namespace string, kustomizationName string, action model.Action, timeout time.Duration) (*model.ActionResult, *model.ServerResponse) {
+	return s.k8s.RunKustomizationAction(ctx, clusterID, namespace, kustomizationName, action, timeout)
+}
+
+// RunGitSourceAction suspends, resumes or reconciles the fluxcd git repository of the kustomization, and waits
+// for its reconciliation when timeout is set
+func (s GitRepoService) RunGitSourceAction(ctx context.Context, clusterID string, namespace string, kustomizationName string, action model.Action, timeout time.Duration) (*model.ActionResult, *model.ServerResponse) {
+	kustomization, err := s.k8s.GetKustomization(ctx, clusterID, namespace, kustomizationName)
+	if err != nil {
+		return nil, err
+	}
+	if kustomization.Spec.SourceRef.Kind != constants.GitRepository {
+		return nil, model.RepoNotFoundError(clusterID, namespace, kustomizationName)
+	}
+	sourceNamespace := utils.DefaultIfEmpty(kustomization.Spec.SourceRef.Namespace, kustomization.Namespace)
+	return s.k8s.RunGitRepositoryAction(ctx, clusterID, sourceNamespace, kustomization.Spec.SourceRef.Name, action, timeout)
 }
 
 func (s GitRepoService) toReleaseInfo(contents []*model.GitContent) ([]*model.ReleaseInfo, *model.ServerResponse) {
@@ -141,8 +349,8 @@ func (s GitRepoService) toReleaseInfo(contents []*model.GitContent) ([]*model.Re
 	return releasesInfo, nil
 }
 
-func (s GitRepoService) getReleaseInfo(clusterID, namespace, kustomizationName, releaseNamespace, releaseName string) (*model.ReleaseInfo, *model.ServerResponse) {
-	releases, err := s.ListReleases(clusterID, namespace, kustomizationName)
+func (s GitRepoService) getReleaseInfo(ctx context.Context, clusterID, namespace, kustomizationName, releaseNamespace, releaseName string) (*model.ReleaseInfo, *model.ServerResponse) {
+	releases, err := s.ListReleases(ctx, clusterID, namespace, kustomizationName)
 	if err != nil {
 		return nil, err
 	}
@@ -155,3 +363,19 @@ func (s GitRepoService) getReleaseInfo(clusterID, namespace, kustomizationName,
 
 	return nil, nil
 }
+
+// findReleaseInfo returns the release with the given name in the git repo, or a 404 response
+func (s GitRepoService) findReleaseInfo(ctx context.Context, clusterID, namespace, kustomizationName, releaseName string) (*model.ReleaseInfo, *model.ServerResponse) {
+	releases, err := s.ListReleases(ctx, clusterID, namespace, kustomizationName)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rel := range releases {
+		if rel.Name == releaseName {
+			return rel, nil
+		}
+	}
+
+	return nil, model.KuboCDGitReleaseNotFoundError(clusterID, namespace, kustomizationName, releaseName)
+}
@@ -0,0 +1,51 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/okdp/okdp-server/internal/model"
+)
+
+func TestAppliedNamespace(t *testing.T) {
+	tests := []struct {
+		name            string
+		targetNamespace string
+		namespace       string
+		applied         string
+	}{
+		{name: "target namespace of the kustomization", targetNamespace: "team-b", namespace: "team-a", applied: "team-b"},
+		{name: "namespace of the release", namespace: "team-a", applied: "team-a"},
+		{name: "default namespace", applied: "default"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			release := &model.Release{ObjectMeta: metav1.ObjectMeta{Name: "podinfo", Namespace: tt.namespace}}
+
+			// When
+			namespace := appliedNamespace(tt.targetNamespace, release)
+
+			// Then
+			assert.Equal(t, tt.applied, namespace)
+		})
+	}
+}
@@ -0,0 +1,37 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package services
+
+import (
+	"github.com/okdp/okdp-server/internal/integrations/k8s"
+)
+
+type HealthService struct {
+	k8s *k8s.K8S
+}
+
+func NewHealthService() *HealthService {
+	return &HealthService{
+		k8s: k8s.NewK8S(),
+	}
+}
+
+// UnsyncedClusters returns the IDs of the clusters whose informers are still syncing,
+// the server is not ready to serve the Kubernetes reads until they are synced or given up
+func (s HealthService) UnsyncedClusters() []string {
+	return s.k8s.Unsynced()
+}
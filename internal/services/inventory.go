@@ -0,0 +1,170 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package services
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/okdp/okdp-server/internal/common/logging"
+	"github.com/okdp/okdp-server/internal/integrations/k8s"
+	"github.com/okdp/okdp-server/internal/model"
+	"github.com/okdp/okdp-server/internal/utils"
+)
+
+// InventoryService provides the multi-cluster inventory. The clusters are queried concurrently,
+// the clusters that fail or do not answer before the timeout are reported without blocking the others.
+type InventoryService struct {
+	k8s *k8s.K8S
+}
+
+func NewInventoryService() *InventoryService {
+	return &InventoryService{
+		k8s: k8s.NewK8S(),
+	}
+}
+
+// clusterInventory collects the inventory of a cluster
+type clusterInventory func(ctx context.Context, clusterID string) (*model.ClusterInventory, *model.ServerResponse)
+
+func (s InventoryService) Releases(ctx context.Context, env string, timeout time.Duration, options *model.ListOptions) *model.Inventory {
+	return s.collect(ctx, env, timeout, func(ctx context.Context, clusterID string) (*model.ClusterInventory, *model.ServerResponse) {
+		releases, err := s.releaseSummaries(ctx, clusterID, options)
+		if err != nil {
+			return nil, err
+		}
+		return &model.ClusterInventory{Releases: releases}, nil
+	})
+}
+
+// Projects returns the projects of the clusters, only the projects running a matching release
+// are returned when a package or tag filter is set
+func (s InventoryService) Projects(ctx context.Context, env string, timeout time.Duration, options *model.ListOptions) *model.Inventory {
+	return s.collect(ctx, env, timeout, func(ctx context.Context, clusterID string) (*model.ClusterInventory, *model.ServerResponse) {
+		namespaces, err := s.k8s.ListNamespaces(ctx, clusterID)
+		if err != nil {
+			return nil, err
+		}
+		releases, err := s.k8s.ListReleases(ctx, clusterID)
+		if err != nil {
+			return nil, err
+		}
+		pods, err := s.k8s.ListPods(ctx, clusterID)
+		if err != nil {
+			return nil, err
+		}
+
+		running := map[string]bool{}
+		for _, release := range releases {
+			if utils.MatchesSearch(options.Package, release.Spec.Package.Repository) && utils.MatchesVersion(options.Tag, release.Spec.Package.Tag) {
+				running[release.Namespace] = true
+			}
+		}
+		filtered := options.Package != "" || options.Tag != ""
+
+		projects := utils.Filter2(projectStats(clusterID, namespaces, releases, pods, options), func(p model.ProjectStats) bool {
+			return (!filtered || running[p.Name]) && p.Matches(options)
+		})
+		return &model.ClusterInventory{Projects: projects}, nil
+	})
+}
+
+// Versions returns the package versions (repository and tag) running on the clusters
+func (s InventoryService) Versions(ctx context.Context, env string, timeout time.Duration, options *model.ListOptions) *model.Inventory {
+	return s.collect(ctx, env, timeout, func(ctx context.Context, clusterID string) (*model.ClusterInventory, *model.ServerResponse) {
+		releases, err := s.releaseSummaries(ctx, clusterID, options)
+		if err != nil {
+			return nil, err
+		}
+		return &model.ClusterInventory{Packages: model.ToPackageVersions(releases)}, nil
+	})
+}
+
+func (s InventoryService) releaseSummaries(ctx context.Context, clusterID string, options *model.ListOptions) ([]*model.ReleaseSummary, *model.ServerResponse) {
+	releases, err := s.k8s.ListReleases(ctx, clusterID)
+	if err != nil {
+		return nil, err
+	}
+	summaries := []*model.ReleaseSummary{}
+	for _, release := range releases {
+		summary := release.ToSummary(clusterID)
+		if options.MatchesLabels(release.Labels) && summary.Matches(options) {
+			summaries = append(summaries, summary)
+		}
+	}
+	return summaries, nil
+}
+
+// collect runs the inventory of the clusters of the environment (all the clusters if not set) concurrently
+func (s InventoryService) collect(ctx context.Context, env string, timeout time.Duration, inventory clusterInventory) *model.Inventory {
+	clusters := utils.Filter2(s.k8s.ListClusters(), func(c model.Cluster) bool {
+		return env == "" || strings.EqualFold(c.Env, env)
+	})
+
+	results := make([]*model.ClusterInventory, len(clusters))
+	var wg sync.WaitGroup
+	for i, cluster := range clusters {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = collectCluster(ctx, cluster, timeout, inventory)
+		}()
+	}
+	wg.Wait()
+
+	return model.NewInventory(results)
+}
+
+// collectCluster runs the inventory of a cluster, the cluster is reported as failed if it does not answer before the timeout
+func collectCluster(ctx context.Context, cluster *model.Cluster, timeout time.Duration, inventory clusterInventory) *model.ClusterInventory {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	type answer struct {
+		result *model.ClusterInventory
+		err    *model.ServerResponse
+	}
+	answers := make(chan answer, 1)
+	go func() {
+		result, err := inventory(ctx, cluster.ID)
+		answers <- answer{result, err}
+	}()
+
+	var result *model.ClusterInventory
+	var err *model.ServerResponse
+	select {
+	case a := <-answers:
+		result, err = a.result, a.err
+	case <-ctx.Done():
+		err = model.NewServerResponse(model.K8sClusterResponse).
+			GenericError(http.StatusGatewayTimeout, "The cluster '%s' did not answer within %s", cluster.ID, timeout).
+			WithCode(model.ErrKubernetes)
+	}
+
+	if err != nil {
+		log.Ctx(ctx).Warn("Skipping the inventory of cluster '%s', details: %+v", cluster.ID, err)
+		result = &model.ClusterInventory{Error: err}
+	} else {
+		result.Reachable = true
+	}
+	result.ClusterId = cluster.ID
+	result.Env = utils.EmptyToNil(cluster.Env)
+	return result
+}
@@ -17,45 +17,135 @@
 package services
 
 import (
+	"context"
+	"fmt"
+	"time"
+
+	log "github.com/okdp/okdp-server/internal/common/logging"
 	"github.com/okdp/okdp-server/internal/integrations/k8s"
+	"github.com/okdp/okdp-server/internal/integrations/oci"
 	"github.com/okdp/okdp-server/internal/model"
 )
 
 type KuboCDService struct {
-	kubocd *k8s.K8S
+	kubocd  *k8s.K8S
+	catalog *oci.RepoCatalog
 }
 
 func NewKuboCDService() *KuboCDService {
 	return &KuboCDService{
-		kubocd: k8s.NewK8S(),
+		kubocd:  k8s.NewK8S(),
+		catalog: oci.NewRepoCatalog(),
 	}
 }
 
-func (s KuboCDService) ListReleases(clusterID string, namespaces ...string) ([]*model.Release, *model.ServerResponse) {
-	return s.kubocd.ListReleases(clusterID, namespaces...)
+func (s KuboCDService) ListReleases(ctx context.Context, clusterID string, namespace string, options *model.ListOptions) (*model.ListResult[*model.Release], *model.ServerResponse) {
+	return s.kubocd.ListReleasesPage(ctx, clusterID, namespace, options)
+}
+
+func (s KuboCDService) GetRelease(ctx context.Context, clusterID string, namespace string, releaseName string) (*model.Release, *model.ServerResponse) {
+	return s.kubocd.GetRelease(ctx, clusterID, namespace, releaseName)
+}
 
+func (s KuboCDService) GetReleaseStatus(ctx context.Context, clusterID string, namespace string, releaseName string) (*model.ReleaseStatus, *model.ServerResponse) {
+	return s.kubocd.GetReleaseStatus(ctx, clusterID, namespace, releaseName)
 }
 
-func (s KuboCDService) GetRelease(clusterID string, namespace string, releaseName string) (*model.Release, *model.ServerResponse) {
-	return s.kubocd.GetRelease(clusterID, namespace, releaseName)
+func (s KuboCDService) GetReleaseHealth(ctx context.Context, clusterID string, namespace string, releaseName string) (*model.ReleaseHealth, *model.ServerResponse) {
+	return s.kubocd.GetReleaseHealth(ctx, clusterID, namespace, releaseName)
 }
 
-func (s KuboCDService) GetReleaseStatus(clusterID string, namespace string, releaseName string) (*model.ReleaseStatus, *model.ServerResponse) {
-	return s.kubocd.GetReleaseStatus(clusterID, namespace, releaseName)
+func (s KuboCDService) GetReleaseResourceTree(ctx context.Context, clusterID string, namespace string, releaseName string) (*model.ResourceTree, *model.ServerResponse) {
+	return s.kubocd.GetReleaseResourceTree(ctx, clusterID, namespace, releaseName)
 }
 
-func (s KuboCDService) CreateRelease(clusterID string, namespace string, release *model.Release, dryRun bool) *model.ServerResponse {
-	return s.kubocd.CreateRelease(clusterID, namespace, release, dryRun)
+func (s KuboCDService) CreateRelease(ctx context.Context, clusterID string, namespace string, release *model.Release, dryRun bool, author string, email string) *model.ServerResponse {
+	if err := validateParameters(ctx, s.catalog, release); err != nil {
+		return err
+	}
+	response := s.kubocd.CreateRelease(ctx, clusterID, namespace, release, dryRun)
+	if !dryRun && response.Status < 300 {
+		recordRevision(ctx, s.kubocd, clusterID, namespace, release, author, email, "Created")
+	}
+	return response
 }
 
-func (s KuboCDService) UpdateRelease(clusterID string, namespace string, release *model.Release, dryRun bool) *model.ServerResponse {
-	return s.kubocd.UpdateRelease(clusterID, namespace, release, dryRun)
+func (s KuboCDService) UpdateRelease(ctx context.Context, clusterID string, namespace string, release *model.Release, dryRun bool, ifMatch string, author string, email string) *model.ServerResponse {
+	if err := validateParameters(ctx, s.catalog, release); err != nil {
+		return err
+	}
+	response := s.kubocd.UpdateRelease(ctx, clusterID, namespace, release, dryRun, ifMatch)
+	if !dryRun && response.Status < 300 {
+		recordRevision(ctx, s.kubocd, clusterID, namespace, release, author, email, "Updated")
+	}
+	return response
+}
+
+func (s KuboCDService) PatchRelease(ctx context.Context, clusterID string, namespace string, releaseName string, patch *model.Patch, dryRun bool, ifMatch string, author string, email string) (*model.Release, *model.ServerResponse) {
+	return s.patchRelease(ctx, clusterID, namespace, releaseName, patch, dryRun, ifMatch, author, email, "Patched")
+}
+
+// patchRelease validates the patched release, as applied by the API server in dry run, before writing it
+func (s KuboCDService) patchRelease(ctx context.Context, clusterID string, namespace string, releaseName string, patch *model.Patch, dryRun bool, ifMatch string, author string, email string, message string) (*model.Release, *model.ServerResponse) {
+	patched, err := s.kubocd.PatchRelease(ctx, clusterID, namespace, releaseName, patch, true, ifMatch)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateParameters(ctx, s.catalog, patched); err != nil {
+		return nil, err
+	}
+	if dryRun {
+		return patched, nil
+	}
+
+	release, err := s.kubocd.PatchRelease(ctx, clusterID, namespace, releaseName, patch, false, ifMatch)
+	if err == nil {
+		recordRevision(ctx, s.kubocd, clusterID, namespace, release, author, email, message)
+	}
+	return release, err
+}
+
+// GetReleaseHistory returns the last revisions of the release, from the oldest to the current one
+func (s KuboCDService) GetReleaseHistory(ctx context.Context, clusterID string, namespace string, releaseName string) ([]*model.ReleaseRevision, *model.ServerResponse) {
+	return s.kubocd.ListReleaseRevisions(ctx, clusterID, namespace, releaseName)
+}
+
+// RollbackRelease restores the spec of the release from one of its revisions, the rollback is recorded as a new revision
+func (s KuboCDService) RollbackRelease(ctx context.Context, clusterID string, namespace string, releaseName string, revision int, dryRun bool, ifMatch string, author string, email string) (*model.Release, *model.ServerResponse) {
+	revisions, err := s.kubocd.ListReleaseRevisions(ctx, clusterID, namespace, releaseName)
+	if err != nil {
+		return nil, err
+	}
+	target, err := model.FindRevision(revisions, namespace, releaseName, revision)
+	if err != nil {
+		return nil, err
+	}
+	patch, err := target.RollbackPatch()
+	if err != nil {
+		return nil, err
+	}
+	return s.patchRelease(ctx, clusterID, namespace, releaseName, patch, dryRun, ifMatch, author, email, fmt.Sprintf("Rollback to revision %d", revision))
+}
+
+// RunReleaseAction suspends, resumes or reconciles the release, and waits for its reconciliation when timeout is set
+func (s KuboCDService) RunReleaseAction(ctx context.Context, clusterID string, namespace string, releaseName string, action model.Action, timeout time.Duration) (*model.ActionResult, *model.ServerResponse) {
+	return s.kubocd.RunReleaseAction(ctx, clusterID, namespace, releaseName, action, timeout)
+}
+
+// recordRevision records the new spec of the release in its history, the failures are logged only
+// as the change itself succeeded
+func recordRevision(ctx context.Context, k *k8s.K8S, clusterID string, namespace string, release *model.Release, author string, email string, message string) {
+	revision := model.NewReleaseRevision(release, author, email, message)
+	revision.Release.Namespace = namespace
+	if err := k.RecordReleaseRevision(ctx, clusterID, namespace, revision); err != nil {
+		log.Ctx(ctx).Warn("Unable to record the revision of release '%s/%s' on cluster '%s', details: %+v", namespace, release.Name, clusterID, err)
+	}
 }
 
-func (s KuboCDService) DeleteRelease(clusterID string, namespace string, releaseName string) *model.ServerResponse {
-	return s.kubocd.DeleteRelease(clusterID, namespace, releaseName)
+func (s KuboCDService) DeleteRelease(ctx context.Context, clusterID string, namespace string, releaseName string, ifMatch string) *model.ServerResponse {
+	return s.kubocd.DeleteRelease(ctx, clusterID, namespace, releaseName, ifMatch)
 }
 
-func (s KuboCDService) ListEventsRelease(clusterID string, namespace, releaseName string) ([]map[string]interface{}, *model.ServerResponse) {
-	return s.kubocd.ListEventsRelease(clusterID, namespace, releaseName)
+func (s KuboCDService) ListEventsRelease(ctx context.Context, clusterID string, namespace, releaseName string, options *model.ListOptions) (*model.ListResult[map[string]interface{}], *model.ServerResponse) {
+	return s.kubocd.ListEventsRelease(ctx, clusterID, namespace, releaseName, options)
 }
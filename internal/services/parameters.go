@@ -0,0 +1,88 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package services
+
+import (
+	"context"
+	"encoding/json"
+
+	log "github.com/okdp/okdp-server/internal/common/logging"
+	"github.com/okdp/okdp-server/internal/integrations/oci"
+	"github.com/okdp/okdp-server/internal/model"
+	schema "github.com/okdp/okdp-server/internal/schema/parameters"
+)
+
+// validateParameters checks the parameters of the release against the schema of its package version.
+// The releases of the packages which are not in the configured catalogs are not validated.
+func validateParameters(ctx context.Context, catalog *oci.RepoCatalog, release *model.Release) *model.ServerResponse {
+	parametersSchema, err := parametersSchemaOf(ctx, catalog, release.Spec.Package.Repository, release.Spec.Package.Tag)
+	if err != nil || parametersSchema == nil {
+		return err
+	}
+	return checkParameters(parametersSchema, release)
+}
+
+// parametersSchemaOf returns the parameters schema of the package version, or nil if the package
+// (OCI repository) is not in the configured catalogs
+func parametersSchemaOf(ctx context.Context, catalog *oci.RepoCatalog, repository string, tag string) (*schema.KuboSchemaItem, *model.ServerResponse) {
+	catalogID, name, found := catalog.FindPackage(repository)
+	if !found {
+		log.Ctx(ctx).Debug("Skipping the validation of the parameters of package '%s': not found in the catalogs", repository)
+		return nil, nil
+	}
+
+	definition, err := catalog.GetPackageDefinition(ctx, catalogID, name, tag)
+	if err != nil {
+		return nil, err
+	}
+	kuboSchema, er := schema.NewKuboSchema(definition)
+	if er != nil {
+		return nil, model.NewServerResponse(model.OkdpServerResponse).
+			UnprocessableEntity("Unable to read the schema of the package '%s:%s' in catalog '%s': %v", name, tag, catalogID, er)
+	}
+	if kuboSchema.ParametersSchema == nil {
+		// Like KuboCD, a package without parameters schema accepts no parameter
+		return &schema.KuboSchemaItem{Type: "object"}, nil
+	}
+	return kuboSchema.ParametersSchema, nil
+}
+
+// checkParameters checks the parameters of the release against the parameters schema
+func checkParameters(parametersSchema *schema.KuboSchemaItem, release *model.Release) *model.ServerResponse {
+	var parameters interface{} = map[string]interface{}{}
+	if release.Spec.Parameters != nil && len(release.Spec.Parameters.Raw) > 0 {
+		if err := json.Unmarshal(release.Spec.Parameters.Raw, &parameters); err != nil {
+			return model.NewServerResponse(model.OkdpServerResponse).
+				WithCode(model.ErrValidationFailed).
+				BadRequest("The parameters of the release '%s/%s' are not valid JSON: %v", release.Namespace, release.Name, err)
+		}
+	}
+
+	errs := parametersSchema.Validate("spec.parameters", parameters)
+	if len(errs) == 0 {
+		return nil
+	}
+	details := make([]model.ErrorDetail, 0, len(errs))
+	for _, e := range errs {
+		details = append(details, model.NewErrorDetail(e.Field, e.Reason, e.Message))
+	}
+	return model.NewServerResponse(model.OkdpServerResponse).
+		WithCode(model.ErrValidationFailed).
+		WithDetails(details...).
+		BadRequest("The parameters of the release '%s/%s' do not match the schema of the package '%s:%s'",
+			release.Namespace, release.Name, release.Spec.Package.Repository, release.Spec.Package.Tag)
+}
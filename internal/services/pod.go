@@ -17,6 +17,7 @@
 package services
 
 import (
+	"context"
 	"io"
 
 	"github.com/okdp/okdp-server/internal/integrations/k8s"
@@ -33,10 +34,37 @@ func NewPodService() *PodService {
 	}
 }
 
-func (s PodService) GetPods(clusterID, namespace, releaseName string) ([]*model.PodInfo, *model.ServerResponse) {
-	return s.pod.GetPods(clusterID, namespace, releaseName)
+func (s PodService) GetPods(ctx context.Context, clusterID, namespace, releaseName string) ([]*model.PodInfo, *model.ServerResponse) {
+	return s.pod.GetPods(ctx, clusterID, namespace, releaseName)
 }
 
-func (s PodService) StreamLogs(clusterID, namespace, pod, container string, tailLines *int64, isSSE bool) (io.ReadCloser, *model.ServerResponse) {
-	return s.pod.StreamLogs(clusterID, namespace, pod, container, tailLines, isSSE)
+func (s PodService) StreamLogs(ctx context.Context, clusterID, namespace, pod, container string, tailLines *int64, isSSE bool) (io.ReadCloser, *model.ServerResponse) {
+	return s.pod.StreamLogs(ctx, clusterID, namespace, pod, container, tailLines, isSSE)
+}
+
+// GetReleasePod returns the pod of the release, or a not found error when the pod does not belong to the release
+// or has no such container. The pods of a release may run in its target namespace.
+func (s PodService) GetReleasePod(ctx context.Context, clusterID, namespace, releaseName, pod, container string) (*model.PodInfo, *model.ServerResponse) {
+	pods, err := s.pod.GetPods(ctx, clusterID, namespace, releaseName)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range pods {
+		if p.Name != pod {
+			continue
+		}
+		for _, c := range p.Containers {
+			if c.Name == container {
+				return p, nil
+			}
+		}
+		return nil, model.NewServerResponse(model.OkdpServerResponse).
+			NotFoundError("The pod '%s' of the release '%s/%s' has no container '%s'", pod, namespace, releaseName, container)
+	}
+	return nil, model.NewServerResponse(model.OkdpServerResponse).
+		NotFoundError("The pod '%s' does not belong to the release '%s/%s'", pod, namespace, releaseName)
+}
+
+func (s PodService) Exec(ctx context.Context, clusterID string, pod *model.PodInfo, container string, options *model.ExecOptions) (int, *model.ServerResponse) {
+	return s.pod.Exec(ctx, clusterID, pod.Namespace, pod.Name, container, options)
 }
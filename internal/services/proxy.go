@@ -0,0 +1,44 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package services
+
+import (
+	"context"
+	"net/http/httputil"
+
+	"github.com/okdp/okdp-server/internal/integrations/k8s"
+	"github.com/okdp/okdp-server/internal/model"
+)
+
+type ProxyService struct {
+	k8s *k8s.K8S
+}
+
+func NewProxyService() *ProxyService {
+	return &ProxyService{
+		k8s: k8s.NewK8S(),
+	}
+}
+
+// NewReleaseProxy returns a reverse proxy, served under the prefix, to the port of the service or the pod of the release
+func (s ProxyService) NewReleaseProxy(ctx context.Context, clusterID, namespace, releaseName, kind, name, port, prefix string) (*httputil.ReverseProxy, *model.ServerResponse) {
+	target, err := s.k8s.GetReleaseProxyTarget(ctx, clusterID, namespace, releaseName, kind, name, port)
+	if err != nil {
+		return nil, err
+	}
+	return s.k8s.NewReverseProxy(clusterID, target, prefix)
+}
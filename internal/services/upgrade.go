@@ -0,0 +1,348 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package services
+
+import (
+	"context"
+	"fmt"
+
+	log "github.com/okdp/okdp-server/internal/common/logging"
+	"github.com/okdp/okdp-server/internal/integrations/git"
+	"github.com/okdp/okdp-server/internal/integrations/k8s"
+	"github.com/okdp/okdp-server/internal/integrations/oci"
+	"github.com/okdp/okdp-server/internal/model"
+	schema "github.com/okdp/okdp-server/internal/schema/parameters"
+	"github.com/okdp/okdp-server/internal/utils"
+)
+
+// UpgradeService upgrades the releases of a package to a new version, either on the clusters or in the git repos.
+// The unreachable clusters and git repos are skipped (with a warning) and reported in the outcome of the upgrade.
+type UpgradeService struct {
+	k8s     *k8s.K8S
+	git     *git.Repository
+	catalog *oci.RepoCatalog
+}
+
+func NewUpgradeService() *UpgradeService {
+	return &UpgradeService{
+		k8s:     k8s.NewK8S(),
+		git:     git.NewRepository(),
+		catalog: oci.NewRepoCatalog(),
+	}
+}
+
+// releaseUpgrades selects the releases to upgrade and validates their parameters against the new version
+type releaseUpgrades struct {
+	*model.Upgrade
+	namespace        string
+	options          *model.ListOptions
+	parametersSchema *schema.KuboSchemaItem
+}
+
+// UpgradeReleases bumps the package version of the selected releases. The parameters of the releases are validated
+// against the schema of the new version first, the invalid releases are not upgraded.
+func (s UpgradeService) UpgradeReleases(ctx context.Context, request *model.UpgradeRequest, dryRun bool, author string, email string) (*model.Upgrade, *model.ServerResponse) {
+	if request.Package == "" || request.Version == "" {
+		return nil, model.NewServerResponse(model.OkdpServerResponse).
+			WithCode(model.ErrValidationFailed).
+			BadRequest("The package and the version to upgrade to are required")
+	}
+	options, err := model.NewListOptions(nil, "", "", "", utils.OrEmpty(request.LabelSelector))
+	if err != nil {
+		return nil, err
+	}
+	clusters, err := selectClusters(s.k8s, request.ClusterId)
+	if err != nil {
+		return nil, err
+	}
+	parametersSchema, err := parametersSchemaOf(ctx, s.catalog, request.Package, request.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	upgrades := &releaseUpgrades{
+		Upgrade:          model.NewUpgrade(request, utils.DefaultIfEmpty(string(utils.OrEmpty(request.Target)), model.UpgradeTargetCluster), dryRun),
+		namespace:        utils.OrEmpty(request.Namespace),
+		options:          options,
+		parametersSchema: parametersSchema,
+	}
+
+	if upgrades.Target == model.UpgradeTargetGit {
+		commitOpts := model.NewGitCommitOptions(fmt.Sprintf("Upgrade package %s to version %s", request.Package, request.Version)).
+			Author(author).
+			Email(email)
+		repos := newGitUpgrades()
+		for _, cluster := range clusters {
+			s.planGitUpgrades(ctx, cluster.ID, upgrades, repos)
+		}
+		if !upgrades.DryRun {
+			for _, repo := range repos.ordered {
+				s.pushGitUpgrade(ctx, repo, commitOpts)
+			}
+		}
+	} else {
+		patch, err := model.UpgradePatch(request.Version)
+		if err != nil {
+			return nil, err
+		}
+		for _, cluster := range clusters {
+			s.upgradeClusterReleases(ctx, cluster.ID, upgrades, patch, author, email)
+		}
+	}
+
+	upgrades.Sort()
+	return upgrades.Upgrade, nil
+}
+
+func (s UpgradeService) upgradeClusterReleases(ctx context.Context, clusterID string, upgrades *releaseUpgrades, patch *model.Patch, author string, email string) {
+	releases, err := s.k8s.ListReleases(ctx, clusterID)
+	if err != nil {
+		log.Ctx(ctx).Warn("Skipping the upgrade of the releases of cluster '%s', details: %+v", clusterID, err)
+		upgrades.Skip(clusterID, "", err)
+		return
+	}
+
+	for _, release := range releases {
+		change := upgrades.add(clusterID, release, release.Namespace)
+		if change == nil || change.Error != nil {
+			continue
+		}
+		upgraded, err := s.k8s.PatchRelease(ctx, clusterID, release.Namespace, release.Name, patch, upgrades.DryRun, release.ResourceVersion)
+		if err != nil {
+			change.Fail(model.UpgradeFailed, err)
+			continue
+		}
+		if !upgrades.DryRun {
+			change.Status = model.UpgradeUpgraded
+			recordRevision(ctx, s.k8s, clusterID, release.Namespace, upgraded, author, email, fmt.Sprintf("Upgrade to version %s", upgrades.Version))
+		}
+	}
+}
+
+// gitUpgrade gathers the upgraded files of a git repo (same url and reference), whatever the kustomizations and
+// the clusters they are read through, so that they are pushed with a single commit
+type gitUpgrade struct {
+	clusterID string
+	repo      *model.GitRepository
+	read      map[string]bool
+	paths     map[string]bool
+	files     []*model.GitFileChange
+	changes   []*model.ReleaseUpgrade
+}
+
+// gitUpgrades indexes the git upgrades by git repo url and reference, in the order they are found
+type gitUpgrades struct {
+	byRepo  map[string]*gitUpgrade
+	ordered []*gitUpgrade
+}
+
+func newGitUpgrades() *gitUpgrades {
+	return &gitUpgrades{byRepo: map[string]*gitUpgrade{}}
+}
+
+// of returns the upgrade of the git repo, the repo is pushed with the credentials of the first kustomization found
+func (g *gitUpgrades) of(clusterID string, repo *model.GitRepository) *gitUpgrade {
+	key := repo.RepoURL + "@" + repo.Ref
+	upgrade, found := g.byRepo[key]
+	if !found {
+		upgrade = &gitUpgrade{clusterID: clusterID, repo: repo, read: map[string]bool{}, paths: map[string]bool{}}
+		g.byRepo[key] = upgrade
+		g.ordered = append(g.ordered, upgrade)
+	}
+	return upgrade
+}
+
+// planGitUpgrades adds the upgrades of the releases of the git repos of the cluster. The folders already read
+// (same url, reference and path) through another kustomization or cluster are skipped, as well as the files.
+func (s UpgradeService) planGitUpgrades(ctx context.Context, clusterID string, upgrades *releaseUpgrades, repos *gitUpgrades) {
+	gitRepos, err := s.git.ListGitRepos(ctx, clusterID, "")
+	if err != nil {
+		log.Ctx(ctx).Warn("Skipping the upgrade of the git releases of cluster '%s', details: %+v", clusterID, err)
+		upgrades.Skip(clusterID, "", err)
+		return
+	}
+
+	for _, repo := range gitRepos {
+		upgrade := repos.of(clusterID, repo)
+		if upgrade.read[repo.Path] {
+			continue
+		}
+		upgrade.read[repo.Path] = true
+
+		kustomization, err := s.k8s.GetKustomization(ctx, clusterID, repo.Namespace, repo.Name)
+		if err != nil {
+			log.Ctx(ctx).Warn("Skipping the upgrade of the releases of the git repo '%s/%s' on cluster '%s', details: %+v", repo.Namespace, repo.Name, clusterID, err)
+			upgrades.Skip(clusterID, repo.Namespace+"/"+repo.Name, err)
+			continue
+		}
+		contents, err := s.git.GetContents(ctx, clusterID, repo.Namespace, repo.Name)
+		if err != nil {
+			log.Ctx(ctx).Warn("Skipping the upgrade of the releases of the git repo '%s/%s' on cluster '%s', details: %+v", repo.Namespace, repo.Name, clusterID, err)
+			upgrades.Skip(clusterID, repo.Namespace+"/"+repo.Name, err)
+			continue
+		}
+
+		for _, content := range contents {
+			if upgrade.paths[content.Path] {
+				continue
+			}
+			upgrade.paths[content.Path] = true
+			release, err := content.ToRelease()
+			if err != nil {
+				log.Ctx(ctx).Warn("Ignoring file '%s' of the git repo '%s/%s': not a KuboCD release, details: %+v", content.Path, repo.Namespace, repo.Name, err)
+				continue
+			}
+			change := upgrades.add(clusterID, release, appliedNamespace(kustomization.Spec.TargetNamespace, release))
+			if change == nil {
+				continue
+			}
+			change.GitRepo = repo.Namespace + "/" + repo.Name
+			change.Path = content.Path
+			if change.Error != nil {
+				continue
+			}
+
+			yaml, er := release.WithVersion(upgrades.Version).SanitizeMetadata().SanitizeStatus().ToYAML()
+			if er != nil {
+				change.Fail(model.UpgradeFailed, model.NewServerResponse(model.OkdpServerResponse).
+					UnprocessableEntity("Unable to serialize the release '%s/%s': %v", release.Namespace, release.Name, er))
+				continue
+			}
+			upgrade.files = append(upgrade.files, &model.GitFileChange{Path: content.Path, Content: yaml, IfMatch: content.SHA})
+			upgrade.changes = append(upgrade.changes, change)
+		}
+	}
+}
+
+// pushGitUpgrade pushes the upgraded files of the git repo with a single commit
+func (s UpgradeService) pushGitUpgrade(ctx context.Context, upgrade *gitUpgrade, commitOpts *model.GitCommit) {
+	if len(upgrade.files) == 0 {
+		return
+	}
+	if err := s.git.WriteFiles(ctx, upgrade.clusterID, upgrade.repo.Namespace, upgrade.repo.Name, upgrade.files, commitOpts); err != nil {
+		for _, change := range upgrade.changes {
+			change.Fail(model.UpgradeFailed, err)
+		}
+		return
+	}
+	for _, change := range upgrade.changes {
+		change.Status = model.UpgradeUpgraded
+	}
+}
+
+// add adds the upgrade of the release deployed in the namespace if it is selected, the upgrade is invalid when the
+// parameters of the release do not match the schema of the new version. It returns nil if the release is not selected.
+func (u *releaseUpgrades) add(clusterID string, release *model.Release, namespace string) *model.ReleaseUpgrade {
+	selected := release.IsPackage(u.Package) && release.Spec.Package.Tag != u.Version &&
+		(u.namespace == "" || namespace == u.namespace) && u.options.MatchesLabels(release.Labels)
+	if !selected {
+		return nil
+	}
+
+	change := model.NewReleaseUpgrade(clusterID, release, u.Version)
+	change.Namespace = namespace
+	u.Releases = append(u.Releases, change)
+	if u.parametersSchema != nil {
+		if err := checkParameters(u.parametersSchema, release.WithVersion(u.Version)); err != nil {
+			change.Fail(model.UpgradeInvalid, err)
+		}
+	}
+	return change
+}
+
+// ListOutdatedReleases returns the releases of the cluster, or of a project, deploying an outdated version of their
+// package or a version which no longer exists in the registry. The schema changes go from the deployed version to
+// the latest one. The releases of the packages which are not in the catalogs are ignored.
+func (s UpgradeService) ListOutdatedReleases(ctx context.Context, clusterID string, namespace string) ([]*model.OutdatedRelease, *model.ServerResponse) {
+	var namespaces []string
+	if namespace != "" {
+		if _, err := s.k8s.GetNamespaceByName(ctx, clusterID, namespace); err != nil {
+			return nil, err
+		}
+		namespaces = append(namespaces, namespace)
+	}
+	releases, err := s.k8s.ListReleases(ctx, clusterID, namespaces...)
+	if err != nil {
+		return nil, err
+	}
+
+	packages := map[string]*model.Package{}
+	schemas := map[string]interface{}{}
+	outdated := []*model.OutdatedRelease{}
+	for _, release := range releases {
+		catalogID, name, found := s.catalog.FindPackage(release.Spec.Package.Repository)
+		if !found {
+			continue
+		}
+		key := catalogID + "/" + name
+		pkg, cached := packages[key]
+		if !cached {
+			if pkg, err = s.catalog.GetPackage(catalogID, name); err != nil {
+				log.Ctx(ctx).Warn("Skipping the releases of package '%s' in catalog '%s', details: %+v", name, catalogID, err)
+			}
+			packages[key] = pkg
+		}
+		if pkg == nil {
+			continue
+		}
+
+		advice := model.NewOutdatedRelease(clusterID, release, catalogID, pkg)
+		if !advice.Outdated && !advice.TagMissing {
+			continue
+		}
+		if advice.Outdated && !advice.TagMissing {
+			advice.SchemaChanges = s.schemaChanges(ctx, catalogID, name, advice.CurrentVersion, advice.LatestMajor, schemas)
+		}
+		outdated = append(outdated, advice)
+	}
+
+	model.SortOutdatedReleases(outdated)
+	return outdated, nil
+}
+
+// schemaChanges returns the changes of the package schema between the two versions, the schemas are cached
+// in schemas by package version
+func (s UpgradeService) schemaChanges(ctx context.Context, catalogID string, name string, from string, to string, schemas map[string]interface{}) []utils.FieldDiff {
+	schemaOf := func(version string) (interface{}, bool) {
+		key := catalogID + "/" + name + ":" + version
+		if packageSchema, found := schemas[key]; found {
+			return packageSchema, true
+		}
+		definition, err := s.catalog.GetPackageDefinition(ctx, catalogID, name, version)
+		if err != nil {
+			log.Ctx(ctx).Warn("Unable to read the schema of package '%s:%s' in catalog '%s', details: %+v", name, version, catalogID, err)
+			return nil, false
+		}
+		schemas[key] = definition["schema"]
+		return definition["schema"], true
+	}
+
+	before, found := schemaOf(from)
+	if !found {
+		return nil
+	}
+	after, found := schemaOf(to)
+	if !found {
+		return nil
+	}
+	diffs, err := utils.DiffObjects(before, after)
+	if err != nil {
+		log.Ctx(ctx).Warn("Unable to compare the schemas of package '%s' versions '%s' and '%s', details: %v", name, from, to, err)
+		return nil
+	}
+	return diffs
+}
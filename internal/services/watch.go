@@ -0,0 +1,48 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package services
+
+import (
+	"context"
+
+	"github.com/okdp/okdp-server/internal/integrations/k8s"
+	"github.com/okdp/okdp-server/internal/model"
+)
+
+// WatchService streams the live notifications of the Kubernetes resources,
+// the watches end when the context (of the HTTP request) is done.
+type WatchService struct {
+	k8s *k8s.K8S
+}
+
+func NewWatchService() *WatchService {
+	return &WatchService{
+		k8s: k8s.NewK8S(),
+	}
+}
+
+func (s WatchService) WatchReleases(ctx context.Context, clusterID string, namespace string, resourceVersion string) (<-chan *model.WatchEvent, *model.ServerResponse) {
+	return s.k8s.WatchReleases(ctx, clusterID, namespace, resourceVersion)
+}
+
+func (s WatchService) WatchPods(ctx context.Context, clusterID string, namespace string, releaseName string, resourceVersion string) (<-chan *model.WatchEvent, *model.ServerResponse) {
+	return s.k8s.WatchPods(ctx, clusterID, namespace, releaseName, resourceVersion)
+}
+
+func (s WatchService) WatchEventsRelease(ctx context.Context, clusterID string, namespace string, releaseName string, resourceVersion string) (<-chan *model.WatchEvent, *model.ServerResponse) {
+	return s.k8s.WatchEventsRelease(ctx, clusterID, namespace, releaseName, resourceVersion)
+}
@@ -0,0 +1,135 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package utils
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	DiffAdd     = "add"
+	DiffRemove  = "remove"
+	DiffReplace = "replace"
+)
+
+type FieldDiff struct {
+	Path string      `json:"path"`
+	Op   string      `json:"op"`
+	From interface{} `json:"from,omitempty"`
+	To   interface{} `json:"to,omitempty"`
+}
+
+// DiffObjects compares the JSON representations of `before` and `after` and returns
+// the field level changes, sorted by path.
+// Paths are dot separated (spec.parameters.replicas), array indexes are written as path segments (spec.contexts.0).
+// The fields listed in `ignored` (using the same dot notation) and their children are skipped.
+func DiffObjects(before, after interface{}, ignored ...string) ([]FieldDiff, error) {
+	b, err := toGeneric(before)
+	if err != nil {
+		return nil, err
+	}
+	a, err := toGeneric(after)
+	if err != nil {
+		return nil, err
+	}
+
+	diffs := []FieldDiff{}
+	diffValues("", b, a, ignored, &diffs)
+	sort.SliceStable(diffs, func(i, j int) bool {
+		return diffs[i].Path < diffs[j].Path
+	})
+	return diffs, nil
+}
+
+func diffValues(path string, before, after interface{}, ignored []string, diffs *[]FieldDiff) {
+	if Contains(ignored, path) {
+		return
+	}
+
+	switch {
+	case before == nil && after == nil:
+		return
+	case before == nil:
+		*diffs = append(*diffs, FieldDiff{Path: path, Op: DiffAdd, To: after})
+		return
+	case after == nil:
+		*diffs = append(*diffs, FieldDiff{Path: path, Op: DiffRemove, From: before})
+		return
+	}
+
+	beforeMap, beforeIsMap := before.(map[string]interface{})
+	afterMap, afterIsMap := after.(map[string]interface{})
+	if beforeIsMap && afterIsMap {
+		keys := map[string]bool{}
+		for k := range beforeMap {
+			keys[k] = true
+		}
+		for k := range afterMap {
+			keys[k] = true
+		}
+		for k := range keys {
+			diffValues(joinPath(path, k), beforeMap[k], afterMap[k], ignored, diffs)
+		}
+		return
+	}
+
+	beforeList, beforeIsList := before.([]interface{})
+	afterList, afterIsList := after.([]interface{})
+	if beforeIsList && afterIsList {
+		for i := 0; i < len(beforeList) || i < len(afterList); i++ {
+			var b, a interface{}
+			if i < len(beforeList) {
+				b = beforeList[i]
+			}
+			if i < len(afterList) {
+				a = afterList[i]
+			}
+			diffValues(joinPath(path, strconv.Itoa(i)), b, a, ignored, diffs)
+		}
+		return
+	}
+
+	if !reflect.DeepEqual(before, after) {
+		*diffs = append(*diffs, FieldDiff{Path: path, Op: DiffReplace, From: before, To: after})
+	}
+}
+
+func joinPath(path string, key string) string {
+	if path == "" {
+		return key
+	}
+	return strings.Join([]string{path, key}, ".")
+}
+
+func toGeneric(obj interface{}) (interface{}, error) {
+	if obj == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	var generic interface{}
+	if err := json.Unmarshal(data, &generic); err != nil {
+		return nil, err
+	}
+	return generic, nil
+}
@@ -0,0 +1,90 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffObjects(t *testing.T) {
+	// Given
+	before := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":            "podinfo",
+			"resourceVersion": "1",
+		},
+		"spec": map[string]interface{}{
+			"parameters": map[string]interface{}{
+				"replicas": 1,
+				"host":     "podinfo.local",
+			},
+			"contexts": []interface{}{"ctx1"},
+		},
+	}
+	after := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":            "podinfo",
+			"resourceVersion": "2",
+		},
+		"spec": map[string]interface{}{
+			"parameters": map[string]interface{}{
+				"replicas": 2,
+				"tls":      true,
+			},
+			"contexts": []interface{}{"ctx1", "ctx2"},
+		},
+	}
+
+	// When
+	diffs, err := DiffObjects(before, after, "metadata.resourceVersion")
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, []FieldDiff{
+		{Path: "spec.contexts.1", Op: DiffAdd, To: "ctx2"},
+		{Path: "spec.parameters.host", Op: DiffRemove, From: "podinfo.local"},
+		{Path: "spec.parameters.replicas", Op: DiffReplace, From: float64(1), To: float64(2)},
+		{Path: "spec.parameters.tls", Op: DiffAdd, To: true},
+	}, diffs)
+}
+
+func TestDiffObjectsNoChange(t *testing.T) {
+	// Given
+	obj := struct {
+		Name string `json:"name"`
+	}{Name: "podinfo"}
+
+	// When
+	diffs, err := DiffObjects(obj, obj)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Empty(t, diffs)
+}
+
+func TestDiffObjectsNilBefore(t *testing.T) {
+	// When
+	diffs, err := DiffObjects(nil, map[string]interface{}{"name": "podinfo"})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, []FieldDiff{
+		{Path: "", Op: DiffAdd, To: map[string]interface{}{"name": "podinfo"}},
+	}, diffs)
+}
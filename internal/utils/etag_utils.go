@@ -0,0 +1,45 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package utils
+
+import "strings"
+
+// ETag returns the strong entity tag (quoted) of a resource version.
+//
+// Example:
+//
+//	ETag("123456") // returns "\"123456\""
+func ETag(version string) string {
+	return `"` + version + `"`
+}
+
+// ParseETag returns the resource version of an entity tag, ignoring the weak indicator (W/) and the quotes.
+//
+// Example:
+//
+//	ParseETag("W/\"123456\"") // returns "123456"
+func ParseETag(tag string) string {
+	tag = strings.TrimSpace(tag)
+	tag = strings.TrimPrefix(tag, "W/")
+	return strings.Trim(tag, `"`)
+}
+
+// MatchesETag checks an If-Match resource version against the current version of a resource.
+// An empty If-Match (not set) and the "*" wildcard match any version.
+func MatchesETag(ifMatch string, version string) bool {
+	return ifMatch == "" || ifMatch == "*" || ifMatch == version
+}
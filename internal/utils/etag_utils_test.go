@@ -0,0 +1,65 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestETag(t *testing.T) {
+	assert.Equal(t, `"123456"`, ETag("123456"))
+}
+
+func TestParseETag(t *testing.T) {
+	tests := []struct {
+		name     string
+		tag      string
+		expected string
+	}{
+		{"Strong tag", `"123456"`, "123456"},
+		{"Weak tag", `W/"123456"`, "123456"},
+		{"Unquoted tag", "123456", "123456"},
+		{"Wildcard", "*", "*"},
+		{"Empty", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ParseETag(tt.tag))
+		})
+	}
+}
+
+func TestMatchesETag(t *testing.T) {
+	assert.True(t, MatchesETag("", "123456"))
+	assert.True(t, MatchesETag("*", "123456"))
+	assert.True(t, MatchesETag("123456", "123456"))
+	assert.False(t, MatchesETag("123455", "123456"))
+}
+
+func TestGitBlobSHA(t *testing.T) {
+	// Given
+	content := []byte("hello\n")
+
+	// When
+	sha := GitBlobSHA(content)
+
+	// Then
+	assert.Equal(t, "ce013625030ba8dba906f756967f9e9ca394464a", sha)
+}
@@ -19,6 +19,7 @@ package utils
 import (
 	"crypto/sha1"
 	"encoding/hex"
+	"fmt"
 	"strings"
 )
 
@@ -28,3 +29,11 @@ func FromString(input string) string {
 	hash := sha1.Sum([]byte(normalized))
 	return hex.EncodeToString(hash[:6]) // 6 bytes -> 12 hex chars
 }
+
+// GitBlobSHA returns the git blob hash of the content (same as 'git hash-object')
+func GitBlobSHA(content []byte) string {
+	h := sha1.New()
+	fmt.Fprintf(h, "blob %d\x00", len(content))
+	h.Write(content)
+	return hex.EncodeToString(h.Sum(nil))
+}
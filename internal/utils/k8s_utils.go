@@ -17,6 +17,8 @@
 package utils
 
 import (
+	"strings"
+
 	"github.com/okdp/okdp-server/internal/common/constants"
 	corev1 "k8s.io/api/core/v1"
 )
@@ -55,6 +57,13 @@ func MergeAnnotations(ns *corev1.Namespace, newAnnotations *map[string]string) {
 	}
 }
 
+// RemoveAnnotations removes the given annotations from an existing Namespace's annotations.
+func RemoveAnnotations(ns *corev1.Namespace, keys []string) {
+	for _, k := range keys {
+		delete(ns.Annotations, k)
+	}
+}
+
 // GetPodHealth returns a high-level health status string for a given Kubernetes Pod.
 // - If the Pod phase is "Running" and all containers are ready, returns "Healthy".
 // - If the Pod is "Running" but not all containers are ready, returns "NotReady".
@@ -122,3 +131,20 @@ func GetContainerState(pod *corev1.Pod, containerName string) ContainerStateInfo
 	}
 	return ContainerStateInfo{State: constants.StateUnknown}
 }
+
+// RevisionCommit returns the commit hash of a fluxcd source revision.
+// Both the current (main@sha1:<hash>) and the legacy (main/<hash>) formats are supported.
+//
+// Example:
+//
+//	RevisionCommit("main@sha1:6c2709d") // returns "6c2709d"
+//	RevisionCommit("main/6c2709d")      // returns "6c2709d"
+func RevisionCommit(revision string) string {
+	if i := strings.LastIndex(revision, ":"); i >= 0 {
+		return revision[i+1:]
+	}
+	if i := strings.LastIndex(revision, "/"); i >= 0 {
+		return revision[i+1:]
+	}
+	return revision
+}
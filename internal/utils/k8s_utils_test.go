@@ -146,3 +146,40 @@ func TestMergeAnnotations_NilAnnotationsInNamespace(t *testing.T) {
 		t.Errorf("expected 'newKey' annotation to be %q, got %q", newValue, val)
 	}
 }
+
+func TestRevisionCommit(t *testing.T) {
+	cases := map[string]string{
+		"main@sha1:6c2709d1b2":   "6c2709d1b2",
+		"v1.0.0@sha1:6c2709d1b2": "6c2709d1b2",
+		"sha1:6c2709d1b2":        "6c2709d1b2",
+		"main/6c2709d1b2":        "6c2709d1b2",
+		"6c2709d1b2":             "6c2709d1b2",
+		"":                       "",
+	}
+
+	for revision, expected := range cases {
+		if commit := RevisionCommit(revision); commit != expected {
+			t.Errorf("expected commit of %q to be %q, got %q", revision, expected, commit)
+		}
+	}
+}
+
+func TestRemoveAnnotations(t *testing.T) {
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				"removed": "value",
+				"kept":    "value",
+			},
+		},
+	}
+
+	RemoveAnnotations(ns, []string{"removed", "missing"})
+
+	if _, ok := ns.ObjectMeta.Annotations["removed"]; ok {
+		t.Errorf("expected 'removed' annotation to be removed")
+	}
+	if _, ok := ns.ObjectMeta.Annotations["kept"]; !ok {
+		t.Errorf("expected 'kept' annotation to remain")
+	}
+}
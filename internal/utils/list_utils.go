@@ -0,0 +1,129 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package utils
+
+import (
+	"cmp"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Cursor is the position of a page in a list: the offset of its first item
+// in the filtered and sorted list and, when the list is paginated by the
+// Kubernetes API server, the continue token returned by the previous call.
+type Cursor struct {
+	Offset int    `json:"o"`
+	Token  string `json:"t,omitempty"`
+}
+
+// Encode returns the opaque (base64 url) representation of the cursor
+func (c Cursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor parses an opaque cursor returned by Cursor.Encode.
+// An empty value is the cursor of the first page.
+func DecodeCursor(value string) (Cursor, error) {
+	var cursor Cursor
+	if value == "" {
+		return cursor, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return cursor, fmt.Errorf("invalid continue token")
+	}
+	if err := json.Unmarshal(data, &cursor); err != nil || cursor.Offset < 0 {
+		return Cursor{}, fmt.Errorf("invalid continue token")
+	}
+	return cursor, nil
+}
+
+// Paginate returns the page of items starting at the cursor offset, and the cursor
+// of the next page (nil on the last page). All the remaining items are returned when
+// limit is not positive.
+func Paginate[T any](items []T, cursor Cursor, limit int) ([]T, *Cursor) {
+	start := min(cursor.Offset, len(items))
+	if limit <= 0 || start+limit >= len(items) {
+		return items[start:], nil
+	}
+	return items[start : start+limit], &Cursor{Offset: start + limit}
+}
+
+// Comparator compares two items, returning a negative number when a < b,
+// a positive number when a > b and zero otherwise.
+type Comparator[T any] func(a, b T) int
+
+// SortBy sorts the items in place by the given sort key, looked up in the provided comparators.
+// The key may be prefixed by '-' for a descending order. The sort is stable so that the
+// natural order of the items is kept for equal keys.
+func SortBy[T any](items []T, sortKey string, comparators map[string]Comparator[T]) error {
+	if sortKey == "" {
+		return nil
+	}
+	key, desc := strings.CutPrefix(sortKey, "-")
+	compare, found := comparators[key]
+	if !found {
+		return fmt.Errorf("unsupported sort key '%s'", key)
+	}
+	slices.SortStableFunc(items, func(a, b T) int {
+		if desc {
+			return compare(b, a)
+		}
+		return compare(a, b)
+	})
+	return nil
+}
+
+// CompareStrings returns a comparator on a string field
+func CompareStrings[T any](field func(T) string) Comparator[T] {
+	return func(a, b T) int {
+		return cmp.Compare(field(a), field(b))
+	}
+}
+
+// CompareTimes returns a comparator on a time field
+func CompareTimes[T any](field func(T) time.Time) Comparator[T] {
+	return func(a, b T) int {
+		return field(a).Compare(field(b))
+	}
+}
+
+// MatchesSearch checks whether one of the values contains the search text (case insensitive).
+// An empty search text matches all the values.
+func MatchesSearch(search string, values ...string) bool {
+	if search == "" {
+		return true
+	}
+	search = strings.ToLower(search)
+	for _, value := range values {
+		if strings.Contains(strings.ToLower(value), search) {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchesFilter checks whether the value equals the filter (case insensitive).
+// An empty filter matches all the values.
+func MatchesFilter(filter string, value string) bool {
+	return filter == "" || strings.EqualFold(filter, value)
+}
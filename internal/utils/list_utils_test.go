@@ -0,0 +1,129 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCursorEncodeDecode(t *testing.T) {
+	// Given
+	cursor := Cursor{Offset: 20, Token: "k8s-continue-token"}
+
+	// When
+	decoded, err := DecodeCursor(cursor.Encode())
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, cursor, decoded)
+}
+
+func TestDecodeCursor_Empty(t *testing.T) {
+	// When
+	cursor, err := DecodeCursor("")
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, Cursor{}, cursor)
+}
+
+func TestDecodeCursor_Invalid(t *testing.T) {
+	for _, value := range []string{"not base64!", "bm90IGpzb24", Cursor{Offset: -1}.Encode()} {
+		// When
+		_, err := DecodeCursor(value)
+
+		// Then
+		assert.Error(t, err, value)
+	}
+}
+
+func TestPaginate(t *testing.T) {
+	// Given
+	items := []int{1, 2, 3, 4, 5}
+
+	// When
+	page1, next1 := Paginate(items, Cursor{}, 2)
+	page2, next2 := Paginate(items, *next1, 2)
+	page3, next3 := Paginate(items, *next2, 2)
+
+	// Then
+	assert.Equal(t, []int{1, 2}, page1)
+	assert.Equal(t, []int{3, 4}, page2)
+	assert.Equal(t, []int{5}, page3)
+	assert.Nil(t, next3)
+}
+
+func TestPaginate_NoLimit(t *testing.T) {
+	// Given
+	items := []int{1, 2, 3}
+
+	// When
+	page, next := Paginate(items, Cursor{Offset: 1}, 0)
+
+	// Then
+	assert.Equal(t, []int{2, 3}, page)
+	assert.Nil(t, next)
+}
+
+func TestPaginate_OffsetOutOfRange(t *testing.T) {
+	// When
+	page, next := Paginate([]int{1, 2}, Cursor{Offset: 10}, 5)
+
+	// Then
+	assert.Empty(t, page)
+	assert.Nil(t, next)
+}
+
+func TestSortBy(t *testing.T) {
+	type item struct {
+		name    string
+		created time.Time
+	}
+	now := time.Now()
+	items := []item{{"b", now}, {"c", now.Add(-time.Hour)}, {"a", now.Add(time.Hour)}}
+	comparators := map[string]Comparator[item]{
+		"name":              CompareStrings(func(i item) string { return i.name }),
+		"creationTimestamp": CompareTimes(func(i item) time.Time { return i.created }),
+	}
+
+	// When / Then
+	assert.NoError(t, SortBy(items, "name", comparators))
+	assert.Equal(t, []string{"a", "b", "c"}, Map(items, func(i item) string { return i.name }))
+
+	assert.NoError(t, SortBy(items, "-creationTimestamp", comparators))
+	assert.Equal(t, []string{"a", "b", "c"}, Map(items, func(i item) string { return i.name }))
+
+	assert.NoError(t, SortBy(items, "creationTimestamp", comparators))
+	assert.Equal(t, []string{"c", "b", "a"}, Map(items, func(i item) string { return i.name }))
+
+	assert.Error(t, SortBy(items, "size", comparators))
+}
+
+func TestMatchesSearch(t *testing.T) {
+	assert.True(t, MatchesSearch("", "podinfo"))
+	assert.True(t, MatchesSearch("INFO", "redis", "podinfo"))
+	assert.False(t, MatchesSearch("kafka", "redis", "podinfo"))
+}
+
+func TestMatchesFilter(t *testing.T) {
+	assert.True(t, MatchesFilter("", "READY"))
+	assert.True(t, MatchesFilter("ready", "READY"))
+	assert.False(t, MatchesFilter("ERROR", "READY"))
+}
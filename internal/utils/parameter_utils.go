@@ -38,6 +38,20 @@ func DefaultIfEmpty(value, defaultValue string) string {
 	return defaultValue
 }
 
+// FirstNonEmpty returns the first non empty value, or "" if all the values are empty.
+//
+// Example:
+//
+//	FirstNonEmpty("", "main", "v1.0.0") // returns "main"
+func FirstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
 // EmptyToNil returns a pointer to the given string, unless the string is empty.
 // If the input string is "", it returns nil. Otherwise, it returns a pointer to the string.
 // This is useful when you want to omit empty fields in JSON serialization with the 'omitempty' tag.
@@ -48,6 +62,15 @@ func EmptyToNil(s string) *string {
 	return &s
 }
 
+// OrEmpty returns the value of the given string pointer, or "" if nil.
+// It accepts the generated string enum types of the query parameters.
+func OrEmpty[T ~string](s *T) string {
+	if s == nil {
+		return ""
+	}
+	return string(*s)
+}
+
 // NilToEmptySlice returns the input slice if not nil, or an empty slice if input is nil.
 // Useful to ensure you never return a nil slice (for API responses, etc).
 func NilToEmptySlice[T any](s []T) []T {
@@ -78,3 +78,37 @@ func TestDefaultIfEmpty(t *testing.T) {
 		})
 	}
 }
+
+func TestFirstNonEmpty(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []string
+		expected string
+	}{
+		{"No values", nil, ""},
+		{"All empty", []string{"", ""}, ""},
+		{"First non empty", []string{"", "main", "v1.0.0"}, "main"},
+		{"First value", []string{"main", ""}, "main"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := FirstNonEmpty(tt.values...)
+			if result != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestOrEmpty(t *testing.T) {
+	type sortKey string
+	value := sortKey("-name")
+
+	if result := OrEmpty[sortKey](nil); result != "" {
+		t.Errorf("Expected empty string, got %q", result)
+	}
+	if result := OrEmpty(&value); result != "-name" {
+		t.Errorf("Expected %q, got %q", "-name", result)
+	}
+}
@@ -0,0 +1,94 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// IsSupportedPatch returns true if the patch type is a JSON Patch (RFC 6902) or a JSON Merge Patch (RFC 7386)
+func IsSupportedPatch(patchType types.PatchType) bool {
+	return patchType == types.JSONPatchType || patchType == types.MergePatchType
+}
+
+// ApplyPatch applies the JSON Patch (RFC 6902) or JSON Merge Patch (RFC 7386) `patch` to the JSON representation
+// of `obj` and unmarshals the patched document into `out`.
+func ApplyPatch(obj interface{}, patchType types.PatchType, patch []byte, out interface{}) error {
+	doc, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+
+	var patched []byte
+	switch patchType {
+	case types.JSONPatchType:
+		ops, err := jsonpatch.DecodePatch(patch)
+		if err != nil {
+			return err
+		}
+		if patched, err = ops.Apply(doc); err != nil {
+			return err
+		}
+	case types.MergePatchType:
+		if patched, err = jsonpatch.MergePatch(doc, patch); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unsupported patch type '%s'", patchType)
+	}
+
+	return json.Unmarshal(patched, out)
+}
+
+// WithResourceVersion adds the metadata.resourceVersion to the patch,
+// so that the Kubernetes API server rejects it with a conflict if the object changed since this version.
+func WithResourceVersion(patchType types.PatchType, patch []byte, resourceVersion string) ([]byte, error) {
+	switch patchType {
+	case types.JSONPatchType:
+		var ops []map[string]interface{}
+		if err := json.Unmarshal(patch, &ops); err != nil {
+			return nil, err
+		}
+		ops = append([]map[string]interface{}{{
+			"op":    "add",
+			"path":  "/metadata/resourceVersion",
+			"value": resourceVersion,
+		}}, ops...)
+		return json.Marshal(ops)
+	case types.MergePatchType:
+		var doc map[string]interface{}
+		if err := json.Unmarshal(patch, &doc); err != nil {
+			return nil, err
+		}
+		if doc == nil {
+			doc = map[string]interface{}{}
+		}
+		metadata, _ := doc["metadata"].(map[string]interface{})
+		if metadata == nil {
+			metadata = map[string]interface{}{}
+		}
+		metadata["resourceVersion"] = resourceVersion
+		doc["metadata"] = metadata
+		return json.Marshal(doc)
+	default:
+		return nil, fmt.Errorf("unsupported patch type '%s'", patchType)
+	}
+}
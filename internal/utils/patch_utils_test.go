@@ -0,0 +1,99 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+type patchTarget struct {
+	Name       string                 `json:"name"`
+	Parameters map[string]interface{} `json:"parameters,omitempty"`
+}
+
+func TestApplyPatch_JSONPatch(t *testing.T) {
+	// Given
+	obj := patchTarget{Name: "podinfo", Parameters: map[string]interface{}{"replicas": 1.0}}
+	patch := []byte(`[{"op":"replace","path":"/parameters/replicas","value":2},{"op":"add","path":"/parameters/host","value":"podinfo.local"}]`)
+
+	// When
+	var out patchTarget
+	err := ApplyPatch(obj, types.JSONPatchType, patch, &out)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, patchTarget{Name: "podinfo", Parameters: map[string]interface{}{"replicas": 2.0, "host": "podinfo.local"}}, out)
+}
+
+func TestApplyPatch_JSONPatchFailedTest(t *testing.T) {
+	// Given
+	obj := patchTarget{Name: "podinfo"}
+	patch := []byte(`[{"op":"test","path":"/name","value":"other"}]`)
+
+	// When
+	var out patchTarget
+	err := ApplyPatch(obj, types.JSONPatchType, patch, &out)
+
+	// Then
+	assert.Error(t, err)
+}
+
+func TestApplyPatch_MergePatch(t *testing.T) {
+	// Given
+	obj := patchTarget{Name: "podinfo", Parameters: map[string]interface{}{"replicas": 1.0, "host": "podinfo.local"}}
+	patch := []byte(`{"parameters":{"replicas":3,"host":null}}`)
+
+	// When
+	var out patchTarget
+	err := ApplyPatch(obj, types.MergePatchType, patch, &out)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, patchTarget{Name: "podinfo", Parameters: map[string]interface{}{"replicas": 3.0}}, out)
+}
+
+func TestApplyPatch_Unsupported(t *testing.T) {
+	// Given
+	obj := patchTarget{Name: "podinfo"}
+
+	// When
+	var out patchTarget
+	err := ApplyPatch(obj, types.StrategicMergePatchType, []byte(`{}`), &out)
+
+	// Then
+	assert.Error(t, err)
+	assert.False(t, IsSupportedPatch(types.StrategicMergePatchType))
+}
+
+func TestWithResourceVersion(t *testing.T) {
+	// Given
+	jsonPatch := []byte(`[{"op":"remove","path":"/spec/suspend"}]`)
+	mergePatch := []byte(`{"spec":{"suspend":true}}`)
+
+	// When
+	patchedJSON, err1 := WithResourceVersion(types.JSONPatchType, jsonPatch, "42")
+	patchedMerge, err2 := WithResourceVersion(types.MergePatchType, mergePatch, "42")
+
+	// Then
+	assert.NoError(t, err1)
+	assert.JSONEq(t, `[{"op":"add","path":"/metadata/resourceVersion","value":"42"},{"op":"remove","path":"/spec/suspend"}]`, string(patchedJSON))
+	assert.NoError(t, err2)
+	assert.JSONEq(t, `{"metadata":{"resourceVersion":"42"},"spec":{"suspend":true}}`, string(patchedMerge))
+}
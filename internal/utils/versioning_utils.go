@@ -66,3 +66,65 @@ func SortVersions(versions []string) []string {
 
 	return append(sorted, invalidVersions...)
 }
+
+// MatchesVersion checks whether a version matches the filter: an exact version (case insensitive)
+// or a semantic version constraint. The constraints are checked against the version without
+// its pre-release suffix (the KuboCD package revision), the invalid versions only match exactly.
+//
+// Example:
+//
+//	MatchesVersion("1.x", "1.4.6-p01")       // returns true
+//	MatchesVersion(">=1.2, <2", "2.0.0-p01") // returns false
+//	MatchesVersion("6.7.1-p01", "6.7.1-p01") // returns true
+func MatchesVersion(filter string, version string) bool {
+	if filter == "" || strings.EqualFold(filter, version) {
+		return true
+	}
+	constraint, err := semver.NewConstraint(filter)
+	if err != nil {
+		return false
+	}
+	sv, err := semver.NewVersion(strings.TrimPrefix(version, "v"))
+	if err != nil {
+		return false
+	}
+	core, err := sv.SetPrerelease("")
+	if err != nil {
+		return false
+	}
+	return constraint.Check(&core)
+}
+
+// LatestVersions returns the latest versions newer than the current one: within the same minor version (patch),
+// the same major version (minor) and overall (major). A version is empty when there is no newer version in its
+// range, the invalid versions are ignored. The pre-releases are ignored unless the current version is a pre-release too
+// (e.g. the KuboCD package revisions '1.2.0-p01').
+//
+// Example:
+//
+//	LatestVersions("1.2.0", []string{"1.2.1", "1.3.0", "2.0.0"}) // returns "1.2.1", "1.3.0", "2.0.0"
+//	LatestVersions("2.0.0", []string{"1.2.1", "2.0.0"})          // returns "", "", ""
+func LatestVersions(current string, versions []string) (string, string, string) {
+	cv, err := semver.NewVersion(strings.TrimPrefix(current, "v"))
+	if err != nil {
+		return "", "", ""
+	}
+
+	var patch, minor, major string
+	for _, v := range SortVersions(versions) {
+		sv, err := semver.NewVersion(strings.TrimPrefix(v, "v"))
+		if err != nil || !sv.GreaterThan(cv) || (sv.Prerelease() != "" && cv.Prerelease() == "") {
+			continue
+		}
+		if major == "" {
+			major = v
+		}
+		if minor == "" && sv.Major() == cv.Major() {
+			minor = v
+		}
+		if patch == "" && sv.Major() == cv.Major() && sv.Minor() == cv.Minor() {
+			patch = v
+		}
+	}
+	return patch, minor, major
+}
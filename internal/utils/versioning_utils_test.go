@@ -53,3 +53,77 @@ func TestSortVersions(t *testing.T) {
 		})
 	}
 }
+
+func TestMatchesVersion(t *testing.T) {
+	tests := []struct {
+		filter   string
+		version  string
+		expected bool
+	}{
+		{filter: "", version: "1.4.6-p01", expected: true},
+		{filter: "1.4.6-p01", version: "1.4.6-p01", expected: true},
+		{filter: "1.4.6-p01", version: "1.4.6-p02", expected: false},
+		{filter: "1.x", version: "1.4.6-p01", expected: true},
+		{filter: "1.x", version: "v1.0.0", expected: true},
+		{filter: "1.x", version: "2.0.0-p01", expected: false},
+		{filter: ">=1.2, <2", version: "1.10.0", expected: true},
+		{filter: ">=1.2, <2", version: "1.1.0", expected: false},
+		{filter: "latest", version: "latest", expected: true},
+		{filter: "1.x", version: "latest", expected: false},
+		{filter: "not a constraint", version: "1.0.0", expected: false},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%s matches %s", test.version, test.filter), func(t *testing.T) {
+			assert.Equal(t, test.expected, MatchesVersion(test.filter, test.version))
+		})
+	}
+}
+
+func TestLatestVersions(t *testing.T) {
+	tests := []struct {
+		current  string
+		versions []string
+		patch    string
+		minor    string
+		major    string
+	}{
+		{
+			current:  "1.2.0-p01",
+			versions: []string{"1.2.0-p01", "1.2.0-p02", "1.2.3-p01", "1.4.0-p01", "2.0.0-p01", "invalid"},
+			patch:    "1.2.3-p01", minor: "1.4.0-p01", major: "2.0.0-p01",
+		},
+		{
+			current:  "v1.2.0",
+			versions: []string{"v1.2.0", "v1.3.0"},
+			patch:    "", minor: "v1.3.0", major: "v1.3.0",
+		},
+		{
+			current:  "1.2.0",
+			versions: []string{"1.2.1-rc.1", "1.3.0", "2.0.0-beta.1"},
+			patch:    "", minor: "1.3.0", major: "1.3.0",
+		},
+		{
+			current:  "2.0.0",
+			versions: []string{"1.2.1", "2.0.0"},
+			patch:    "", minor: "", major: "",
+		},
+		{
+			current:  "latest",
+			versions: []string{"1.0.0"},
+			patch:    "", minor: "", major: "",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("Latest versions of %s", test.current), func(t *testing.T) {
+			// When
+			patch, minor, major := LatestVersions(test.current, test.versions)
+
+			// Then
+			assert.Equal(t, test.patch, patch)
+			assert.Equal(t, test.minor, minor)
+			assert.Equal(t, test.major, major)
+		})
+	}
+}
@@ -0,0 +1,68 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package validation
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+
+	"github.com/gin-gonic/gin"
+)
+
+// responseRecorder buffers the response so that it can be validated before being sent to the client
+type responseRecorder struct {
+	gin.ResponseWriter
+	body        *bytes.Buffer
+	status      int
+	wroteHeader bool
+}
+
+func (w *responseRecorder) WriteHeader(status int) {
+	w.status = status
+	w.wroteHeader = true
+}
+
+func (w *responseRecorder) WriteHeaderNow() {
+	w.wroteHeader = true
+}
+
+func (w *responseRecorder) Write(data []byte) (int, error) {
+	w.wroteHeader = true
+	return w.body.Write(data)
+}
+
+func (w *responseRecorder) WriteString(s string) (int, error) {
+	w.wroteHeader = true
+	return w.body.WriteString(s)
+}
+
+func (w *responseRecorder) Status() int {
+	return w.status
+}
+
+func (w *responseRecorder) Size() int {
+	return w.body.Len()
+}
+
+func (w *responseRecorder) Written() bool {
+	return w.wroteHeader
+}
+
+func writeJSON(w io.Writer, value interface{}) error {
+	return json.NewEncoder(w).Encode(value)
+}
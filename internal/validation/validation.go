@@ -0,0 +1,206 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package validation
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+	"github.com/gin-gonic/gin"
+
+	api "github.com/okdp/okdp-server/api/openapi/v3/_api"
+	log "github.com/okdp/okdp-server/internal/common/logging"
+	"github.com/okdp/okdp-server/internal/config"
+	"github.com/okdp/okdp-server/internal/model"
+)
+
+// OpenAPIValidator returns a middleware that validates the requests (path params, query params and body)
+// and optionally the responses against the embedded OpenAPI specification.
+// The requests that do not match any operation of the specification are not validated.
+func OpenAPIValidator(validationConf config.Validation) gin.HandlerFunc {
+	router := newRouter()
+	options := &openapi3filter.Options{
+		MultiError:         true,
+		AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+	}
+
+	return func(c *gin.Context) {
+		if !validationConf.Requests && !validationConf.Responses {
+			c.Next()
+			return
+		}
+
+		route, pathParams, err := router.FindRoute(c.Request)
+		if err != nil {
+			c.Next()
+			return
+		}
+
+		input := &openapi3filter.RequestValidationInput{
+			Request:    c.Request,
+			PathParams: pathParams,
+			Route:      route,
+			Options:    options,
+		}
+
+		if validationConf.Requests {
+			if err := openapi3filter.ValidateRequest(c.Request.Context(), input); err != nil {
+				resp := RequestValidationError(err)
+				c.AbortWithStatusJSON(resp.Status, resp.ForRequest(c))
+				return
+			}
+		}
+
+		if !validationConf.Responses || isStreaming(c, route) {
+			c.Next()
+			return
+		}
+
+		recorder := &responseRecorder{ResponseWriter: c.Writer, body: &bytes.Buffer{}, status: http.StatusOK}
+		c.Writer = recorder
+		c.Next()
+		c.Writer = recorder.ResponseWriter
+
+		validateResponse(c, input, recorder, validationConf.FailOnInvalidResponse)
+	}
+}
+
+func init() {
+	// JSON Merge Patch (RFC 7386) documents are plain JSON documents
+	openapi3filter.RegisterBodyDecoder("application/merge-patch+json", openapi3filter.JSONBodyDecoder)
+}
+
+func newRouter() routers.Router {
+	swagger, err := api.GetSwagger()
+	if err != nil {
+		log.Fatal("Unable to load the OpenAPI specification: %+v", err)
+	}
+	router, err := gorillamux.NewRouter(swagger)
+	if err != nil {
+		log.Fatal("Unable to create the OpenAPI validation router: %+v", err)
+	}
+	return router
+}
+
+// validateResponse checks the buffered response against the specification then writes it to the client.
+// The invalid responses are logged, and replaced with an internal error when failOnInvalidResponse is enabled.
+func validateResponse(c *gin.Context, input *openapi3filter.RequestValidationInput, recorder *responseRecorder, failOnInvalidResponse bool) {
+	err := openapi3filter.ValidateResponse(c.Request.Context(), &openapi3filter.ResponseValidationInput{
+		RequestValidationInput: input,
+		Status:                 recorder.status,
+		Header:                 recorder.Header(),
+		Body:                   io.NopCloser(bytes.NewReader(recorder.body.Bytes())),
+		Options:                input.Options,
+	})
+
+	if err != nil {
+		log.Ctx(c).Error("The response of %s %s does not match the OpenAPI specification: %s", c.Request.Method, c.FullPath(), err.Error())
+		if failOnInvalidResponse {
+			resp := model.NewServerResponse(model.OkdpServerResponse).ForRequest(c).
+				WithCode(model.ErrInvalidResponse).
+				WithDetails(toErrorDetails(err)...).
+				GenericError(http.StatusInternalServerError, "The server response does not match the OpenAPI specification")
+			c.Writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+			c.Writer.WriteHeader(resp.Status)
+			c.Writer.WriteHeaderNow()
+			_ = writeJSON(c.Writer, resp)
+			return
+		}
+	}
+
+	if recorder.wroteHeader {
+		c.Writer.WriteHeader(recorder.status)
+	}
+	c.Writer.WriteHeaderNow()
+	if _, err := c.Writer.Write(recorder.body.Bytes()); err != nil {
+		log.Ctx(c).Error("Unable to write the response of %s %s: %v", c.Request.Method, c.FullPath(), err)
+	}
+}
+
+// RequestValidationError converts the OpenAPI validation errors into a bad request response with field-level details
+func RequestValidationError(err error) *model.ServerResponse {
+	return model.NewServerResponse(model.OkdpServerResponse).
+		WithCode(model.ErrValidationFailed).
+		WithDetails(toErrorDetails(err)...).
+		BadRequest("The request does not match the OpenAPI specification")
+}
+
+func toErrorDetails(err error) []model.ErrorDetail {
+	switch e := err.(type) {
+	case openapi3.MultiError:
+		details := []model.ErrorDetail{}
+		for _, inner := range e {
+			details = append(details, toErrorDetails(inner)...)
+		}
+		return details
+	case *openapi3filter.RequestError:
+		field := "body"
+		if e.Parameter != nil {
+			field = fmt.Sprintf("%s.%s", e.Parameter.In, e.Parameter.Name)
+		}
+		return withField(e.Err, field, e.Error())
+	case *openapi3filter.ResponseError:
+		return withField(e.Err, "response", e.Error())
+	default:
+		return withField(err, "", err.Error())
+	}
+}
+
+// withField builds the details of the schema errors under the given field, or a single detail with the message
+func withField(err error, field string, message string) []model.ErrorDetail {
+	switch e := err.(type) {
+	case openapi3.MultiError:
+		details := []model.ErrorDetail{}
+		for _, inner := range e {
+			details = append(details, withField(inner, field, inner.Error())...)
+		}
+		return details
+	case *openapi3.SchemaError:
+		if pointer := e.JSONPointer(); len(pointer) > 0 {
+			field = strings.TrimPrefix(field+"."+strings.Join(pointer, "."), ".")
+		}
+		return []model.ErrorDetail{model.NewErrorDetail(field, e.SchemaField, e.Reason)}
+	case *openapi3filter.ParseError:
+		return []model.ErrorDetail{model.NewErrorDetail(field, "parse", e.Error())}
+	default:
+		if unwrapped := errors.Unwrap(err); unwrapped != nil {
+			return withField(unwrapped, field, message)
+		}
+		return []model.ErrorDetail{model.NewErrorDetail(field, "", message)}
+	}
+}
+
+// isStreaming reports whether the response is streamed (Server-Sent Events, WebSocket) and therefore cannot be buffered
+func isStreaming(c *gin.Context, route *routers.Route) bool {
+	if strings.Contains(c.GetHeader("Accept"), "text/event-stream") ||
+		strings.EqualFold(c.GetHeader("Upgrade"), "websocket") {
+		return true
+	}
+	if route == nil || route.Operation == nil || route.Operation.Responses == nil {
+		return false
+	}
+	ok := route.Operation.Responses.Status(http.StatusOK)
+	return ok != nil && ok.Value != nil && ok.Value.Content.Get("text/event-stream") != nil
+}
@@ -0,0 +1,158 @@
+/*
+ *    Copyright 2025 okdp.io
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package validation
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	log "github.com/okdp/okdp-server/internal/common/logging"
+	"github.com/okdp/okdp-server/internal/config"
+	"github.com/okdp/okdp-server/internal/model"
+)
+
+func newTestRouter(validationConf config.Validation, projectsResponse interface{}) *gin.Engine {
+	log.SetupGlobalLogger(config.Logging{})
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	apiV1 := r.Group("/api/v1")
+	apiV1.Use(OpenAPIValidator(validationConf))
+	apiV1.GET("/audit", func(c *gin.Context) {
+		c.JSON(http.StatusOK, []interface{}{})
+	})
+	apiV1.GET("/clusters/:clusterId/projects", func(c *gin.Context) {
+		c.JSON(http.StatusOK, projectsResponse)
+	})
+	apiV1.POST("/clusters/:clusterId/projects", func(c *gin.Context) {
+		c.JSON(http.StatusCreated, model.NewServerResponse(model.OkdpServerResponse).Created("created"))
+	})
+	apiV1.PATCH("/clusters/:clusterId/projects/:projectName", func(c *gin.Context) {
+		body, _ := c.GetRawData()
+		c.Data(http.StatusOK, "application/json", body)
+	})
+	return r
+}
+
+func servePatch(r *gin.Engine, contentType, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPatch, "/api/v1/clusters/sandbox/projects/project1", strings.NewReader(body))
+	req.Header.Set("Content-Type", contentType)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func serve(r *gin.Engine, method, path, body string) (*httptest.ResponseRecorder, *model.ServerResponse) {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	if body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	var resp model.ServerResponse
+	_ = json.Unmarshal(w.Body.Bytes(), &resp)
+	return w, &resp
+}
+
+func TestOpenAPIValidator_InvalidQueryParam(t *testing.T) {
+	// Given
+	r := newTestRouter(config.Validation{Requests: true}, nil)
+	// When
+	w, resp := serve(r, http.MethodGet, "/api/v1/audit?limit=5000&outcome=unknown", "")
+	// Then
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.True(t, resp.HasCode(model.ErrValidationFailed))
+	require.NotNil(t, resp.Details)
+	fields := []string{}
+	for _, detail := range *resp.Details {
+		fields = append(fields, *detail.Field)
+	}
+	assert.Contains(t, fields, "query.limit")
+	assert.Contains(t, fields, "query.outcome")
+}
+
+func TestOpenAPIValidator_InvalidBody(t *testing.T) {
+	// Given
+	r := newTestRouter(config.Validation{Requests: true}, nil)
+	// When
+	w, resp := serve(r, http.MethodPost, "/api/v1/clusters/sandbox/projects", `{"displayName": "My project", "status": "Unknown"}`)
+	// Then
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.True(t, resp.HasCode(model.ErrValidationFailed))
+	require.NotNil(t, resp.Details)
+	fields := []string{}
+	for _, detail := range *resp.Details {
+		fields = append(fields, *detail.Field)
+	}
+	assert.Contains(t, fields, "body.name")
+	assert.Contains(t, fields, "body.status")
+}
+
+func TestOpenAPIValidator_ValidRequest(t *testing.T) {
+	// Given
+	r := newTestRouter(config.Validation{Requests: true}, nil)
+	// When
+	w, _ := serve(r, http.MethodPost, "/api/v1/clusters/sandbox/projects", `{"name": "project1"}`)
+	// Then
+	assert.Equal(t, http.StatusCreated, w.Code)
+}
+
+func TestOpenAPIValidator_PatchRequest(t *testing.T) {
+	// Given
+	r := newTestRouter(config.Validation{Requests: true}, nil)
+	// When
+	mergePatch := servePatch(r, "application/merge-patch+json", `{"name": "project1"}`)
+	jsonPatch := servePatch(r, "application/json-patch+json", `[{"op": "replace", "path": "/name", "value": "project1"}]`)
+	invalidJSONPatch := servePatch(r, "application/json-patch+json", `[{"op": "rename", "path": "/name"}]`)
+	// Then
+	assert.Equal(t, http.StatusOK, mergePatch.Code)
+	assert.JSONEq(t, `{"name": "project1"}`, mergePatch.Body.String())
+	assert.Equal(t, http.StatusOK, jsonPatch.Code)
+	assert.Equal(t, http.StatusBadRequest, invalidJSONPatch.Code)
+}
+
+func TestOpenAPIValidator_Disabled(t *testing.T) {
+	// Given
+	r := newTestRouter(config.Validation{}, nil)
+	// When
+	w, _ := serve(r, http.MethodGet, "/api/v1/audit?limit=5000", "")
+	// Then
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestOpenAPIValidator_InvalidResponse(t *testing.T) {
+	// Given
+	projects := []map[string]interface{}{{"displayName": "no name"}}
+	logOnly := newTestRouter(config.Validation{Responses: true}, projects)
+	failing := newTestRouter(config.Validation{Responses: true, FailOnInvalidResponse: true}, projects)
+
+	// When
+	w1, _ := serve(logOnly, http.MethodGet, "/api/v1/clusters/sandbox/projects", "")
+	w2, resp := serve(failing, http.MethodGet, "/api/v1/clusters/sandbox/projects", "")
+
+	// Then
+	assert.Equal(t, http.StatusOK, w1.Code)
+	assert.JSONEq(t, `[{"displayName": "no name"}]`, w1.Body.String())
+	assert.Equal(t, http.StatusInternalServerError, w2.Code)
+	assert.True(t, resp.HasCode(model.ErrInvalidResponse))
+}